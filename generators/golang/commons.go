@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/nbrglm/napiway/spec"
 	"github.com/nbrglm/napiway/utils"
@@ -16,12 +17,33 @@ import (
 func TypesDataFromSpec(specification *spec.Specification) []TypeData {
 	var types []TypeData
 	for _, t := range specification.Schemas {
-		types = append(types, TypeData{
-			Name:        exportedName(t.Name),
-			Description: t.Description,
-			Fields:      getFieldsDataFromSpecFields(t.Properties, specification.Schemas),
-			Enum:        t.Enum,
-		})
+		typeData := TypeData{
+			Name:            exportedName(t.Name),
+			Description:     t.Description,
+			Fields:          getFieldsDataFromSpecFields(t.Properties, specification.Schemas),
+			Enum:            t.Enum,
+			InheritedFields: getFieldsDataFromSpecFields(specification.InheritedProperties(t), specification.Schemas),
+		}
+		for _, base := range t.Extends {
+			embedded := EmbeddedTypeData{Name: exportedName(base)}
+			for _, field := range getFieldsDataFromSpecFields(specification.AllProperties(specification.SchemaByName(base)), specification.Schemas) {
+				if field.Required {
+					embedded.RequiredFields = append(embedded.RequiredFields, field.Name)
+				}
+			}
+			typeData.Extends = append(typeData.Extends, embedded)
+		}
+		typeData.DecodesUnions = slices.ContainsFunc(typeData.AllFields(), func(f TypeFieldData) bool { return f.IsUnion })
+		if len(t.OneOf) > 0 {
+			typeData.Discriminator = exportedName(t.Discriminator.Property)
+			for _, variant := range t.OneOf {
+				typeData.OneOf = append(typeData.OneOf, UnionVariantData{
+					Name:  exportedName(variant),
+					Value: t.DiscriminatorValue(variant),
+				})
+			}
+		}
+		types = append(types, typeData)
 	}
 	sortTypesByName(&types)
 	return types
@@ -30,23 +52,40 @@ func TypesDataFromSpec(specification *spec.Specification) []TypeData {
 func AuthMethodsFromSpec(specification *spec.Specification) []AuthMethodData {
 	authMethods := make([]AuthMethodData, len(specification.Auth))
 	for i, auth := range specification.Auth {
-		authMethods[i] = AuthMethodData{
-			ID:            auth.ID,
-			Name:          exportedName(auth.Name),
-			TransportName: auth.TransportName,
-			Type:          AuthMethodType(auth.Type),
-			Description:   auth.Description,
-			Format:        auth.Format,
-		}
+		authMethods[i] = authMethodDataFromSpec(auth)
 	}
 	sortAuthMethodsByID(&authMethods)
 	return authMethods
 }
 
+func authMethodDataFromSpec(auth spec.AuthMethod) AuthMethodData {
+	return AuthMethodData{
+		ID:              auth.ID,
+		Name:            exportedName(auth.Name),
+		TransportName:   auth.TransportName,
+		Type:            AuthMethodType(auth.Type),
+		Description:     auth.Description,
+		Format:          auth.Format,
+		TokenURL:        auth.TokenURL,
+		Scopes:          auth.Scopes,
+		KeyIDHeader:     auth.KeyIDHeader,
+		TimestampHeader: auth.TimestampHeader,
+		Algorithm:       auth.Algorithm,
+		ClockSkew:       auth.ClockSkewDuration(),
+	}
+}
+
+// isUnionSchema reports whether the schema named name is a oneOf schema, generated as an interface rather than a struct.
+func isUnionSchema(specification *spec.Specification, name string) bool {
+	schema := specification.SchemaByName(name)
+	return schema != nil && len(schema.OneOf) > 0
+}
+
 func RequestResponsesDataFromEndpointDef(endpointIdx int, specification *spec.Specification) (RequestData, error) {
 	endpoint := specification.Endpoints[endpointIdx]
 
 	var authMethodAll, authMethodAny []AuthMethodData
+	var authAlternatives []AuthAlternativeData
 
 	if endpoint.Auth != nil {
 		var err error
@@ -58,12 +97,18 @@ func RequestResponsesDataFromEndpointDef(endpointIdx int, specification *spec.Sp
 		if err != nil {
 			return RequestData{}, err
 		}
+		authAlternatives, err = getAuthAlternatives(specification, endpoint.Auth.Alternatives)
+		if err != nil {
+			return RequestData{}, err
+		}
 	}
 
 	var requestBodyName *string
+	requestBodyIsUnion := false
 	if endpoint.BodyName != nil {
 		requestBodyName = new(string)
 		*requestBodyName = exportedName(*endpoint.BodyName)
+		requestBodyIsUnion = isUnionSchema(specification, *endpoint.BodyName)
 	}
 
 	responses := make([]ResponseData, len(endpoint.Responses))
@@ -73,9 +118,11 @@ func RequestResponsesDataFromEndpointDef(endpointIdx int, specification *spec.Sp
 			has413 = true
 		}
 		var responseBodyName *string
+		responseBodyIsUnion := false
 		if resp.BodyName != nil {
 			responseBodyName = new(string)
 			*responseBodyName = exportedName(*resp.BodyName)
+			responseBodyIsUnion = isUnionSchema(specification, *resp.BodyName)
 		}
 		responses[i] = ResponseData{
 			StatusCode:       resp.Status,
@@ -84,7 +131,8 @@ func RequestResponsesDataFromEndpointDef(endpointIdx int, specification *spec.Sp
 			RawBody:          resp.RawBody,
 			ContentType:      *resp.ContentType,
 			Headers:          mapSpecParamToParamData(resp.Headers),
-			ResponseBodyName: responseBodyName,
+			ResponseBodyName:    responseBodyName,
+			ResponseBodyIsUnion: responseBodyIsUnion,
 		}
 	}
 
@@ -106,23 +154,102 @@ func RequestResponsesDataFromEndpointDef(endpointIdx int, specification *spec.Sp
 	sortResponsesByStatusCode(&responses)
 
 	return RequestData{
-		Name:            exportedName(endpoint.Name + "Req"),
-		Description:     endpoint.Description,
-		Method:          string(endpoint.Method),
-		Path:            endpoint.Path,
-		MaxBodyBytes:    endpoint.MaxBodyBytes,
-		ContentType:     *endpoint.ContentType,
-		RawBody:         endpoint.RawBody,
-		RequestBodyName: requestBodyName,
-		PathParams:      mapSpecParamToParamData(endpoint.PathParams),
-		QueryParams:     mapSpecParamToParamData(endpoint.QueryParams),
-		HeaderParams:    mapSpecParamToParamData(endpoint.Headers),
-		AuthAll:         authMethodAll,
-		AuthAny:         authMethodAny,
-		Responses:       responses,
+		Name:             exportedName(endpoint.Name + "Req"),
+		EndpointName:     exportedName(endpoint.Name),
+		Description:      endpoint.Description,
+		Method:           string(endpoint.Method),
+		Path:             endpoint.Path,
+		MaxBodyBytes:     endpoint.MaxBodyBytes,
+		ContentType:      *endpoint.ContentType,
+		RawBody:          endpoint.RawBody,
+		RequestBodyName:    requestBodyName,
+		RequestBodyIsUnion: requestBodyIsUnion,
+		PathParams:       mapSpecParamToParamData(endpoint.PathParams),
+		QueryParams:      mapSpecParamToParamData(endpoint.QueryParams),
+		HeaderParams:     mapSpecParamToParamData(endpoint.Headers),
+		AuthAll:          authMethodAll,
+		AuthAny:          authMethodAny,
+		AuthAlternatives: authAlternatives,
+		Scopes:           endpoint.Scopes,
+		Retry:            retryDataFromSpec(specification.EndpointRetryPolicy(endpoint)),
+		IdempotencyKey:   endpoint.Idempotent == spec.EndpointIdempotencyKey,
+		Idempotent:       endpoint.IsIdempotent(),
+		Pagination:       paginationDataFromSpec(specification, endpoint, responses),
+		Responses:        responses,
 	}, nil
 }
 
+// paginationDataFromSpec returns the PaginationData of a validated endpoint, or nil if it is not paginated.
+func paginationDataFromSpec(specification *spec.Specification, endpoint *spec.Endpoint, responses []ResponseData) *PaginationData {
+	p := endpoint.Pagination
+	if p == nil {
+		return nil
+	}
+	paginated := endpoint.PaginatedResponse()
+	data := &PaginationData{
+		Style:     string(p.Style),
+		FirstPage: 1,
+	}
+	if p.FirstPage != nil {
+		data.FirstPage = *p.FirstPage
+	}
+	for _, param := range mapSpecParamToParamData(endpoint.QueryParams) {
+		if param.Name == exportedName(p.Param) {
+			data.Param = param
+		}
+	}
+	for _, resp := range responses {
+		if resp.StatusCode == paginated.Status {
+			data.Response = resp
+		}
+	}
+	for _, schema := range specification.Schemas {
+		if schema.Name != *paginated.BodyName {
+			continue
+		}
+		for _, field := range getFieldsDataFromSpecFields(specification.AllProperties(schema), specification.Schemas) {
+			switch {
+			case field.Name == exportedName(p.Items):
+				data.Items = field
+			case p.NextCursor != "" && field.Name == exportedName(p.NextCursor):
+				data.NextCursor = &field
+			case p.TotalCount != "" && field.Name == exportedName(p.TotalCount):
+				data.TotalCount = &field
+			}
+		}
+	}
+	return data
+}
+
+// EndpointsDataFromSpec returns the request and response data for every endpoint, in specification order.
+func EndpointsDataFromSpec(specification *spec.Specification) ([]EndpointData, error) {
+	endpoints := make([]EndpointData, len(specification.Endpoints))
+	for idx := range specification.Endpoints {
+		reqData, err := RequestResponsesDataFromEndpointDef(idx, specification)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get request and response data from endpoint (%s) definition: %w", specification.Endpoints[idx].Name, err)
+		}
+		endpoints[idx] = EndpointData{
+			Name:    specification.Endpoints[idx].Name,
+			Request: reqData,
+		}
+	}
+	return endpoints, nil
+}
+
+// retryDataFromSpec returns the RetryData of an endpoint's effective retry policy, or nil if it is not retried.
+func retryDataFromSpec(policy *spec.RetryPolicy) *RetryData {
+	if policy == nil {
+		return nil
+	}
+	return &RetryData{
+		MaxAttempts:    policy.MaxAttempts,
+		StatusCodes:    policy.StatusCodes,
+		InitialBackoff: policy.InitialBackoffDuration(),
+		MaxBackoff:     policy.MaxBackoffDuration(),
+	}
+}
+
 func mapSpecParamToParamData(params []spec.Param) []ParamData {
 	resParams := make([]ParamData, len(params))
 	for i, pathParam := range params {
@@ -165,14 +292,7 @@ func getAuthMethods(specification *spec.Specification, ids []string) ([]AuthMeth
 			missingAuths = append(missingAuths, id)
 		} else {
 			auth := specification.Auth[idx]
-			ams = append(ams, AuthMethodData{
-				ID:            auth.ID,
-				Name:          exportedName(auth.Name),
-				TransportName: auth.TransportName,
-				Type:          AuthMethodType(auth.Type),
-				Description:   auth.Description,
-				Format:        auth.Format,
-			})
+			ams = append(ams, authMethodDataFromSpec(auth))
 		}
 	}
 	if len(missingAuths) > 0 {
@@ -183,6 +303,42 @@ func getAuthMethods(specification *spec.Specification, ids []string) ([]AuthMeth
 	return ams, nil
 }
 
+// getAuthAlternatives resolves the alternative sets of auth method ids of an endpoint, keeping their order.
+func getAuthAlternatives(specification *spec.Specification, alternatives [][]string) ([]AuthAlternativeData, error) {
+	res := make([]AuthAlternativeData, len(alternatives))
+	for i, ids := range alternatives {
+		ams, err := getAuthMethods(specification, ids)
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, len(ams))
+		values := make([]string, len(ams))
+		for j, am := range ams {
+			names[j] = am.Name
+			values[j] = am.ID
+		}
+		res[i] = AuthAlternativeData{
+			Name:    strings.Join(names, "And"),
+			Value:   strings.Join(values, "+"),
+			Methods: ams,
+		}
+	}
+	for i := range res {
+		for j, other := range res {
+			if j == i {
+				continue
+			}
+			for _, am := range other.Methods {
+				if !slices.ContainsFunc(slices.Concat(res[i].Methods, res[i].Others), func(m AuthMethodData) bool { return m.ID == am.ID }) {
+					res[i].Others = append(res[i].Others, am)
+				}
+			}
+		}
+		sortAuthMethodsByID(&res[i].Others)
+	}
+	return res, nil
+}
+
 func getFieldsDataFromSpecFields(fields []*spec.SchemaField, schemas []*spec.Schema) []TypeFieldData {
 	if len(fields) == 0 {
 		return nil
@@ -191,8 +347,9 @@ func getFieldsDataFromSpecFields(fields []*spec.SchemaField, schemas []*spec.Sch
 	for i, field := range fields {
 		typ, isPrimitive := getTypeDataFieldTypeFromSpecFieldType(field.Type)
 		isEnum := IsTypeEnum(isPrimitive, typ, schemas)
+		isUnion := IsTypeUnion(isPrimitive, typ, schemas)
 		ptrType := false
-		if field.IsArray {
+		if field.IsArray || field.IsMap || isUnion {
 			ptrType = false
 		} else if !field.Required {
 			ptrType = true
@@ -213,7 +370,10 @@ func getFieldsDataFromSpecFields(fields []*spec.SchemaField, schemas []*spec.Sch
 			IsNonPrimitiveType: !isPrimitive,
 			Tag:                tagBuilder.String(),
 			IsArray:            field.IsArray,
+			ArrayDepth:         field.Dimensions(),
+			IsMap:              field.IsMap,
 			IsEnum:             isEnum,
+			IsUnion:            isUnion,
 			Required:           field.Required,
 			NonEmpty:           field.NonEmpty,
 		}
@@ -374,3 +534,28 @@ func IsTypeEnum(isPrimitive bool, typ string, schemas []*spec.Schema) bool {
 	}
 	return false
 }
+
+// IsTypeUnion reports whether typ, a non-primitive field type, is a oneOf schema.
+func IsTypeUnion(isPrimitive bool, typ string, schemas []*spec.Schema) bool {
+	if !isPrimitive {
+		for _, schema := range schemas {
+			if schema.Name == typ && len(schema.OneOf) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// goDurationLiteral returns d as a Go expression in the largest unit that represents it exactly, e.g. "100 * time.Millisecond".
+func goDurationLiteral(d time.Duration) string {
+	for _, unit := range []struct {
+		d    time.Duration
+		name string
+	}{{time.Hour, "Hour"}, {time.Minute, "Minute"}, {time.Second, "Second"}, {time.Millisecond, "Millisecond"}, {time.Microsecond, "Microsecond"}} {
+		if d%unit.d == 0 {
+			return fmt.Sprintf("%d * time.%s", d/unit.d, unit.name)
+		}
+	}
+	return fmt.Sprintf("%d * time.Nanosecond", d)
+}
@@ -1,16 +1,76 @@
 package golang
 
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nbrglm/napiway/spec"
+)
+
 type GoTypesFileData struct {
 	PackageName string
 
 	AuthMethods []AuthMethodData
 
 	Types []TypeData
+
+	// Server is true when generating the goServer types file, which also gets the JSON decoding methods.
+	Server bool
+}
+
+// HasUnions reports whether any type is a oneOf schema, so the types file needs the union helpers.
+func (d GoTypesFileData) HasUnions() bool {
+	return slices.ContainsFunc(d.Types, func(t TypeData) bool { return len(t.OneOf) > 0 })
 }
 
 type GoReqResFileData struct {
 	RequestData
 	PackageName string
+
+	// Server is true when generating the goServer request/response file, and false for the Go SDK.
+	Server bool
+
+	// TypedErrors is true when the Go SDK returns the 4xx and 5xx responses as typed errors, see spec.GoSDKGeneration.
+	TypedErrors bool
+}
+
+type GoServerRoutesFileData struct {
+	PackageName string
+	Endpoints   []EndpointData
+
+	// ErrorSchema is the exported name of the schema parse errors are rendered with, empty if not configured.
+	ErrorSchema string
+
+	// AuthMethods used by at least one endpoint, whose Authenticators the Handler embeds.
+	AuthMethods []AuthMethodData
+
+	// HasScopes is true if at least one endpoint declares scopes, so the Handler embeds the ScopeChecker.
+	HasScopes bool
+
+	// HasIdempotencyKeys is true if at least one endpoint uses idempotency keys, so the Handler embeds the IdempotencyStore.
+	HasIdempotencyKeys bool
+}
+
+type GoServerErrorsFileData struct {
+	PackageName string
+}
+
+type GoServerIdempotencyFileData struct {
+	PackageName string
+}
+
+type GoServerAuthFileData struct {
+	PackageName string
+
+	// AuthMethods used by at least one endpoint, each of which gets an Authenticator interface.
+	AuthMethods []AuthMethodData
+
+	// HasScopes is true if at least one endpoint declares scopes, which generates the ScopeChecker interface.
+	HasScopes bool
+	// HasHMAC is true if the specification has hmac auth methods, which need the HMACSignature helpers.
+	HasHMAC bool
 }
 
 type GoSdkClientFileData struct {
@@ -18,6 +78,30 @@ type GoSdkClientFileData struct {
 	ClientName    string
 	ClientVersion string
 	Endpoints     []EndpointData
+
+	// The auth methods of the specification, whose default credentials are set with ClientOptions.
+	AuthMethods []AuthMethodData
+	// The oauth2ClientCredentials auth methods of the specification, which get token providers in the SDK.
+	OAuth2Methods []AuthMethodData
+	// HasHMAC is true if the specification has hmac auth methods, whose requests the SDK signs.
+	HasHMAC bool
+	// TypedErrors is true when the endpoint methods return the 4xx and 5xx responses as typed errors, see spec.GoSDKGeneration.
+	TypedErrors bool
+}
+
+// HasRetry reports whether any endpoint has a retry policy, so the client needs the retry helpers.
+func (d GoSdkClientFileData) HasRetry() bool {
+	return slices.ContainsFunc(d.Endpoints, func(e EndpointData) bool { return e.Request.Retry != nil })
+}
+
+// HasIdempotencyKeys reports whether any endpoint uses idempotency keys, so the client generates them.
+func (d GoSdkClientFileData) HasIdempotencyKeys() bool {
+	return hasIdempotencyKeys(d.Endpoints)
+}
+
+// HasPagination reports whether any endpoint is paginated, so the SDK has iterators.
+func (d GoSdkClientFileData) HasPagination() bool {
+	return slices.ContainsFunc(d.Endpoints, func(e EndpointData) bool { return e.Request.Pagination != nil })
 }
 
 type EndpointData struct {
@@ -29,6 +113,9 @@ type RequestData struct {
 	Name        string
 	Description *string
 
+	// EndpointName is the exported name of the endpoint this request belongs to, e.g. "CreateUser".
+	EndpointName string
+
 	// Indicates whether the request body should be ignored by the generated code
 	// and only focus on other aspects of the request, such as headers, query parameters, path parameters, etc.
 	RawBody bool
@@ -47,13 +134,215 @@ type RequestData struct {
 
 	RequestBodyName *string
 
+	// RequestBodyIsUnion is true if the request body is a oneOf schema, an interface rather than a struct.
+	RequestBodyIsUnion bool
+
 	AuthAll []AuthMethodData
 	AuthAny []AuthMethodData
 
+	// Alternative sets of auth methods, see spec.EndpointAuthentication.Alternatives.
+	AuthAlternatives []AuthAlternativeData
+
+	// Authorization scopes required by the endpoint, see spec.Endpoint.Scopes.
+	Scopes []string
+
+	// Retry policy of the endpoint in the SDK, nil if it is not retried.
+	Retry *RetryData
+
+	// Whether requests carry an Idempotency-Key header, see spec.EndpointIdempotencyKey.
+	IdempotencyKey bool
+
+	// Whether the endpoint is idempotent, see spec.Endpoint.IsIdempotent.
+	Idempotent bool
+
+	// Pagination of the endpoint in the SDK, nil if it is not paginated.
+	Pagination *PaginationData
+
 	// Responses
 	Responses []ResponseData
 }
 
+// AuthAlternativeData is one of the sets of auth methods in RequestData.AuthAlternatives.
+type AuthAlternativeData struct {
+	// Name of the alternative, the names of its auth methods joined with "And", e.g. "APIKeyAndSessionToken".
+	Name string
+
+	// Identifies the alternative, the IDs of its auth methods joined with "+", e.g. "apiKeyAuth+sessionTokenAuth".
+	Value string
+
+	Methods []AuthMethodData
+
+	// The auth methods of the other alternatives that are not part of this one.
+	Others []AuthMethodData
+}
+
+// RequestBodyType returns the Go type of the request body: a pointer to its struct, or its interface for a oneOf schema.
+func (r RequestData) RequestBodyType() string {
+	return bodyType(*r.RequestBodyName, r.RequestBodyIsUnion)
+}
+
+// ScopesList returns the comma-separated Scopes.
+func (r RequestData) ScopesList() string {
+	return strings.Join(r.Scopes, ", ")
+}
+
+// AuthAlternativeMethods returns the auth methods of all of the AuthAlternatives, without duplicates and sorted by ID.
+func (r RequestData) AuthAlternativeMethods() []AuthMethodData {
+	var methods []AuthMethodData
+	for _, alternative := range r.AuthAlternatives {
+		for _, am := range alternative.Methods {
+			if !slices.ContainsFunc(methods, func(m AuthMethodData) bool { return m.ID == am.ID }) {
+				methods = append(methods, am)
+			}
+		}
+	}
+	sortAuthMethodsByID(&methods)
+	return methods
+}
+
+// RetryData is the retry policy of an endpoint, see spec.RetryPolicy.
+type RetryData struct {
+	MaxAttempts    int
+	StatusCodes    []int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// InitialBackoffLiteral returns InitialBackoff as a Go expression, e.g. "100 * time.Millisecond".
+func (r RetryData) InitialBackoffLiteral() string {
+	return goDurationLiteral(r.InitialBackoff)
+}
+
+// MaxBackoffLiteral returns MaxBackoff as a Go expression, e.g. "5 * time.Second".
+func (r RetryData) MaxBackoffLiteral() string {
+	return goDurationLiteral(r.MaxBackoff)
+}
+
+// PaginationData is the pagination of an endpoint, see spec.Pagination.
+type PaginationData struct {
+	// "page", "offset" or "cursor".
+	Style string
+
+	// The query param selecting the page.
+	Param ParamData
+
+	// The response holding the items, and the fields of its body.
+	Response   ResponseData
+	Items      TypeFieldData
+	NextCursor *TypeFieldData
+	TotalCount *TypeFieldData
+
+	// Number of the first page, for the page style.
+	FirstPage int64
+}
+
+// AuthMethods returns the auth methods of AuthAll, AuthAny and AuthAlternatives.
+func (r RequestData) AuthMethods() []AuthMethodData {
+	return slices.Concat(r.AuthAll, r.AuthAny, r.AuthAlternativeMethods())
+}
+
+// AuthMethodIDs returns the IDs of the auth methods of AuthAll, AuthAny and AuthAlternatives.
+func (r RequestData) AuthMethodIDs() []string {
+	var ids []string
+	for _, am := range r.AuthMethods() {
+		ids = append(ids, am.ID)
+	}
+	return ids
+}
+
+// HasAuth reports whether the request requires authentication.
+func (r RequestData) HasAuth() bool {
+	return len(r.AuthAll) > 0 || len(r.AuthAny) > 0 || len(r.AuthAlternatives) > 0
+}
+
+// OAuth2Methods returns the oauth2ClientCredentials auth methods of AuthAll, AuthAny and AuthAlternatives,
+// whose tokens the SDK can obtain from token providers.
+func (r RequestData) OAuth2Methods() []AuthMethodData {
+	var methods []AuthMethodData
+	for _, am := range slices.Concat(r.AuthAll, r.AuthAny, r.AuthAlternativeMethods()) {
+		if am.IsOAuth2() && !slices.ContainsFunc(methods, func(m AuthMethodData) bool { return m.ID == am.ID }) {
+			methods = append(methods, am)
+		}
+	}
+	return methods
+}
+
+// HasHMAC reports whether any auth method of the request is hmac, so the request is signed.
+func (r RequestData) HasHMAC() bool {
+	return slices.ContainsFunc(slices.Concat(r.AuthAll, r.AuthAny, r.AuthAlternativeMethods()), func(am AuthMethodData) bool {
+		return am.Type == AuthMethodTypeHMAC
+	})
+}
+
+// SuccessResponses returns the responses of the request that are not errors, see ResponseData.IsError.
+func (r RequestData) SuccessResponses() []ResponseData {
+	var responses []ResponseData
+	for _, resp := range r.Responses {
+		if !resp.IsError() {
+			responses = append(responses, resp)
+		}
+	}
+	return responses
+}
+
+// DirectResponse returns the only success response of the request, which the endpoint method of a Go SDK
+// with typed errors returns directly, or nil if the request has none or several.
+func (r RequestData) DirectResponse() *ResponseData {
+	if responses := r.SuccessResponses(); len(responses) == 1 {
+		return &responses[0]
+	}
+	return nil
+}
+
+// OAuth2AuthAny returns the oauth2ClientCredentials auth methods of AuthAny.
+func (r RequestData) OAuth2AuthAny() []AuthMethodData {
+	var methods []AuthMethodData
+	for _, am := range r.AuthAny {
+		if am.IsOAuth2() {
+			methods = append(methods, am)
+		}
+	}
+	return methods
+}
+
+// OAuth2AuthAlternatives returns the AuthAlternatives made only of oauth2ClientCredentials auth methods,
+// which the SDK can use when no auth parameter is set.
+func (r RequestData) OAuth2AuthAlternatives() []AuthAlternativeData {
+	var alternatives []AuthAlternativeData
+	for _, alternative := range r.AuthAlternatives {
+		if !slices.ContainsFunc(alternative.Methods, func(m AuthMethodData) bool { return !m.IsOAuth2() }) {
+			alternatives = append(alternatives, alternative)
+		}
+	}
+	return alternatives
+}
+
+// AuthAlternativesDescription describes the AuthAlternatives by the transport names of their auth methods,
+// e.g. "[X-API-Key, X-Session-Token] or [X-Admin-Token]".
+func (r RequestData) AuthAlternativesDescription() string {
+	alternatives := make([]string, len(r.AuthAlternatives))
+	for i, alternative := range r.AuthAlternatives {
+		names := make([]string, len(alternative.Methods))
+		for j, am := range alternative.Methods {
+			names[j] = am.TransportName
+		}
+		alternatives[i] = "[" + strings.Join(names, ", ") + "]"
+	}
+	return strings.Join(alternatives, " or ")
+}
+
+// AuthAnyTransportNames returns the comma-separated transport names of AuthAny, without duplicates
+// (bearer and basic auth methods can share the Authorization header).
+func (r RequestData) AuthAnyTransportNames() string {
+	names := make([]string, 0, len(r.AuthAny))
+	for _, am := range r.AuthAny {
+		if !slices.Contains(names, am.TransportName) {
+			names = append(names, am.TransportName)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
 type ResponseData struct {
 	StatusCode  int
 	Name        string
@@ -66,6 +355,38 @@ type ResponseData struct {
 	ContentType      string
 	Headers          []ParamData
 	ResponseBodyName *string
+
+	// ResponseBodyIsUnion is true if the response body is a oneOf schema, an interface rather than a struct.
+	ResponseBodyIsUnion bool
+}
+
+// ResponseBodyType returns the Go type of the response body: a pointer to its struct, or its interface for a oneOf schema.
+func (r ResponseData) ResponseBodyType() string {
+	return bodyType(*r.ResponseBodyName, r.ResponseBodyIsUnion)
+}
+
+// bodyType returns the Go type of a request or response body named name: a pointer to its struct,
+// or its interface if it is a oneOf schema.
+func bodyType(name string, isUnion bool) string {
+	if isUnion {
+		return name
+	}
+	return "*" + name
+}
+
+// IsError reports whether the response has a 4xx or 5xx status code.
+func (r ResponseData) IsError() bool {
+	return r.StatusCode >= 400
+}
+
+// StatusText returns the text of the status code of the response, e.g. "Bad Request".
+func (r ResponseData) StatusText() string {
+	return http.StatusText(r.StatusCode)
+}
+
+// HasContent reports whether the response has headers or a body, so it gets a Parse<Name> function.
+func (r ResponseData) HasContent() bool {
+	return len(r.Headers) > 0 || r.ResponseBodyName != nil || r.RawBody
 }
 
 type ParamData struct {
@@ -82,6 +403,59 @@ type TypeData struct {
 
 	Fields []TypeFieldData
 	Enum   []string
+
+	// OneOf lists the variants of a oneOf schema, which is generated as an interface they implement.
+	OneOf []UnionVariantData
+
+	// Discriminator is the exported name of the property telling apart the variants of a oneOf schema.
+	Discriminator string
+
+	// Extends lists the structs embedded in the struct, see spec.Schema.Extends.
+	Extends []EmbeddedTypeData
+
+	// InheritedFields are the fields of the embedded structs, which get constructor parameters and With methods on the struct.
+	InheritedFields []TypeFieldData
+
+	// DecodesUnions is true if the struct, or one it embeds, has oneOf fields, so the Go SDK gives it an UnmarshalJSON method.
+	DecodesUnions bool
+}
+
+// EmbeddedTypeData is a struct embedded in another one, for a schema it extends.
+type EmbeddedTypeData struct {
+	Name string
+
+	// RequiredFields are the names of the required fields of the embedded struct, in the order of its constructor parameters.
+	RequiredFields []string
+}
+
+// AllFields returns the fields of the struct, including the inherited ones, sorted by name.
+func (t TypeData) AllFields() []TypeFieldData {
+	fields := slices.Concat(t.InheritedFields, t.Fields)
+	sortTypeFieldsByName(&fields)
+	return fields
+}
+
+// DecodedFields returns the fields of the struct in the order the server decodes them, the inherited ones first.
+func (t TypeData) DecodedFields() []TypeFieldData {
+	return slices.Concat(t.InheritedFields, t.Fields)
+}
+
+// UnionVariantData is a variant of a oneOf schema.
+type UnionVariantData struct {
+	// Name of the variant schema, e.g. "EmailNotification".
+	Name string
+
+	// Value of the discriminator property for the variant, e.g. "email".
+	Value string
+}
+
+// InvalidDiscriminatorMessage returns the validation message of a oneOf schema with an unknown discriminator value.
+func (t TypeData) InvalidDiscriminatorMessage() string {
+	values := make([]string, len(t.OneOf))
+	for i, variant := range t.OneOf {
+		values[i] = variant.Value
+	}
+	return "must be one of " + strings.Join(values, ", ")
 }
 
 // TypeStr is a string representation of a Go type, used for code generation purposes.
@@ -106,8 +480,9 @@ type TypeFieldData struct {
 	// Whether the type is a pointer type. Cases:
 	// True if the field is optional.
 	// True if the field is a non-primitive type (e.g. struct), since we want to use pointer types for structs to allow for nil values and to avoid copying large structs.
-	// False if IsArray is true.
+	// False if IsArray or IsMap is true.
 	// False if the field is required and a primitive type, since we want to use value types for required primitive fields for better ergonomics.
+	// False if IsUnion is true, since interfaces are nil when unset.
 	PtrType bool
 
 	// Whether the type is a non-primitive type (e.g. struct).
@@ -119,18 +494,75 @@ type TypeFieldData struct {
 	// Used to put the [] in the right place for array types. If true, the generated code will use []Type for this type.
 	IsArray bool
 
+	// Number of nested arrays, e.g. 2 for [][]Type. 0 if IsArray is false.
+	ArrayDepth int
+
+	// Used to put the map[string] in the right place for map types. If true, the generated code will use map[string]Type for this type.
+	IsMap bool
+
 	// Used to indicate that the field is an enum and should be parsed appropriately.
 	IsEnum bool
 
+	// Used to indicate that the field is a oneOf schema, an interface decoded by its discriminator.
+	IsUnion bool
+
 	Required bool
 
 	NonEmpty bool
 }
 
+// ArrayPrefix returns the [] of each of the nested arrays of the field, e.g. [][] for a matrix.
+func (f TypeFieldData) ArrayPrefix() string {
+	return strings.Repeat("[]", f.ArrayDepth)
+}
+
+// Item returns the field data of the items of an array field, which are arrays themselves if its arrays are nested.
+func (f TypeFieldData) Item() TypeFieldData {
+	f.ArrayDepth--
+	f.IsArray = f.ArrayDepth > 0
+	return f
+}
+
+// IsStruct reports whether the type of the field, or of its items, is a struct, rather than a primitive, an enum or a oneOf interface.
+func (f TypeFieldData) IsStruct() bool {
+	return f.IsNonPrimitiveType && !f.IsEnum && !f.IsUnion
+}
+
+// JSONNodeType returns the type of the field in the raw struct the server decodes a JSON object into:
+// the raw struct of its struct type, or jsonValue for the other types, within its arrays or map.
+func (f TypeFieldData) JSONNodeType() string {
+	node := "jsonValue"
+	if f.IsStruct() {
+		node = "json" + f.Type
+	}
+	switch {
+	case f.IsArray:
+		return f.ArrayPrefix() + node
+	case f.IsMap:
+		return "map[string]" + node
+	case f.IsStruct():
+		return "*" + node
+	default:
+		return node
+	}
+}
+
+// IsJSONValue reports whether the field is a jsonValue in the raw struct the server decodes a JSON object into, see JSONNodeType.
+func (f TypeFieldData) IsJSONValue() bool {
+	return !f.IsArray && !f.IsMap && !f.IsStruct()
+}
+
 type AuthMethodType string
 
 const (
 	AuthMethodTypeHeader AuthMethodType = "header"
+	AuthMethodTypeBearer AuthMethodType = "bearer"
+	AuthMethodTypeBasic  AuthMethodType = "basic"
+	AuthMethodTypeQuery  AuthMethodType = "query"
+	AuthMethodTypeCookie AuthMethodType = "cookie"
+
+	AuthMethodTypeOAuth2ClientCredentials AuthMethodType = "oauth2ClientCredentials"
+	AuthMethodTypeHMAC                    AuthMethodType = "hmac"
 )
 
 type AuthMethodData struct {
@@ -144,8 +576,71 @@ type AuthMethodData struct {
 
 	Description *string
 
-	// Optional format of the auth method
-	//
-	// For example
+	// Optional format of the auth method, see spec.AuthMethod.Format.
 	Format *string
+
+	// URL of the token endpoint and the requested scopes, only set for oauth2ClientCredentials auth.
+	TokenURL string
+	Scopes   []string
+
+	// Headers, hash algorithm and clock skew window of hmac auth, see spec.AuthMethod.
+	KeyIDHeader     string
+	TimestampHeader string
+	Algorithm       string
+	ClockSkew       time.Duration
+}
+
+// IsOAuth2 reports whether the auth method is oauth2ClientCredentials, whose tokens the SDK can obtain with a token provider.
+func (am AuthMethodData) IsOAuth2() bool {
+	return am.Type == AuthMethodTypeOAuth2ClientCredentials
+}
+
+// ParsedFormat returns the enforced format of the auth method, or nil if Format is documentation-only.
+func (am AuthMethodData) ParsedFormat() *spec.AuthFormat {
+	// Format is validated with the specification.
+	format, _ := spec.ParseAuthFormat(am.Format)
+	return format
+}
+
+// FormatPrefix returns the text before the "{token}" placeholder of the enforced template format of the auth method,
+// or an empty string if it has none.
+func (am AuthMethodData) FormatPrefix() string {
+	if format := am.ParsedFormat(); format != nil && format.Pattern == "" {
+		return format.Prefix
+	}
+	return ""
+}
+
+// FormatSuffix returns the text after the "{token}" placeholder of the enforced template format of the auth method,
+// or an empty string if it has none.
+func (am AuthMethodData) FormatSuffix() string {
+	if format := am.ParsedFormat(); format != nil && format.Pattern == "" {
+		return format.Suffix
+	}
+	return ""
+}
+
+// GoType returns the Go type of the credential of the auth method.
+func (am AuthMethodData) GoType() string {
+	switch am.Type {
+	case AuthMethodTypeBasic:
+		return "BasicAuthCredentials"
+	case AuthMethodTypeHMAC:
+		return "HMACKey"
+	}
+	return "string"
+}
+
+// ServerGoType returns the Go type of the credential of the auth method in the server,
+// which differs from GoType for hmac auth: the server gets the signature of the request instead of the key.
+func (am AuthMethodData) ServerGoType() string {
+	if am.Type == AuthMethodTypeHMAC {
+		return "HMACSignature"
+	}
+	return am.GoType()
+}
+
+// ClockSkewSeconds returns ClockSkew in whole seconds.
+func (am AuthMethodData) ClockSkewSeconds() int64 {
+	return int64(am.ClockSkew / time.Second)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/nbrglm/napiway/spec"
@@ -46,16 +47,9 @@ func GenerateGoSDK(cfg *spec.GoSDKGeneration, spc *spec.Specification) error {
 	}
 
 	// client file
-	clientFileEndpoints := make([]EndpointData, len(spc.Endpoints))
-	for idx := range spc.Endpoints {
-		reqData, err := RequestResponsesDataFromEndpointDef(idx, spc)
-		if err != nil {
-			return fmt.Errorf("failed to get request and response data from endpoint (%s) definition: %w", spc.Endpoints[idx].Name, err)
-		}
-		clientFileEndpoints[idx] = EndpointData{
-			Name:    spc.Endpoints[idx].Name,
-			Request: reqData,
-		}
+	clientFileEndpoints, err := EndpointsDataFromSpec(spc)
+	if err != nil {
+		return err
 	}
 	clientFilePath := filepath.Join(cfg.OutputDir, "client.go")
 	clientFileData := GoSdkClientFileData{
@@ -63,6 +57,10 @@ func GenerateGoSDK(cfg *spec.GoSDKGeneration, spc *spec.Specification) error {
 		ClientName:    exportedName(strings.ReplaceAll(spc.ApiName, " ", "")),
 		ClientVersion: spc.Version,
 		Endpoints:     clientFileEndpoints,
+		AuthMethods:   AuthMethodsFromSpec(spc),
+		OAuth2Methods: slices.DeleteFunc(AuthMethodsFromSpec(spc), func(am AuthMethodData) bool { return !am.IsOAuth2() }),
+		HasHMAC:       hasHMAC(AuthMethodsFromSpec(spc)),
+		TypedErrors:   cfg.TypedErrors,
 	}
 	clientFileContent, err := ExecuteTemplate("sdkClientFile", clientFileData)
 	if err != nil {
@@ -80,6 +78,47 @@ func GenerateGoSDK(cfg *spec.GoSDKGeneration, spc *spec.Specification) error {
 		return fmt.Errorf("failed to format client file %s: %w", clientFilePath, formatErr)
 	}
 
+	middlewareFileContent, err := ExecuteTemplate("sdkMiddlewareFile", clientFileData)
+	if err != nil {
+		return fmt.Errorf("failed to execute middleware file template: %w", err)
+	}
+	if err := formatAndWriteFile(filepath.Join(cfg.OutputDir, "middleware.go"), middlewareFileContent); err != nil {
+		return err
+	}
+
+	// Default credentials file, if the specification has auth methods
+	if len(clientFileData.AuthMethods) > 0 {
+		credentialsFileContent, err := ExecuteTemplate("sdkCredentialsFile", clientFileData)
+		if err != nil {
+			return fmt.Errorf("failed to execute credentials file template: %w", err)
+		}
+		if err := formatAndWriteFile(filepath.Join(cfg.OutputDir, "credentials.go"), credentialsFileContent); err != nil {
+			return err
+		}
+	}
+
+	// Iterators file, if any endpoint is paginated
+	if clientFileData.HasPagination() {
+		paginationFileContent, err := ExecuteTemplate("sdkPaginationFile", clientFileData)
+		if err != nil {
+			return fmt.Errorf("failed to execute pagination file template: %w", err)
+		}
+		if err := formatAndWriteFile(filepath.Join(cfg.OutputDir, "pagination.go"), paginationFileContent); err != nil {
+			return err
+		}
+	}
+
+	// OAuth2 token providers file, if any auth method uses them
+	if len(clientFileData.OAuth2Methods) > 0 {
+		oauth2FileContent, err := ExecuteTemplate("sdkOAuth2File", clientFileData)
+		if err != nil {
+			return fmt.Errorf("failed to execute oauth2 file template: %w", err)
+		}
+		if err := formatAndWriteFile(filepath.Join(cfg.OutputDir, "oauth2.go"), oauth2FileContent); err != nil {
+			return err
+		}
+	}
+
 	// Write the License File, if any is provided
 	if cfg.LicenseFile != nil {
 		licenseFilePath := filepath.Join(cfg.OutputDir, "LICENSE")
@@ -130,6 +169,7 @@ func generateAndWriteSdkReqResFiles(cfg *spec.GoSDKGeneration, spc *spec.Specifi
 		fileData := GoReqResFileData{
 			PackageName: packageName,
 			RequestData: reqData,
+			TypedErrors: cfg.TypedErrors,
 		}
 		content, err := ExecuteTemplate("sdkReqResFile", fileData)
 		if err != nil {
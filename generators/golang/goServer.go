@@ -3,6 +3,7 @@ package golang
 import (
 	"fmt"
 	"path/filepath"
+	"slices"
 
 	"github.com/nbrglm/napiway/spec"
 	"github.com/nbrglm/napiway/utils"
@@ -28,6 +29,22 @@ func GenerateServerHelpers(cfg *spec.GoServerGeneration, spc *spec.Specification
 		return fmt.Errorf("failed to generate and write server request and response files: %w", err)
 	}
 
+	if err := generateAndWriteServerRoutesFile(cfg, spc); err != nil {
+		return fmt.Errorf("failed to generate and write server routes file: %w", err)
+	}
+
+	if err := generateAndWriteServerAuthFile(cfg, spc); err != nil {
+		return fmt.Errorf("failed to generate and write server auth file: %w", err)
+	}
+
+	if err := generateAndWriteServerErrorsFile(cfg); err != nil {
+		return fmt.Errorf("failed to generate and write server errors file: %w", err)
+	}
+
+	if err := generateAndWriteServerIdempotencyFile(cfg, spc); err != nil {
+		return fmt.Errorf("failed to generate and write server idempotency file: %w", err)
+	}
+
 	helpersFilePath := filepath.Join(cfg.OutputDir, "helperFuncs.go")
 	if err := generateAndWriteHelperFuncsFile(cfg.PackageName, spc.ApiName, spc.Version, helpersFilePath); err != nil {
 		return fmt.Errorf("failed to generate and write helper functions file: %w", err)
@@ -42,6 +59,7 @@ func generateAndWriteServerTypesFile(cfg *spec.GoServerGeneration, spc *spec.Spe
 		PackageName: cfg.PackageName,
 		Types:       types,
 		AuthMethods: AuthMethodsFromSpec(spc),
+		Server:      true,
 	}
 	filePath := filepath.Join(cfg.OutputDir, "types.go")
 	content, err := ExecuteTemplate("serverTypesFile", fileData)
@@ -67,6 +85,7 @@ func generateAndWriteServerReqResFiles(cfg *spec.GoServerGeneration, spc *spec.S
 		fileData := GoReqResFileData{
 			PackageName: cfg.PackageName,
 			RequestData: reqData,
+			Server:      true,
 		}
 		content, err := ExecuteTemplate("serverReqResFile", fileData)
 		if err != nil {
@@ -79,3 +98,114 @@ func generateAndWriteServerReqResFiles(cfg *spec.GoServerGeneration, spc *spec.S
 	}
 	return nil
 }
+
+func generateAndWriteServerRoutesFile(cfg *spec.GoServerGeneration, spc *spec.Specification) error {
+	endpoints, err := EndpointsDataFromSpec(spc)
+	if err != nil {
+		return err
+	}
+	fileData := GoServerRoutesFileData{
+		PackageName:        cfg.PackageName,
+		Endpoints:          endpoints,
+		AuthMethods:        usedAuthMethods(endpoints),
+		HasScopes:          hasScopes(endpoints),
+		HasIdempotencyKeys: hasIdempotencyKeys(endpoints),
+	}
+	if cfg.ErrorSchema != nil {
+		fileData.ErrorSchema = exportedName(*cfg.ErrorSchema)
+	}
+	filePath := filepath.Join(cfg.OutputDir, "routes.go")
+	content, err := ExecuteTemplate("serverRoutesFile", fileData)
+	if err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+	return formatAndWriteFile(filePath, content)
+}
+
+func generateAndWriteServerErrorsFile(cfg *spec.GoServerGeneration) error {
+	fileData := GoServerErrorsFileData{
+		PackageName: cfg.PackageName,
+	}
+	filePath := filepath.Join(cfg.OutputDir, "errors.go")
+	content, err := ExecuteTemplate("serverErrorsFile", fileData)
+	if err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+	return formatAndWriteFile(filePath, content)
+}
+
+// generateAndWriteServerIdempotencyFile writes the IdempotencyStore, if at least one endpoint uses idempotency keys.
+func generateAndWriteServerIdempotencyFile(cfg *spec.GoServerGeneration, spc *spec.Specification) error {
+	endpoints, err := EndpointsDataFromSpec(spc)
+	if err != nil {
+		return err
+	}
+	if !hasIdempotencyKeys(endpoints) {
+		return nil
+	}
+	fileData := GoServerIdempotencyFileData{
+		PackageName: cfg.PackageName,
+	}
+	filePath := filepath.Join(cfg.OutputDir, "idempotency.go")
+	content, err := ExecuteTemplate("serverIdempotencyFile", fileData)
+	if err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+	return formatAndWriteFile(filePath, content)
+}
+
+func generateAndWriteServerAuthFile(cfg *spec.GoServerGeneration, spc *spec.Specification) error {
+	endpoints, err := EndpointsDataFromSpec(spc)
+	if err != nil {
+		return err
+	}
+	fileData := GoServerAuthFileData{
+		PackageName: cfg.PackageName,
+		AuthMethods: usedAuthMethods(endpoints),
+		HasScopes:   hasScopes(endpoints),
+		HasHMAC:     hasHMAC(AuthMethodsFromSpec(spc)),
+	}
+	filePath := filepath.Join(cfg.OutputDir, "auth.go")
+	content, err := ExecuteTemplate("serverAuthFile", fileData)
+	if err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+	return formatAndWriteFile(filePath, content)
+}
+
+// usedAuthMethods returns the auth methods referenced by at least one endpoint, sorted by ID.
+func usedAuthMethods(endpoints []EndpointData) []AuthMethodData {
+	seen := make(map[string]bool)
+	var res []AuthMethodData
+	for _, endpoint := range endpoints {
+		for _, am := range slices.Concat(endpoint.Request.AuthAll, endpoint.Request.AuthAny, endpoint.Request.AuthAlternativeMethods()) {
+			if !seen[am.ID] {
+				seen[am.ID] = true
+				res = append(res, am)
+			}
+		}
+	}
+	sortAuthMethodsByID(&res)
+	return res
+}
+
+// hasScopes reports whether at least one endpoint declares authorization scopes.
+func hasScopes(endpoints []EndpointData) bool {
+	return slices.ContainsFunc(endpoints, func(endpoint EndpointData) bool {
+		return len(endpoint.Request.Scopes) > 0
+	})
+}
+
+// hasIdempotencyKeys reports whether at least one endpoint uses idempotency keys.
+func hasIdempotencyKeys(endpoints []EndpointData) bool {
+	return slices.ContainsFunc(endpoints, func(endpoint EndpointData) bool {
+		return endpoint.Request.IdempotencyKey
+	})
+}
+
+// hasHMAC reports whether any of authMethods is hmac.
+func hasHMAC(authMethods []AuthMethodData) bool {
+	return slices.ContainsFunc(authMethods, func(am AuthMethodData) bool {
+		return am.Type == AuthMethodTypeHMAC
+	})
+}
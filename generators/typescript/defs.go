@@ -1,5 +1,13 @@
 package typescript
 
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nbrglm/napiway/spec"
+)
+
 type TsSdkPackageJsonTemplateData struct {
 	PackageName string
 	Version     string
@@ -16,6 +24,23 @@ type TsSdkModelsFileData struct {
 	Types      []TypeData
 
 	Requests []RequestData
+
+	// The auth methods of the specification, whose default credentials are part of the client config.
+	AuthMethods []AuthMethodData
+
+	// HasHMAC is true if the specification has hmac auth methods, whose params are HMACKeys.
+	HasHMAC bool
+}
+
+// OAuth2Methods returns the oauth2ClientCredentials AuthMethods, whose token providers are part of the client config.
+func (d TsSdkModelsFileData) OAuth2Methods() []AuthMethodData {
+	var methods []AuthMethodData
+	for _, am := range d.AuthMethods {
+		if am.IsOAuth2() {
+			methods = append(methods, am)
+		}
+	}
+	return methods
 }
 
 type TsSdkApiFileData struct {
@@ -25,6 +50,27 @@ type TsSdkApiFileData struct {
 	AuthMethods   []AuthMethodData
 }
 
+// OAuth2Methods returns the oauth2ClientCredentials AuthMethods, which get token providers in the SDK.
+func (d TsSdkApiFileData) OAuth2Methods() []AuthMethodData {
+	var methods []AuthMethodData
+	for _, am := range d.AuthMethods {
+		if am.IsOAuth2() {
+			methods = append(methods, am)
+		}
+	}
+	return methods
+}
+
+// HasRetry reports whether any endpoint has a retry policy, so the client needs the retry helpers.
+func (d TsSdkApiFileData) HasRetry() bool {
+	return slices.ContainsFunc(d.Endpoints, func(e EndpointData) bool { return e.Request.Retry != nil })
+}
+
+// HasHMAC reports whether the specification has hmac auth methods, so the SDK signs requests.
+func (d TsSdkApiFileData) HasHMAC() bool {
+	return slices.ContainsFunc(d.AuthMethods, AuthMethodData.IsHMAC)
+}
+
 type EndpointData struct {
 	Name    string
 	Request RequestData
@@ -49,9 +95,173 @@ type RequestData struct {
 	AuthAll []AuthMethodData
 	AuthAny []AuthMethodData
 
+	// Alternative sets of auth methods, see spec.EndpointAuthentication.Alternatives.
+	AuthAlternatives []AuthAlternativeData
+
+	// Authorization scopes required by the endpoint, see spec.Endpoint.Scopes.
+	Scopes []string
+
+	// Retry policy of the endpoint in the SDK, nil if it is not retried.
+	Retry *RetryData
+
+	// Whether requests carry an Idempotency-Key header, see spec.EndpointIdempotencyKey.
+	IdempotencyKey bool
+
+	// Whether the endpoint is idempotent, see spec.Endpoint.IsIdempotent.
+	Idempotent bool
+
+	// Pagination of the endpoint in the SDK, nil if it is not paginated.
+	Pagination *PaginationData
+
 	Responses []ResponseData
 }
 
+// PaginationData is the pagination of an endpoint, see spec.Pagination.
+type PaginationData struct {
+	// Name of the iterator method of the client, e.g. "listUsersAll".
+	MethodName string
+
+	// "page", "offset" or "cursor".
+	Style string
+
+	// The query param selecting the page.
+	Param ParamData
+
+	// The response holding the items, and the fields of its body.
+	Response   ResponseData
+	Items      TypeFieldData
+	NextCursor *TypeFieldData
+	TotalCount *TypeFieldData
+
+	// Number of the first page, for the page style.
+	FirstPage int64
+}
+
+// ItemsTsType returns the TypeScript type of the items, as referenced from api.ts.
+func (p PaginationData) ItemsTsType() string {
+	switch p.Items.Type {
+	case TypeStrInteger, TypeStrDouble:
+		return "number"
+	case TypeStrString, TypeStrBoolean, TypeStrFreeFormObject:
+		return p.Items.Type
+	default:
+		return "Models." + p.Items.Type
+	}
+}
+
+// AuthAlternativeData is one of the sets of auth methods in RequestData.AuthAlternatives.
+type AuthAlternativeData struct {
+	Methods []AuthMethodData
+
+	// The auth methods of the other alternatives that are not part of this one.
+	Others []AuthMethodData
+}
+
+// ScopesList returns the comma-separated Scopes.
+func (r RequestData) ScopesList() string {
+	return strings.Join(r.Scopes, ", ")
+}
+
+// AuthAlternativeMethods returns the auth methods of all of the AuthAlternatives, without duplicates and sorted by ID.
+func (r RequestData) AuthAlternativeMethods() []AuthMethodData {
+	var methods []AuthMethodData
+	for _, alternative := range r.AuthAlternatives {
+		for _, am := range alternative.Methods {
+			if !slices.ContainsFunc(methods, func(m AuthMethodData) bool { return m.ID == am.ID }) {
+				methods = append(methods, am)
+			}
+		}
+	}
+	sortAuthMethodsByID(&methods)
+	return methods
+}
+
+// RetryData is the retry policy of an endpoint, see spec.RetryPolicy.
+type RetryData struct {
+	MaxAttempts    int
+	StatusCodes    []int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// InitialBackoffMillis returns InitialBackoff in milliseconds, for setTimeout.
+func (r RetryData) InitialBackoffMillis() float64 {
+	return float64(r.InitialBackoff) / float64(time.Millisecond)
+}
+
+// MaxBackoffMillis returns MaxBackoff in milliseconds, for setTimeout.
+func (r RetryData) MaxBackoffMillis() float64 {
+	return float64(r.MaxBackoff) / float64(time.Millisecond)
+}
+
+// AuthMethodIDs returns the IDs of the auth methods of AuthAll, AuthAny and AuthAlternatives.
+func (r RequestData) AuthMethodIDs() []string {
+	var ids []string
+	for _, am := range slices.Concat(r.AuthAll, r.AuthAny, r.AuthAlternativeMethods()) {
+		ids = append(ids, am.ID)
+	}
+	return ids
+}
+
+// HasAuth reports whether the request requires authentication.
+func (r RequestData) HasAuth() bool {
+	return len(r.AuthAll) > 0 || len(r.AuthAny) > 0 || len(r.AuthAlternatives) > 0
+}
+
+// OAuth2Methods returns the oauth2ClientCredentials auth methods of AuthAll, AuthAny and AuthAlternatives,
+// whose tokens the SDK can obtain from token providers.
+func (r RequestData) OAuth2Methods() []AuthMethodData {
+	var methods []AuthMethodData
+	for _, am := range slices.Concat(r.AuthAll, r.AuthAny, r.AuthAlternativeMethods()) {
+		if am.IsOAuth2() && !slices.ContainsFunc(methods, func(m AuthMethodData) bool { return m.ID == am.ID }) {
+			methods = append(methods, am)
+		}
+	}
+	return methods
+}
+
+// HasHMAC reports whether any auth method of the request is hmac, so the request is signed.
+func (r RequestData) HasHMAC() bool {
+	return slices.ContainsFunc(slices.Concat(r.AuthAll, r.AuthAny, r.AuthAlternativeMethods()), AuthMethodData.IsHMAC)
+}
+
+// OAuth2AuthAny returns the oauth2ClientCredentials auth methods of AuthAny.
+func (r RequestData) OAuth2AuthAny() []AuthMethodData {
+	var methods []AuthMethodData
+	for _, am := range r.AuthAny {
+		if am.IsOAuth2() {
+			methods = append(methods, am)
+		}
+	}
+	return methods
+}
+
+// OAuth2AuthAlternatives returns the AuthAlternatives made only of oauth2ClientCredentials auth methods,
+// which the SDK can use when no auth parameter is set.
+func (r RequestData) OAuth2AuthAlternatives() []AuthAlternativeData {
+	var alternatives []AuthAlternativeData
+	for _, alternative := range r.AuthAlternatives {
+		if !slices.ContainsFunc(alternative.Methods, func(m AuthMethodData) bool { return !m.IsOAuth2() }) {
+			alternatives = append(alternatives, alternative)
+		}
+	}
+	return alternatives
+}
+
+// AuthAlternativesDescription describes the AuthAlternatives by the transport names of their auth methods,
+// e.g. "[X-API-Key, X-Session-Token] or [X-Admin-Token]".
+func (r RequestData) AuthAlternativesDescription() string {
+	alternatives := make([]string, len(r.AuthAlternatives))
+	for i, alternative := range r.AuthAlternatives {
+		names := make([]string, len(alternative.Methods))
+		for j, am := range alternative.Methods {
+			names[j] = am.TransportName
+		}
+		alternatives[i] = "[" + strings.Join(names, ", ") + "]"
+	}
+	return strings.Join(alternatives, " or ")
+}
+
 type ResponseData struct {
 	StatusCode  int
 	Name        string
@@ -77,6 +287,24 @@ type TypeData struct {
 
 	Fields []TypeFieldData
 	Enum   []string
+
+	// Extends lists the interfaces the interface extends, see spec.Schema.Extends.
+	Extends []string
+
+	// OneOf lists the variants of a oneOf schema, which is generated as a union discriminated by Discriminator.
+	OneOf []UnionVariantData
+
+	// Discriminator is the exported name of the property telling apart the variants of a oneOf schema.
+	Discriminator string
+}
+
+// UnionVariantData is a variant of a oneOf schema.
+type UnionVariantData struct {
+	// Name of the variant schema, e.g. "EmailNotification".
+	Name string
+
+	// Value of the discriminator property for the variant, e.g. "email".
+	Value string
 }
 
 const (
@@ -92,15 +320,29 @@ type TypeFieldData struct {
 	Description *string
 	Type        string
 	IsArray     bool
+	ArrayDepth  int // number of nested arrays, 0 if IsArray is false
+	IsMap       bool
 	IsEnum      bool
 	Required    bool
 	NonEmpty    bool
 }
 
+// ArraySuffix returns the [] of each of the nested arrays of the field, e.g. [][] for a matrix.
+func (f TypeFieldData) ArraySuffix() string {
+	return strings.Repeat("[]", f.ArrayDepth)
+}
+
 type AuthMethodType string
 
 const (
 	AuthMethodTypeHeader AuthMethodType = "header"
+	AuthMethodTypeBearer AuthMethodType = "bearer"
+	AuthMethodTypeBasic  AuthMethodType = "basic"
+	AuthMethodTypeQuery  AuthMethodType = "query"
+	AuthMethodTypeCookie AuthMethodType = "cookie"
+
+	AuthMethodTypeOAuth2ClientCredentials AuthMethodType = "oauth2ClientCredentials"
+	AuthMethodTypeHMAC                    AuthMethodType = "hmac"
 )
 
 type AuthMethodData struct {
@@ -114,8 +356,49 @@ type AuthMethodData struct {
 
 	Description *string
 
-	// Optional format of the auth method
-	//
-	// For example
+	// Optional format of the auth method, see spec.AuthMethod.Format.
 	Format *string
+
+	// URL of the token endpoint and the requested scopes, only set for oauth2ClientCredentials auth.
+	TokenURL string
+	Scopes   []string
+
+	// Headers, hash algorithm and clock skew window of hmac auth, see spec.AuthMethod.
+	KeyIDHeader     string
+	TimestampHeader string
+	Algorithm       string
+	ClockSkew       time.Duration
+}
+
+// IsOAuth2 reports whether the auth method is oauth2ClientCredentials, whose tokens the SDK can obtain with a token provider.
+func (am AuthMethodData) IsOAuth2() bool {
+	return am.Type == AuthMethodTypeOAuth2ClientCredentials
+}
+
+// IsHMAC reports whether the auth method is hmac, with which the SDK signs requests.
+func (am AuthMethodData) IsHMAC() bool {
+	return am.Type == AuthMethodTypeHMAC
+}
+
+// ParsedFormat returns the enforced format of the auth method, or nil if Format is documentation-only.
+func (am AuthMethodData) ParsedFormat() *spec.AuthFormat {
+	// Format is validated with the specification.
+	format, _ := spec.ParseAuthFormat(am.Format)
+	return format
+}
+
+// TsType returns the TypeScript type of the credential of the auth method.
+func (am AuthMethodData) TsType() string {
+	switch am.Type {
+	case AuthMethodTypeBasic:
+		return "BasicAuthCredentials"
+	case AuthMethodTypeHMAC:
+		return "HMACKey"
+	}
+	return "string"
+}
+
+// WebCryptoAlgorithm returns the Web Crypto name of the hash algorithm of hmac auth, e.g. "SHA-256".
+func (am AuthMethodData) WebCryptoAlgorithm() string {
+	return "SHA-" + strings.TrimPrefix(am.Algorithm, "sha")
 }
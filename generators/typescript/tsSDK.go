@@ -76,8 +76,10 @@ func GenerateTSSDK(genCfg spec.TsSDKGeneration, spc *spec.Specification) (err er
 	modelsFileData := TsSdkModelsFileData{
 		ClientName: clientName,
 
-		Types:    types,
-		Requests: requests,
+		Types:       types,
+		Requests:    requests,
+		AuthMethods: apiFileData.AuthMethods,
+		HasHMAC:     slices.ContainsFunc(apiFileData.AuthMethods, AuthMethodData.IsHMAC),
 	}
 
 	// write models.ts
@@ -266,6 +268,18 @@ func TypesDataFromSpec(specification *spec.Specification) []TypeData {
 			Fields:      getFieldsDataFromSpecFields(schema.Properties, specification.Schemas),
 			Enum:        schema.Enum,
 		}
+		for _, base := range schema.Extends {
+			types[idx].Extends = append(types[idx].Extends, exportedName(base))
+		}
+		if len(schema.OneOf) > 0 {
+			types[idx].Discriminator = exportedName(schema.Discriminator.Property)
+			for _, variant := range schema.OneOf {
+				types[idx].OneOf = append(types[idx].OneOf, UnionVariantData{
+					Name:  exportedName(variant),
+					Value: schema.DiscriminatorValue(variant),
+				})
+			}
+		}
 	}
 	sortTypesByName(&types)
 	return types
@@ -275,6 +289,7 @@ func RequestResponsesDataFromEndpointDef(endpointIdx int, specification *spec.Sp
 	endpoint := specification.Endpoints[endpointIdx]
 
 	var authMethodAll, authMethodAny []AuthMethodData
+	var authAlternatives []AuthAlternativeData
 
 	if endpoint.Auth != nil {
 		var err error
@@ -286,6 +301,10 @@ func RequestResponsesDataFromEndpointDef(endpointIdx int, specification *spec.Sp
 		if err != nil {
 			return RequestData{}, err
 		}
+		authAlternatives, err = getAuthAlternatives(specification, endpoint.Auth.Alternatives)
+		if err != nil {
+			return RequestData{}, err
+		}
 	}
 
 	var reqBodyName *string
@@ -334,22 +353,84 @@ func RequestResponsesDataFromEndpointDef(endpointIdx int, specification *spec.Sp
 	sortResponsesByStatusCode(&responses)
 
 	return RequestData{
-		Name:            exportedName(endpoint.Name + "Req"),
-		Description:     endpoint.Description,
-		Method:          string(endpoint.Method),
-		Path:            endpoint.Path,
-		ContentType:     *endpoint.ContentType,
-		RawBody:         endpoint.RawBody,
-		RequestBodyName: reqBodyName,
-		PathParams:      mapSpecParamToParamData(endpoint.PathParams),
-		QueryParams:     mapSpecParamToParamData(endpoint.QueryParams),
-		HeaderParams:    mapSpecParamToParamData(endpoint.Headers),
-		AuthAll:         authMethodAll,
-		AuthAny:         authMethodAny,
-		Responses:       responses,
+		Name:             exportedName(endpoint.Name + "Req"),
+		Description:      endpoint.Description,
+		Method:           string(endpoint.Method),
+		Path:             endpoint.Path,
+		ContentType:      *endpoint.ContentType,
+		RawBody:          endpoint.RawBody,
+		RequestBodyName:  reqBodyName,
+		PathParams:       mapSpecParamToParamData(endpoint.PathParams),
+		QueryParams:      mapSpecParamToParamData(endpoint.QueryParams),
+		HeaderParams:     mapSpecParamToParamData(endpoint.Headers),
+		AuthAll:          authMethodAll,
+		AuthAny:          authMethodAny,
+		AuthAlternatives: authAlternatives,
+		Scopes:           endpoint.Scopes,
+		Retry:            retryDataFromSpec(specification.EndpointRetryPolicy(endpoint)),
+		IdempotencyKey:   endpoint.Idempotent == spec.EndpointIdempotencyKey,
+		Idempotent:       endpoint.IsIdempotent(),
+		Pagination:       paginationDataFromSpec(specification, endpoint, responses),
+		Responses:        responses,
 	}, nil
 }
 
+// paginationDataFromSpec returns the PaginationData of a validated endpoint, or nil if it is not paginated.
+func paginationDataFromSpec(specification *spec.Specification, endpoint *spec.Endpoint, responses []ResponseData) *PaginationData {
+	p := endpoint.Pagination
+	if p == nil {
+		return nil
+	}
+	paginated := endpoint.PaginatedResponse()
+	data := &PaginationData{
+		MethodName: strings.ToLower(endpoint.Name[:1]) + endpoint.Name[1:] + "All",
+		Style:      string(p.Style),
+		FirstPage:  1,
+	}
+	if p.FirstPage != nil {
+		data.FirstPage = *p.FirstPage
+	}
+	for _, param := range mapSpecParamToParamData(endpoint.QueryParams) {
+		if param.Name == exportedName(p.Param) {
+			data.Param = param
+		}
+	}
+	for _, resp := range responses {
+		if resp.StatusCode == paginated.Status {
+			data.Response = resp
+		}
+	}
+	for _, schema := range specification.Schemas {
+		if schema.Name != *paginated.BodyName {
+			continue
+		}
+		for _, field := range getFieldsDataFromSpecFields(specification.AllProperties(schema), specification.Schemas) {
+			switch {
+			case field.Name == exportedName(p.Items):
+				data.Items = field
+			case p.NextCursor != "" && field.Name == exportedName(p.NextCursor):
+				data.NextCursor = &field
+			case p.TotalCount != "" && field.Name == exportedName(p.TotalCount):
+				data.TotalCount = &field
+			}
+		}
+	}
+	return data
+}
+
+// retryDataFromSpec returns the RetryData of an endpoint's effective retry policy, or nil if it is not retried.
+func retryDataFromSpec(policy *spec.RetryPolicy) *RetryData {
+	if policy == nil {
+		return nil
+	}
+	return &RetryData{
+		MaxAttempts:    policy.MaxAttempts,
+		StatusCodes:    policy.StatusCodes,
+		InitialBackoff: policy.InitialBackoffDuration(),
+		MaxBackoff:     policy.MaxBackoffDuration(),
+	}
+}
+
 func mapSpecParamToParamData(params []spec.Param) []ParamData {
 	resParams := make([]ParamData, len(params))
 	for i, pathParam := range params {
@@ -392,14 +473,7 @@ func getAuthMethods(specification *spec.Specification, ids []string) ([]AuthMeth
 			missingAuths = append(missingAuths, id)
 		} else {
 			auth := specification.Auth[idx]
-			ams = append(ams, AuthMethodData{
-				ID:            auth.ID,
-				Name:          exportedName(auth.Name),
-				TransportName: auth.TransportName,
-				Type:          AuthMethodType(auth.Type),
-				Description:   auth.Description,
-				Format:        auth.Format,
-			})
+			ams = append(ams, authMethodDataFromSpec(auth))
 		}
 	}
 	if len(missingAuths) > 0 {
@@ -410,6 +484,32 @@ func getAuthMethods(specification *spec.Specification, ids []string) ([]AuthMeth
 	return ams, nil
 }
 
+// getAuthAlternatives resolves the alternative sets of auth method ids of an endpoint, keeping their order.
+func getAuthAlternatives(specification *spec.Specification, alternatives [][]string) ([]AuthAlternativeData, error) {
+	res := make([]AuthAlternativeData, len(alternatives))
+	for i, ids := range alternatives {
+		ams, err := getAuthMethods(specification, ids)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = AuthAlternativeData{Methods: ams}
+	}
+	for i := range res {
+		for j, other := range res {
+			if j == i {
+				continue
+			}
+			for _, am := range other.Methods {
+				if !slices.ContainsFunc(slices.Concat(res[i].Methods, res[i].Others), func(m AuthMethodData) bool { return m.ID == am.ID }) {
+					res[i].Others = append(res[i].Others, am)
+				}
+			}
+		}
+		sortAuthMethodsByID(&res[i].Others)
+	}
+	return res, nil
+}
+
 func getFieldsDataFromSpecFields(fields []*spec.SchemaField, schemas []*spec.Schema) []TypeFieldData {
 	if len(fields) == 0 {
 		return nil
@@ -423,6 +523,8 @@ func getFieldsDataFromSpecFields(fields []*spec.SchemaField, schemas []*spec.Sch
 			Description: field.Description,
 			Type:        typ,
 			IsArray:     field.IsArray,
+			ArrayDepth:  field.Dimensions(),
+			IsMap:       field.IsMap,
 			IsEnum:      isEnum,
 			Required:    field.Required,
 			NonEmpty:    field.NonEmpty,
@@ -496,15 +598,25 @@ func IsTypeEnum(isPrimitive bool, typ string, schemas []*spec.Schema) bool {
 func AuthMethodsFromSpec(specification *spec.Specification) []AuthMethodData {
 	authMethods := make([]AuthMethodData, len(specification.Auth))
 	for i, auth := range specification.Auth {
-		authMethods[i] = AuthMethodData{
-			ID:            auth.ID,
-			Name:          exportedName(auth.Name),
-			TransportName: auth.TransportName,
-			Type:          AuthMethodType(auth.Type),
-			Description:   auth.Description,
-			Format:        auth.Format,
-		}
+		authMethods[i] = authMethodDataFromSpec(auth)
 	}
 	sortAuthMethodsByID(&authMethods)
 	return authMethods
 }
+
+func authMethodDataFromSpec(auth spec.AuthMethod) AuthMethodData {
+	return AuthMethodData{
+		ID:              auth.ID,
+		Name:            exportedName(auth.Name),
+		TransportName:   auth.TransportName,
+		Type:            AuthMethodType(auth.Type),
+		Description:     auth.Description,
+		Format:          auth.Format,
+		TokenURL:        auth.TokenURL,
+		Scopes:          auth.Scopes,
+		KeyIDHeader:     auth.KeyIDHeader,
+		TimestampHeader: auth.TimestampHeader,
+		Algorithm:       auth.Algorithm,
+		ClockSkew:       auth.ClockSkewDuration(),
+	}
+}
@@ -36,6 +36,23 @@ func TestSpec(t *testing.T) {
 	runTestForSpec(t, dir, specPath, serverPath, clientPath, tsClientPath)
 }
 
+// TestTypedErrorsSpec tests the Go SDK generated with typedErrors, by the tests of testdata/out/typed-errors,
+// which run the generated server in process.
+func TestTypedErrorsSpec(t *testing.T) {
+	dir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get current working directory: %v", err)
+	}
+	specPath := path.Join(dir, "testdata/typed-errors.yaml")
+	testPath := path.Join(dir, "testdata/out/typed-errors")
+
+	generateTestServerHelpers(t, dir, specPath)
+
+	if err := runCommand(t, testPath, "go", "test", "./..."); err != nil {
+		t.Fatalf("%s TEST FAILED: %v", t.Name(), err)
+	}
+}
+
 func runTestForSpec(t *testing.T, workDir, specPath, serverPath, clientPath, tsClientPath string) {
 	generateTestServerHelpers(t, workDir, specPath)
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
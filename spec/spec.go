@@ -2,8 +2,12 @@ package spec
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
 	"slices"
 	"strings"
+	"time"
 	"unicode"
 )
 
@@ -42,6 +46,11 @@ type Specification struct {
 	// All possible authentication methods for the API.
 	Auth []AuthMethod `yaml:"auth,omitempty"`
 
+	// Default retry policy of the generated SDKs, for the idempotent endpoints.
+	//
+	// If omitted, the SDKs only retry the endpoints with their own retry policy.
+	Retry *RetryPolicy `yaml:"retry,omitempty"`
+
 	// List of endpoint definitions.
 	//
 	// This will be used in code generation and documentation.
@@ -61,14 +70,58 @@ func (s *Specification) Validate() error {
 		return fmt.Errorf("version is required")
 	}
 
+	if err := s.hoistInlineObjects(); err != nil {
+		return err
+	}
+
+	for _, schema := range s.Schemas {
+		if err := schema.Validate(); err != nil {
+			return fmt.Errorf("schema %s: %w", schema.Name, err)
+		}
+	}
+
+	// Extended schemas are checked first, since AllProperties relies on them
+	for _, schema := range s.Schemas {
+		if len(schema.Extends) == 0 {
+			continue
+		}
+		if err := s.validateExtends(schema); err != nil {
+			return fmt.Errorf("schema %s: %w", schema.Name, err)
+		}
+	}
+
+	if s.Retry != nil {
+		if err := s.Retry.Validate(); err != nil {
+			return fmt.Errorf("retry: %w", err)
+		}
+	}
+
 	for _, endpoint := range s.Endpoints {
 		if err := endpoint.Validate(s.Auth); err != nil {
 			return fmt.Errorf("endpoint %s: %w", endpoint.Name, err)
 		}
+		// The backoffs may come from the endpoint, the specification or the defaults
+		if policy := s.EndpointRetryPolicy(endpoint); policy != nil && policy.InitialBackoffDuration() > policy.MaxBackoffDuration() {
+			return fmt.Errorf("endpoint %s: retry: initialBackoff %s must not exceed maxBackoff %s", endpoint.Name, policy.InitialBackoff, policy.MaxBackoff)
+		}
+		if endpoint.Pagination != nil {
+			if err := s.validatePagination(endpoint); err != nil {
+				return fmt.Errorf("endpoint %s: pagination: %w", endpoint.Name, err)
+			}
+		}
 	}
 
-	for i, am := range s.Auth {
-		if err := am.Validate(); err != nil {
+	for _, schema := range s.Schemas {
+		if len(schema.OneOf) == 0 {
+			continue
+		}
+		if err := s.validateOneOf(schema); err != nil {
+			return fmt.Errorf("schema %s: %w", schema.Name, err)
+		}
+	}
+
+	for i := range s.Auth {
+		if err := s.Auth[i].Validate(); err != nil {
 			return fmt.Errorf("auth method %d: %w", i, err)
 		}
 	}
@@ -81,6 +134,15 @@ func (s *Specification) Validate() error {
 		if err := s.GoServer.Validate(); err != nil {
 			return fmt.Errorf("invalid goServer configuration: %w", err)
 		}
+		if s.GoServer.ErrorSchema != nil {
+			errorSchema := s.SchemaByName(*s.GoServer.ErrorSchema)
+			if errorSchema == nil {
+				return fmt.Errorf("invalid goServer configuration: errorSchema %s is not defined in schemas", *s.GoServer.ErrorSchema)
+			}
+			if !errorSchema.IsObject() {
+				return fmt.Errorf("invalid goServer configuration: errorSchema %s must be an object schema", *s.GoServer.ErrorSchema)
+			}
+		}
 	}
 
 	if s.GoSDK != nil {
@@ -98,6 +160,172 @@ func (s *Specification) Validate() error {
 	return nil
 }
 
+// hoistInlineObjects replaces the inline objects of the schema fields with schemas named after their schema and field,
+// e.g. UserAddress for the Address field of User, appended to the Schemas.
+func (s *Specification) hoistInlineObjects() error {
+	// The hoisted schemas are appended while iterating, so that the inline objects they contain are hoisted too
+	for i := 0; i < len(s.Schemas); i++ {
+		schema := s.Schemas[i]
+		if schema == nil {
+			continue
+		}
+		for _, field := range schema.Properties {
+			if field == nil || len(field.Properties) == 0 {
+				continue
+			}
+			if field.Type != "" {
+				return fmt.Errorf("schema %s: property %s: type cannot be set with properties, which define an inline object", schema.Name, field.Name)
+			}
+			name := strings.TrimSpace(schema.Name) + strings.TrimSpace(field.Name)
+			if s.SchemaByName(name) != nil {
+				return fmt.Errorf("schema %s: property %s: the inline object is hoisted as %s, which is already a schema", schema.Name, field.Name, name)
+			}
+			s.Schemas = append(s.Schemas, &Schema{
+				Name:        name,
+				Description: field.Description,
+				Properties:  field.Properties,
+			})
+			field.Type = SchemaFieldType(name)
+			field.Properties = nil
+		}
+	}
+	return nil
+}
+
+// validateOneOf checks the variants and the discriminator of a OneOf schema.
+func (s *Specification) validateOneOf(schema *Schema) error {
+	d := schema.Discriminator
+	if d == nil {
+		return fmt.Errorf("discriminator is required with oneOf")
+	}
+	d.Property = strings.TrimSpace(d.Property)
+	if !isValidGoIdentifier(d.Property) {
+		return fmt.Errorf("discriminator: property '%s' is not a valid identifier", d.Property)
+	}
+	if len(schema.OneOf) < 2 {
+		return fmt.Errorf("oneOf must list at least two schemas")
+	}
+
+	values := make(map[string]bool)
+	for i, name := range schema.OneOf {
+		if slices.Contains(schema.OneOf[:i], name) {
+			return fmt.Errorf("oneOf: %s is listed twice", name)
+		}
+		variant := s.SchemaByName(name)
+		if variant == nil {
+			return fmt.Errorf("oneOf: %s is not defined in schemas", name)
+		}
+		if !variant.IsObject() {
+			return fmt.Errorf("oneOf: %s is not an object schema", name)
+		}
+		if slices.ContainsFunc(s.AllProperties(variant), func(sf *SchemaField) bool { return strings.EqualFold(sf.Name, d.Property) }) {
+			return fmt.Errorf("oneOf: %s must not have the discriminator property %s", name, d.Property)
+		}
+		for _, other := range s.Schemas {
+			if other != schema && slices.Contains(other.OneOf, name) {
+				return fmt.Errorf("oneOf: %s is already a variant of %s", name, other.Name)
+			}
+			if slices.Contains(other.Extends, name) {
+				return fmt.Errorf("oneOf: %s is extended by %s", name, other.Name)
+			}
+		}
+		value := schema.DiscriminatorValue(name)
+		if values[value] {
+			return fmt.Errorf("discriminator: value %s is used by two variants", value)
+		}
+		values[value] = true
+	}
+	for value, name := range d.Mapping {
+		if strings.TrimSpace(value) == "" {
+			return fmt.Errorf("discriminator: mapping values cannot be empty")
+		}
+		if !slices.Contains(schema.OneOf, name) {
+			return fmt.Errorf("discriminator: mapping %s: %s is not listed in oneOf", value, name)
+		}
+		if schema.DiscriminatorValue(name) != value {
+			return fmt.Errorf("discriminator: mapping %s: %s is mapped twice", value, name)
+		}
+	}
+
+	return nil
+}
+
+// validateExtends checks the schemas extended by schema, and that the properties it inherits do not conflict.
+func (s *Specification) validateExtends(schema *Schema) error {
+	for i, name := range schema.Extends {
+		if slices.Contains(schema.Extends[:i], name) {
+			return fmt.Errorf("extends: %s is listed twice", name)
+		}
+		base := s.SchemaByName(name)
+		if base == nil {
+			return fmt.Errorf("extends: %s is not defined in schemas", name)
+		}
+		if !base.IsObject() {
+			return fmt.Errorf("extends: %s is not an object schema", name)
+		}
+	}
+
+	// Each property comes from a single schema, so that the Go embedded structs have no ambiguous fields
+	declaredBy := make(map[string]string)
+	walking := make(map[string]bool)
+	var collect func(sc *Schema) error
+	collect = func(sc *Schema) error {
+		if sc == nil {
+			// An undefined schema, reported when the schema extending it is checked
+			return nil
+		}
+		if walking[sc.Name] {
+			return fmt.Errorf("extends: %s extends itself", sc.Name)
+		}
+		walking[sc.Name] = true
+		defer delete(walking, sc.Name)
+		for _, name := range sc.Extends {
+			if err := collect(s.SchemaByName(name)); err != nil {
+				return err
+			}
+		}
+		for _, prop := range sc.Properties {
+			key := strings.ToLower(prop.Name)
+			if other, ok := declaredBy[key]; ok {
+				if other == sc.Name {
+					return fmt.Errorf("property %s is inherited from %s twice", prop.Name, sc.Name)
+				}
+				return fmt.Errorf("property %s of %s conflicts with the one of %s", prop.Name, sc.Name, other)
+			}
+			declaredBy[key] = sc.Name
+		}
+		return nil
+	}
+	return collect(schema)
+}
+
+// AllProperties returns the properties of schema, with those it inherits from the schemas it extends first.
+func (s *Specification) AllProperties(schema *Schema) []*SchemaField {
+	var props []*SchemaField
+	for _, name := range schema.Extends {
+		if base := s.SchemaByName(name); base != nil {
+			props = append(props, s.AllProperties(base)...)
+		}
+	}
+	return append(props, schema.Properties...)
+}
+
+// InheritedProperties returns the properties schema inherits from the schemas it extends.
+func (s *Specification) InheritedProperties(schema *Schema) []*SchemaField {
+	all := s.AllProperties(schema)
+	return all[:len(all)-len(schema.Properties)]
+}
+
+// SchemaByName returns the schema with the given name, or nil if it is not defined.
+func (s *Specification) SchemaByName(name string) *Schema {
+	for _, schema := range s.Schemas {
+		if schema.Name == name {
+			return schema
+		}
+	}
+	return nil
+}
+
 type Schema struct {
 	// Name of the schema, e.g., "User", "Post", etc.
 	Name string `yaml:"name"`
@@ -108,12 +336,58 @@ type Schema struct {
 	// The fields of the schema
 	Properties []*SchemaField `yaml:"properties,omitempty"`
 
+	// The object schemas whose properties this schema inherits, e.g. [BaseEntity].
+	//
+	// Generates embedded structs in Go, and extended interfaces in TypeScript.
+	// Inherited properties cannot be declared again, by the schema or by another of the schemas it extends.
+	Extends []string `yaml:"extends,omitempty"`
+
 	// Mutually-exclusive with Properties.
 	//
 	// If this is set, Properties must be empty.
 	//
 	// Generates an enum type in the generated code, where the possible values are specified in this Enum field and the name is specified in the Name field.
 	Enum []string `yaml:"enum,omitempty"`
+
+	// Mutually-exclusive with Properties and Enum.
+	//
+	// If this is set, the schema is one of the object schemas listed, told apart by their Discriminator property.
+	// Generates an interface implemented by the variants in Go, and a discriminated union in TypeScript.
+	//
+	// It can be the type of fields, single, arrays or maps, but not the body of requests or responses,
+	// nor the type of fields with nested arrays (ArrayDepth > 1).
+	OneOf []string `yaml:"oneOf,omitempty"`
+
+	// The discriminator of a OneOf schema, required if OneOf is set.
+	Discriminator *Discriminator `yaml:"discriminator,omitempty"`
+}
+
+// Discriminator tells apart the variants of a OneOf schema in JSON.
+type Discriminator struct {
+	// Property holding the value of the variant in the JSON objects, e.g. "Kind".
+	//
+	// Always PascalCase, like the schema fields. It must not be a property of the variants, since the generated code sets it.
+	Property string `yaml:"property"`
+
+	// Values of the variants, e.g. {"email": "EmailNotification"}.
+	//
+	// The value of a variant that is not mapped is its schema name.
+	Mapping map[string]string `yaml:"mapping,omitempty"`
+}
+
+// IsObject reports whether the schema has properties, rather than being an enum or a OneOf schema.
+func (s *Schema) IsObject() bool {
+	return len(s.Enum) == 0 && len(s.OneOf) == 0
+}
+
+// DiscriminatorValue returns the value of the Discriminator property for variant, one of the OneOf schemas.
+func (s *Schema) DiscriminatorValue(variant string) string {
+	for value, name := range s.Discriminator.Mapping {
+		if name == variant {
+			return value
+		}
+	}
+	return variant
 }
 
 func (s *Schema) Validate() error {
@@ -124,12 +398,21 @@ func (s *Schema) Validate() error {
 	if s.Name == "" {
 		return fmt.Errorf("name is required for schema")
 	}
-	if len(s.Properties) == 0 && len(s.Enum) == 0 {
-		return fmt.Errorf("Either one property or enum values must be provided for schema %s", s.Name)
+	if len(s.Properties) == 0 && len(s.Extends) == 0 && len(s.Enum) == 0 && len(s.OneOf) == 0 {
+		return fmt.Errorf("Either one property, extended schemas, enum values or oneOf schemas must be provided for schema %s", s.Name)
+	}
+	if len(s.Extends) > 0 && (len(s.Enum) > 0 || len(s.OneOf) > 0) {
+		return fmt.Errorf("Extends cannot be set with Enum or OneOf for schema %s", s.Name)
 	}
 	if len(s.Properties) > 0 && len(s.Enum) > 0 {
 		return fmt.Errorf("Properties and Enum cannot both be set for schema %s", s.Name)
 	}
+	if len(s.OneOf) > 0 && (len(s.Properties) > 0 || len(s.Enum) > 0) {
+		return fmt.Errorf("OneOf cannot be set with Properties or Enum for schema %s", s.Name)
+	}
+	if len(s.OneOf) == 0 && s.Discriminator != nil {
+		return fmt.Errorf("discriminator is only allowed with oneOf for schema %s", s.Name)
+	}
 	if len(s.Properties) > 0 {
 		for _, prop := range s.Properties {
 			if err := prop.Validate(); err != nil {
@@ -207,20 +490,45 @@ type SchemaField struct {
 	// For primitive types, this must be one of "string", "int", "double", "boolean", or "freeFormObject".
 	//
 	// For custom types, this can be the name of another schema defined in the Schemas section.
+	//
+	// Omitted for inline objects, defined by Properties.
 	Type SchemaFieldType `yaml:"type"`
 
+	// Properties of an inline object, if the field is one.
+	//
+	// Inline objects are hoisted into a schema named after the schema and the field, e.g. UserAddress for the Address field of User,
+	// which becomes the Type of the field.
+	Properties []*SchemaField `yaml:"properties,omitempty"`
+
 	// Indicates whether the field is an array of the specified type
 	IsArray bool `yaml:"isArray,omitempty"`
 
+	// Number of nested arrays of an isArray field, e.g. 2 for a matrix ([][]double). Defaults to 1.
+	ArrayDepth int `yaml:"arrayDepth,omitempty"`
+
+	// Indicates whether the field is a map of string keys to values of the specified type
+	//
+	// Cannot be combined with isArray, nor used with "freeFormObject", which is already a map.
+	IsMap bool `yaml:"isMap,omitempty"`
+
 	// Indicates whether the field is required
 	Required bool `yaml:"required,omitempty"`
 
-	// For string and array types, indicates whether the field must be non-empty
+	// For string, array and map types, indicates whether the field must be non-empty
 	//
 	// If it is an array of strings, this means the array elements must be non-empty (i.e. non-empty strings, etc.)
+	// For maps, it means at least one key, and for maps of strings, non-empty values.
 	NonEmpty bool `yaml:"nonEmpty,omitempty"`
 }
 
+// Dimensions returns the number of nested arrays of the field, 0 if it is not an array.
+func (sf *SchemaField) Dimensions() int {
+	if !sf.IsArray {
+		return 0
+	}
+	return max(sf.ArrayDepth, 1)
+}
+
 func (sf *SchemaField) Validate() error {
 	if sf == nil {
 		return fmt.Errorf("schema field is nil")
@@ -230,13 +538,29 @@ func (sf *SchemaField) Validate() error {
 		return fmt.Errorf("name is required for schema field")
 	}
 
+	if sf.ArrayDepth < 0 {
+		return fmt.Errorf("arrayDepth cannot be negative")
+	}
+	if sf.ArrayDepth > 0 && !sf.IsArray {
+		return fmt.Errorf("arrayDepth requires isArray")
+	}
+
+	if sf.IsMap {
+		if sf.IsArray {
+			return fmt.Errorf("isMap cannot be combined with isArray")
+		}
+		if sf.Type == SchemaFieldTypeFreeFormObject {
+			return fmt.Errorf("isMap cannot be used with type %s, which is already a map", sf.Type)
+		}
+	}
+
 	if sf.NonEmpty {
-		if !sf.IsArray {
+		if !sf.IsArray && !sf.IsMap {
 			switch sf.Type {
 			case SchemaFieldTypeString:
 				break
 			default:
-				return fmt.Errorf("nonEmpty is only applicable for string and array types, not for type %s", sf.Type)
+				return fmt.Errorf("nonEmpty is only applicable for string, array and map types, not for type %s", sf.Type)
 			}
 		}
 
@@ -259,6 +583,9 @@ func (sf *SchemaField) Validate() error {
 // The generated files:
 // - helpers.go -> Contains the Per-Endpoint Request DecodeAndValidate functions for the API endpoints.
 // - models.go -> Contains the models for request and per-status response bodies.
+// - routes.go -> Contains the Handler interface and RegisterRoutes, which wires every endpoint to an http.ServeMux.
+// - auth.go -> Contains the Principal type and the per auth method Authenticator interfaces and context accessors.
+// - errors.go -> Contains the ParseError returned for requests that fail parsing, and its HTTP status mapping.
 type GoServerGeneration struct {
 	// OutputDir is the directory where the generated server code will be saved
 	OutputDir string `yaml:"outputDir"`
@@ -267,6 +594,12 @@ type GoServerGeneration struct {
 	//
 	// Usually, this is same as the last part of the output directory path.
 	PackageName string `yaml:"packageName"`
+
+	// ErrorSchema is the name of the schema used as the body of responses to requests that fail parsing.
+	//
+	// If set, the generated Handler interface requires a RenderParseError method returning this schema,
+	// otherwise the *ValidationError is written as the JSON body.
+	ErrorSchema *string `yaml:"errorSchema,omitempty"`
 }
 
 func (g *GoServerGeneration) Validate() error {
@@ -307,6 +640,10 @@ type GoSDKGeneration struct {
 	//
 	// If not specified, the generator will not add a LICENSE file.
 	LicenseFile *string `yaml:"licenseFile,omitempty"`
+
+	// TypedErrors makes the endpoint methods return the 4xx and 5xx responses of the spec as typed errors
+	// (e.g. *CreateUser400Error), instead of in the Response<Code> fields of their results.
+	TypedErrors bool `yaml:"typedErrors,omitempty"`
 }
 
 func (g *GoSDKGeneration) Validate() error {
@@ -405,12 +742,27 @@ const (
 //
 // In the above example, the endpoint requires the "apiKey" authentication method to be satisfied,
 // and at least one of "refreshToken" or "sessionToken" to be satisfied.
+//
+// Requirements that cannot be expressed with `All` and `Any` use `Alternatives` instead, e.g.
+//
+//	auth:
+//	  alternatives:
+//	    - ["apiKey", "sessionToken"]
+//	    - ["adminToken"]
+//
+// requires either both "apiKey" and "sessionToken", or "adminToken" alone.
 type EndpointAuthentication struct {
 	// List of IDs of authentication methods that must be satisfied for this endpoint.
 	All []string `yaml:"all"`
 
 	// List of IDs of authentication methods where at least one must be satisfied for this endpoint.
 	Any []string `yaml:"any"`
+
+	// Sets of IDs of authentication methods, where all of the methods of at least one set must be satisfied.
+	//
+	// Sets are tried in order, so the first one satisfied by a request is the one reported to the server.
+	// Cannot be combined with `All` or `Any`.
+	Alternatives [][]string `yaml:"alternatives,omitempty"`
 }
 
 func (ea *EndpointAuthentication) Validate(globalAuth []AuthMethod) error {
@@ -431,13 +783,81 @@ func (ea *EndpointAuthentication) Validate(globalAuth []AuthMethod) error {
 			return fmt.Errorf("any: unknown auth method: %s", id)
 		}
 	}
+	if len(ea.Alternatives) > 0 && (len(ea.All) > 0 || len(ea.Any) > 0) {
+		return fmt.Errorf("alternatives cannot be combined with all or any")
+	}
+	for i, alternative := range ea.Alternatives {
+		if len(alternative) == 0 {
+			return fmt.Errorf("alternatives[%d]: at least one auth method is required", i)
+		}
+		for j, id := range alternative {
+			if !slices.Contains(authIDs, id) {
+				return fmt.Errorf("alternatives[%d]: unknown auth method: %s", i, id)
+			}
+			if slices.Contains(alternative[:j], id) {
+				return fmt.Errorf("alternatives[%d]: duplicate auth method: %s", i, id)
+			}
+		}
+		for j := range i {
+			if sameAuthMethods(ea.Alternatives[j], alternative) {
+				return fmt.Errorf("alternatives[%d]: same auth methods as alternatives[%d]", i, j)
+			}
+		}
+	}
 	return nil
 }
 
+// sameAuthMethods reports whether two alternatives contain the same auth method IDs, in any order.
+func sameAuthMethods(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, id := range a {
+		if !slices.Contains(b, id) {
+			return false
+		}
+	}
+	return true
+}
+
 type AuthMethodType string
 
 const (
+	// The credential is the value of the header named TransportName.
 	AuthMethodHeader AuthMethodType = "header"
+
+	// The credential is a token sent as "Bearer <token>" in the header named TransportName (default: Authorization).
+	AuthMethodBearer AuthMethodType = "bearer"
+
+	// The credential is a username and password sent as "Basic <base64(username:password)>"
+	// in the header named TransportName (default: Authorization).
+	AuthMethodBasic AuthMethodType = "basic"
+
+	// The credential is the value of the query parameter named TransportName.
+	AuthMethodQuery AuthMethodType = "query"
+
+	// The credential is the value of the cookie named TransportName.
+	AuthMethodCookie AuthMethodType = "cookie"
+
+	// The credential is an OAuth2 access token obtained with the client credentials grant from TokenURL,
+	// sent as "Bearer <token>" in the header named TransportName (default: Authorization).
+	//
+	// The generated SDKs can fetch, cache and refresh these tokens themselves.
+	AuthMethodOAuth2ClientCredentials AuthMethodType = "oauth2ClientCredentials"
+
+	// The request is signed with a secret key: TransportName (default: X-Signature) is the hex-encoded HMAC of
+	// the method, path, signing time and body hash of the request, sent with the ID of the key in KeyIDHeader
+	// and the signing time in TimestampHeader.
+	//
+	// The generated SDKs sign requests, and the generated server verifies the signature and rejects requests
+	// signed more than ClockSkew away from its clock.
+	AuthMethodHMAC AuthMethodType = "hmac"
+)
+
+// Hash algorithms of hmac auth methods.
+const (
+	HMACAlgorithmSHA256 = "sha256"
+	HMACAlgorithmSHA512 = "sha512"
 )
 
 type AuthMethod struct {
@@ -447,20 +867,90 @@ type AuthMethod struct {
 	// Name of the header to be used for authentication.
 	Name string `yaml:"name"`
 
-	// Name of the transport (e.g. header name)
+	// Name of the transport, i.e. the header, query parameter or cookie name depending on Type.
 	//
-	// This must be the name you want to use for the header in the actual HTTP request.
+	// This must be the name you want to use in the actual HTTP request.
+	// Optional for bearer, basic and oauth2ClientCredentials, which default to the Authorization header,
+	// and for hmac, where it is the signature header (default: X-Signature).
 	TransportName string `yaml:"transportName"`
 
 	Type        AuthMethodType `yaml:"type"`
 	Description *string        `yaml:"description,omitempty"`
 
-	// Optional format string for the header value.
+	// Optional format of the credential value, after the scheme of bearer auth.
 	//
-	// Freeform text, e.g., "Bearer {token}", "Token {token}", "jwt" etc.
+	// Enforced by the generated server, which rejects credentials that do not match and strips the format
+	// to yield the bare credential, if it is either:
+	//   - a template with a single "{token}" placeholder, e.g. "Token {token}", which the SDKs also apply
+	//     to credentials given without the surrounding text.
+	//   - a regular expression anchored with "^" and "$", e.g. "^sk_(?P<token>[a-z0-9]+)$", whose "token"
+	//     group (or whole match, without one) is the credential.
 	//
-	// NOT ENFORCED, just for documentation purposes.
+	// Any other text, e.g. "jwt", is only used for documentation. Not supported for basic and oauth2ClientCredentials auth.
 	Format *string `yaml:"format,omitempty"`
+
+	// URL of the OAuth2 token endpoint. Required for, and only used by, oauth2ClientCredentials auth.
+	TokenURL string `yaml:"tokenUrl,omitempty"`
+
+	// OAuth2 scopes requested from TokenURL, if any. Only used by oauth2ClientCredentials auth.
+	Scopes []string `yaml:"scopes,omitempty"`
+
+	// Header with the ID of the signing key. Only used by hmac auth (default: X-Signature-Key-Id).
+	KeyIDHeader string `yaml:"keyIdHeader,omitempty"`
+
+	// Header with the signing time, in Unix seconds. Only used by hmac auth (default: X-Signature-Timestamp).
+	TimestampHeader string `yaml:"timestampHeader,omitempty"`
+
+	// Hash algorithm of the signature and body hash, sha256 or sha512. Only used by hmac auth (default: sha256).
+	Algorithm string `yaml:"algorithm,omitempty"`
+
+	// Maximum difference between the signing time and the server's clock, as a Go duration, e.g. "30s".
+	// Only used by hmac auth (default: 5m).
+	ClockSkew string `yaml:"clockSkew,omitempty"`
+}
+
+// ClockSkewDuration returns the parsed ClockSkew of a validated hmac auth method.
+func (am AuthMethod) ClockSkewDuration() time.Duration {
+	d, _ := time.ParseDuration(am.ClockSkew)
+	return d
+}
+
+// AuthFormat is the enforced form of AuthMethod.Format.
+type AuthFormat struct {
+	// The format as written in the specification.
+	Format string
+
+	// Text before and after the "{token}" placeholder of a template format.
+	Prefix string
+	Suffix string
+
+	// Regular expression of a pattern format, empty for a template format.
+	Pattern string
+}
+
+// ParseAuthFormat parses an AuthMethod.Format.
+//
+// Returns nil for a nil or documentation-only format, and an error for an invalid template or pattern.
+func ParseAuthFormat(format *string) (*AuthFormat, error) {
+	if format == nil {
+		return nil, nil
+	}
+	f := *format
+	if strings.HasPrefix(f, "^") && strings.HasSuffix(f, "$") {
+		if _, err := regexp.Compile(f); err != nil {
+			return nil, fmt.Errorf("invalid format pattern: %w", err)
+		}
+		return &AuthFormat{Format: f, Pattern: f}, nil
+	}
+	switch strings.Count(f, "{token}") {
+	case 0:
+		return nil, nil
+	case 1:
+		prefix, suffix, _ := strings.Cut(f, "{token}")
+		return &AuthFormat{Format: f, Prefix: prefix, Suffix: suffix}, nil
+	default:
+		return nil, fmt.Errorf("format %q must contain a single {token} placeholder", f)
+	}
 }
 
 func (am *AuthMethod) Validate() error {
@@ -468,11 +958,68 @@ func (am *AuthMethod) Validate() error {
 		return fmt.Errorf("name is required")
 	}
 	switch am.Type {
-	case AuthMethodHeader:
-		// valid
+	case AuthMethodHeader, AuthMethodQuery, AuthMethodCookie:
+		if am.TransportName == "" {
+			return fmt.Errorf("transportName is required for %s auth", am.Type)
+		}
+	case AuthMethodBearer, AuthMethodBasic, AuthMethodOAuth2ClientCredentials:
+		if am.TransportName == "" {
+			am.TransportName = "Authorization"
+		}
+	case AuthMethodHMAC:
+		if am.TransportName == "" {
+			am.TransportName = "X-Signature"
+		}
 	default:
 		return fmt.Errorf("invalid type: %s", am.Type)
 	}
+	format, err := ParseAuthFormat(am.Format)
+	if err != nil {
+		return err
+	}
+	if format != nil && (am.Type == AuthMethodBasic || am.Type == AuthMethodOAuth2ClientCredentials || am.Type == AuthMethodHMAC) {
+		return fmt.Errorf("format is not supported for %s auth", am.Type)
+	}
+	if am.Type == AuthMethodOAuth2ClientCredentials {
+		tokenURL, err := url.Parse(am.TokenURL)
+		if err != nil || (tokenURL.Scheme != "http" && tokenURL.Scheme != "https") || tokenURL.Host == "" {
+			return fmt.Errorf("tokenUrl must be an absolute http(s) URL for %s auth", am.Type)
+		}
+		for i, scope := range am.Scopes {
+			if scope == "" || strings.ContainsAny(scope, " \t\n") {
+				return fmt.Errorf("scopes[%d]: must be non-empty and cannot contain whitespace", i)
+			}
+		}
+	} else if am.TokenURL != "" || len(am.Scopes) > 0 {
+		return fmt.Errorf("tokenUrl and scopes are only supported for %s auth", AuthMethodOAuth2ClientCredentials)
+	}
+	if am.Type == AuthMethodHMAC {
+		if am.KeyIDHeader == "" {
+			am.KeyIDHeader = "X-Signature-Key-Id"
+		}
+		if am.TimestampHeader == "" {
+			am.TimestampHeader = "X-Signature-Timestamp"
+		}
+		headers := []string{http.CanonicalHeaderKey(am.TransportName), http.CanonicalHeaderKey(am.KeyIDHeader), http.CanonicalHeaderKey(am.TimestampHeader)}
+		if headers[0] == headers[1] || headers[0] == headers[2] || headers[1] == headers[2] {
+			return fmt.Errorf("transportName, keyIdHeader and timestampHeader must be different headers for %s auth", am.Type)
+		}
+		switch am.Algorithm {
+		case "":
+			am.Algorithm = HMACAlgorithmSHA256
+		case HMACAlgorithmSHA256, HMACAlgorithmSHA512:
+		default:
+			return fmt.Errorf("invalid algorithm: %s, must be %s or %s", am.Algorithm, HMACAlgorithmSHA256, HMACAlgorithmSHA512)
+		}
+		if am.ClockSkew == "" {
+			am.ClockSkew = "5m"
+		}
+		if d, err := time.ParseDuration(am.ClockSkew); err != nil || d < time.Second {
+			return fmt.Errorf("clockSkew must be a duration of at least 1s, e.g. \"5m\"")
+		}
+	} else if am.KeyIDHeader != "" || am.TimestampHeader != "" || am.Algorithm != "" || am.ClockSkew != "" {
+		return fmt.Errorf("keyIdHeader, timestampHeader, algorithm and clockSkew are only supported for %s auth", AuthMethodHMAC)
+	}
 	return nil
 }
 
@@ -523,6 +1070,11 @@ type Endpoint struct {
 	// If omitted, the endpoint does not require authentication.
 	Auth *EndpointAuthentication `yaml:"auth,omitempty"`
 
+	// Authorization scopes (or roles) the authenticated caller must have to use this endpoint, e.g. "users:write".
+	//
+	// The generated server passes them to the ScopeChecker after authentication, so requires Auth.
+	Scopes []string `yaml:"scopes,omitempty"`
+
 	// The body for the endpoint
 	//
 	// Pass the name of the body schema defined in the Schemas section, if any.
@@ -539,8 +1091,41 @@ type Endpoint struct {
 
 	// List of responses
 	Responses []*Response `yaml:"responses,omitempty"`
+
+	// Marks the endpoint as safe to retry: "true", or "key" to make it safe with an Idempotency-Key header.
+	// GET, PUT and DELETE endpoints are idempotent regardless.
+	Idempotent EndpointIdempotency `yaml:"idempotent,omitempty"`
+
+	// Retry policy of the generated SDKs for this endpoint. Its fields override those of the specification's retry policy.
+	//
+	// Only used if the endpoint is idempotent.
+	Retry *RetryPolicy `yaml:"retry,omitempty"`
+
+	// Pagination of the endpoint, for which the SDKs generate iterators over the items of all its pages.
+	Pagination *Pagination `yaml:"pagination,omitempty"`
+}
+
+// IsIdempotent reports whether the endpoint can be retried: it is marked idempotent, or its method is.
+func (e *Endpoint) IsIdempotent() bool {
+	return e.Idempotent == EndpointIdempotencyTrue || e.Idempotent == EndpointIdempotencyKey ||
+		e.Method == EndpointMethodGet || e.Method == EndpointMethodPut || e.Method == EndpointMethodDelete
 }
 
+// EndpointIdempotency is the idempotent value of an endpoint, which tells the SDKs whether it can be retried.
+type EndpointIdempotency string
+
+const (
+	// The endpoint is idempotent if its method is: GET, PUT or DELETE. Same as omitting idempotent.
+	EndpointIdempotencyFalse EndpointIdempotency = "false"
+
+	// The endpoint is idempotent, and retried by the SDKs like GET, PUT and DELETE endpoints.
+	EndpointIdempotencyTrue EndpointIdempotency = "true"
+
+	// The endpoint is made idempotent with an Idempotency-Key header: the SDKs send a new key with each call,
+	// reused across its retries, and the Go server answers repeated keys with the stored response of the first request.
+	EndpointIdempotencyKey EndpointIdempotency = "key"
+)
+
 func (e *Endpoint) Validate(authMethods []AuthMethod) error {
 	if e == nil {
 		return fmt.Errorf("endpoint is nil")
@@ -597,12 +1182,298 @@ func (e *Endpoint) Validate(authMethods []AuthMethod) error {
 		}
 	}
 
+	for i, scope := range e.Scopes {
+		if strings.TrimSpace(scope) == "" || strings.ContainsAny(scope, " \t\n") {
+			return fmt.Errorf("scopes[%d]: must be non-empty and cannot contain whitespace", i)
+		}
+		if slices.Contains(e.Scopes[:i], scope) {
+			return fmt.Errorf("scopes[%d]: duplicate scope: %s", i, scope)
+		}
+	}
+	if len(e.Scopes) > 0 && e.Auth == nil {
+		return fmt.Errorf("scopes require auth")
+	}
+
 	if e.RawBody && e.BodyName != nil {
 		return fmt.Errorf("rawBody cannot be true if bodyName is specified")
 	}
+
+	switch e.Idempotent {
+	case "", EndpointIdempotencyFalse, EndpointIdempotencyTrue:
+	case EndpointIdempotencyKey:
+		if e.Method == EndpointMethodGet {
+			return fmt.Errorf("idempotent: key is not supported for GET endpoints, which are idempotent")
+		}
+		if e.RawBody {
+			return fmt.Errorf("idempotent: key is not supported for rawBody endpoints")
+		}
+	default:
+		return fmt.Errorf("invalid idempotent value: %q, must be true, false or key", e.Idempotent)
+	}
+
+	if e.Retry != nil {
+		if err := e.Retry.Validate(); err != nil {
+			return fmt.Errorf("retry: %w", err)
+		}
+	}
+
+	if e.Pagination != nil {
+		if err := e.Pagination.Validate(e); err != nil {
+			return fmt.Errorf("pagination: %w", err)
+		}
+	}
+	return nil
+}
+
+// PaginationStyle is how the pages of a paginated endpoint are selected.
+type PaginationStyle string
+
+const (
+	// Pages are selected by their number, starting at FirstPage.
+	PaginationStylePage PaginationStyle = "page"
+
+	// Pages are selected by the offset of their first item, starting at 0.
+	PaginationStyleOffset PaginationStyle = "offset"
+
+	// Pages are selected by the cursor returned with the previous page.
+	PaginationStyleCursor PaginationStyle = "cursor"
+)
+
+// Pagination declares how the pages of a list endpoint are requested, and where their items are in its response,
+// so the SDKs can iterate over the items of all its pages: <Endpoint>All in Go and <endpoint>All in TypeScript.
+//
+// Fields refer to the params and the fields of the response body by name. The items are those of the first
+// 2xx response with a body.
+type Pagination struct {
+	// Style of the pagination: "page", "offset" or "cursor".
+	Style PaginationStyle `yaml:"style"`
+
+	// Query param selecting the page: its number (page), the offset of its first item (offset) or its cursor (cursor).
+	//
+	// It must be an int param, or a string param for the cursor style. Iteration starts at its value in the request, if set.
+	Param string `yaml:"param"`
+
+	// Array field of the response body holding the items of a page.
+	Items string `yaml:"items"`
+
+	// String field of the response body holding the cursor of the next page, required for the cursor style.
+	// Iteration ends at a page without one.
+	NextCursor string `yaml:"nextCursor,omitempty"`
+
+	// Int field of the response body holding the total number of items, for the page and offset styles.
+	// Iteration ends once that many items were returned, or otherwise at an empty page.
+	TotalCount string `yaml:"totalCount,omitempty"`
+
+	// Number of the first page, for the page style. (default: 1)
+	FirstPage *int64 `yaml:"firstPage,omitempty"`
+}
+
+// Validate checks the pagination against the params of its endpoint e. Its response fields are checked
+// against the schemas by Specification.Validate.
+func (p *Pagination) Validate(e *Endpoint) error {
+	paramType := ParamTypeInteger
+	switch p.Style {
+	case PaginationStylePage, PaginationStyleOffset:
+		if p.NextCursor != "" {
+			return fmt.Errorf("nextCursor is only supported for the cursor style")
+		}
+	case PaginationStyleCursor:
+		paramType = ParamTypeString
+		if p.NextCursor == "" {
+			return fmt.Errorf("nextCursor is required for the cursor style")
+		}
+		if p.TotalCount != "" {
+			return fmt.Errorf("totalCount is not supported for the cursor style")
+		}
+	default:
+		return fmt.Errorf("invalid style: %q, must be page, offset or cursor", p.Style)
+	}
+	if p.FirstPage != nil && p.Style != PaginationStylePage {
+		return fmt.Errorf("firstPage is only supported for the page style")
+	}
+
+	idx := slices.IndexFunc(e.QueryParams, func(qp Param) bool { return qp.Name == p.Param })
+	if idx < 0 {
+		return fmt.Errorf("param: %q is not a query param of the endpoint", p.Param)
+	}
+	if e.QueryParams[idx].Type != paramType {
+		return fmt.Errorf("param: %s must be of type %s for the %s style", p.Param, paramType, p.Style)
+	}
+	if p.Items == "" {
+		return fmt.Errorf("items is required")
+	}
+	if e.PaginatedResponse() == nil {
+		return fmt.Errorf("the endpoint has no 2xx response with a body")
+	}
+	if e.RawBody {
+		return fmt.Errorf("not supported for rawBody endpoints")
+	}
+	return nil
+}
+
+// PaginatedResponse returns the response holding the items of a paginated endpoint: its first 2xx response with a body.
+func (e *Endpoint) PaginatedResponse() *Response {
+	for _, resp := range e.Responses {
+		if resp.Status >= 200 && resp.Status < 300 && resp.BodyName != nil {
+			return resp
+		}
+	}
+	return nil
+}
+
+// validatePagination checks the response fields of the pagination of e against the schema of its paginated response.
+func (s *Specification) validatePagination(e *Endpoint) error {
+	p := e.Pagination
+	bodyName := *e.PaginatedResponse().BodyName
+	schema := s.SchemaByName(bodyName)
+	if schema == nil || !schema.IsObject() {
+		return fmt.Errorf("response body %s is not an object schema", bodyName)
+	}
+	for _, field := range []struct {
+		name, value string
+		typ         SchemaFieldType
+		isArray     bool
+	}{
+		{"items", p.Items, "", true},
+		{"nextCursor", p.NextCursor, SchemaFieldTypeString, false},
+		{"totalCount", p.TotalCount, SchemaFieldTypeInteger, false},
+	} {
+		if field.value == "" {
+			continue
+		}
+		props := s.AllProperties(schema)
+		idx := slices.IndexFunc(props, func(sf *SchemaField) bool { return sf.Name == field.value })
+		if idx < 0 {
+			return fmt.Errorf("%s: %s is not a field of %s", field.name, field.value, bodyName)
+		}
+		sf := props[idx]
+		if sf.IsArray != field.isArray || sf.IsMap || sf.Dimensions() > 1 || (field.typ != "" && sf.Type != field.typ) {
+			if field.isArray {
+				return fmt.Errorf("%s: %s.%s must be an array", field.name, bodyName, field.value)
+			}
+			return fmt.Errorf("%s: %s.%s must be of type %s", field.name, bodyName, field.value, field.typ)
+		}
+	}
+	return nil
+}
+
+// RetryPolicy configures how the generated SDKs retry requests that failed with a transport error
+// or a retryable status code.
+//
+// Retries wait for an exponential backoff with full jitter: a random duration up to InitialBackoff,
+// doubled for each retry up to MaxBackoff. A Retry-After header on the response is honored instead,
+// unless it exceeds MaxBackoff, in which case the response is returned.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one. 1 disables retries. (default: 3)
+	MaxAttempts int `yaml:"maxAttempts,omitempty"`
+
+	// Response status codes that are retried. (default: 429, 502, 503, 504)
+	StatusCodes []int `yaml:"statusCodes,omitempty"`
+
+	// Backoff before the first retry, as a Go duration, e.g. "100ms". (default: 100ms)
+	InitialBackoff string `yaml:"initialBackoff,omitempty"`
+
+	// Maximum backoff between two attempts, as a Go duration. (default: 5s)
+	MaxBackoff string `yaml:"maxBackoff,omitempty"`
+}
+
+// Default values of the RetryPolicy fields.
+const (
+	DefaultRetryMaxAttempts    = 3
+	DefaultRetryInitialBackoff = 100 * time.Millisecond
+	DefaultRetryMaxBackoff     = 5 * time.Second
+)
+
+// DefaultRetryStatusCodes are the status codes retried by default: 429 Too Many Requests,
+// 502 Bad Gateway, 503 Service Unavailable and 504 Gateway Timeout.
+var DefaultRetryStatusCodes = []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+
+func (rp *RetryPolicy) Validate() error {
+	// 0 is an unset maxAttempts, completed with the default
+	if rp.MaxAttempts < 0 {
+		return fmt.Errorf("maxAttempts must be at least 1, or 0 for the default")
+	}
+	for i, code := range rp.StatusCodes {
+		if code < 400 || code > 599 {
+			return fmt.Errorf("statusCodes[%d]: %d is not an error status code", i, code)
+		}
+		if slices.Contains(rp.StatusCodes[:i], code) {
+			return fmt.Errorf("statusCodes[%d]: duplicate status code: %d", i, code)
+		}
+	}
+	backoffs := make(map[string]time.Duration)
+	for _, field := range []struct{ name, value string }{{"initialBackoff", rp.InitialBackoff}, {"maxBackoff", rp.MaxBackoff}} {
+		name, value := field.name, field.value
+		if value == "" {
+			continue
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("%s: invalid duration %q: %w", name, value, err)
+		}
+		if d <= 0 {
+			return fmt.Errorf("%s must be positive", name)
+		}
+		backoffs[name] = d
+	}
+	if initial, ok := backoffs["initialBackoff"]; ok {
+		if maxBackoff, ok := backoffs["maxBackoff"]; ok && initial > maxBackoff {
+			return fmt.Errorf("initialBackoff %s must not exceed maxBackoff %s", rp.InitialBackoff, rp.MaxBackoff)
+		}
+	}
 	return nil
 }
 
+// EndpointRetryPolicy returns the retry policy of an endpoint, merged with the specification's and completed
+// with the defaults, or nil if the endpoint is not retried: it is not idempotent, has no retry policy
+// at either level, or allows a single attempt.
+//
+// The durations of the returned policy are valid.
+func (s *Specification) EndpointRetryPolicy(e *Endpoint) *RetryPolicy {
+	if !e.IsIdempotent() || (s.Retry == nil && e.Retry == nil) {
+		return nil
+	}
+	policy := RetryPolicy{
+		MaxAttempts:    DefaultRetryMaxAttempts,
+		StatusCodes:    DefaultRetryStatusCodes,
+		InitialBackoff: DefaultRetryInitialBackoff.String(),
+		MaxBackoff:     DefaultRetryMaxBackoff.String(),
+	}
+	for _, rp := range []*RetryPolicy{s.Retry, e.Retry} {
+		if rp == nil {
+			continue
+		}
+		if rp.MaxAttempts != 0 {
+			policy.MaxAttempts = rp.MaxAttempts
+		}
+		if len(rp.StatusCodes) > 0 {
+			policy.StatusCodes = rp.StatusCodes
+		}
+		if rp.InitialBackoff != "" {
+			policy.InitialBackoff = rp.InitialBackoff
+		}
+		if rp.MaxBackoff != "" {
+			policy.MaxBackoff = rp.MaxBackoff
+		}
+	}
+	if policy.MaxAttempts <= 1 {
+		return nil
+	}
+	return &policy
+}
+
+// InitialBackoffDuration returns the parsed InitialBackoff of a validated retry policy.
+func (rp RetryPolicy) InitialBackoffDuration() time.Duration {
+	d, _ := time.ParseDuration(rp.InitialBackoff)
+	return d
+}
+
+// MaxBackoffDuration returns the parsed MaxBackoff of a validated retry policy.
+func (rp RetryPolicy) MaxBackoffDuration() time.Duration {
+	d, _ := time.ParseDuration(rp.MaxBackoff)
+	return d
+}
+
 type Response struct {
 	Status int `yaml:"status"`
 	// Description of the response
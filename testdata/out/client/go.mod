@@ -1,6 +1,6 @@
 module github.com/nbrglm/napiway/testdata/out/client
 
-go 1.26.3
+go 1.27.1
 
 replace github.com/nbrglm/napiway/testdata/out/go_sdk => ../go-sdk
 
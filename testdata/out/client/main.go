@@ -1,14 +1,24 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"maps"
+	"net/http"
 	"os"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	sdk "github.com/nbrglm/napiway/testdata/out/go_sdk"
@@ -108,6 +118,126 @@ func main() {
 	// Store the result for printing later
 	structToMapStringBool(createUserResult, &result, "CreateUser")
 
+	// Test oneOf notifications
+	notificationsResult, err := testNotifications(ctx, api, serverAddr)
+	if err != nil {
+		stdErr(false, "Test notifications failed: %v\n", err)
+		return
+	}
+
+	// Store the result for printing later
+	structToMapStringBool(notificationsResult, &result, "Notifications")
+
+	// Test map fields
+	mapsResult, err := testMaps(ctx, api)
+	if err != nil {
+		stdErr(false, "Test maps failed: %v\n", err)
+		return
+	}
+
+	// Store the result for printing later
+	structToMapStringBool(mapsResult, &result, "Maps")
+
+	// Test nested arrays
+	nestedArraysResult, err := testNestedArrays(ctx, api)
+	if err != nil {
+		stdErr(false, "Test nested arrays failed: %v\n", err)
+		return
+	}
+
+	// Store the result for printing later
+	structToMapStringBool(nestedArraysResult, &result, "NestedArrays")
+
+	// Test get session
+	getSessionResult, err := testGetSession(ctx, api)
+	if err != nil {
+		stdErr(false, "Test get session failed: %v\n", err)
+		return
+	}
+
+	// Store the result for printing later
+	structToMapStringBool(getSessionResult, &result, "GetSession")
+
+	// Test get session with a token provider
+	tokenProviderResult, err := testGetSessionWithTokenProvider(ctx, serverAddr)
+	if err != nil {
+		stdErr(false, "Test get session with token provider failed: %v\n", err)
+		return
+	}
+
+	// Store the result for printing later
+	structToMapStringBool(tokenProviderResult, &result, "GetSessionWithTokenProvider")
+
+	// Test receive webhook
+	receiveWebhookResult, err := testReceiveWebhook(ctx, api, serverAddr)
+	if err != nil {
+		stdErr(false, "Test receive webhook failed: %v\n", err)
+		return
+	}
+
+	// Store the result for printing later
+	structToMapStringBool(receiveWebhookResult, &result, "ReceiveWebhook")
+
+	// Test default credentials
+	defaultCredentialsResult, err := testDefaultCredentials(ctx, serverAddr)
+	if err != nil {
+		stdErr(false, "Test default credentials failed: %v\n", err)
+		return
+	}
+
+	// Store the result for printing later
+	structToMapStringBool(defaultCredentialsResult, &result, "DefaultCredentials")
+
+	// Test delete user
+	deleteUserResult, err := testDeleteUser(ctx, api, serverAddr)
+	if err != nil {
+		stdErr(false, "Test delete user failed: %v\n", err)
+		return
+	}
+
+	// Store the result for printing later
+	structToMapStringBool(deleteUserResult, &result, "DeleteUser")
+
+	// Test retries
+	retryResult, err := testRetry(ctx, api)
+	if err != nil {
+		stdErr(false, "Test retry failed: %v\n", err)
+		return
+	}
+
+	// Store the result for printing later
+	structToMapStringBool(retryResult, &result, "Retry")
+
+	// Test idempotency keys
+	idempotencyKeyResult, err := testIdempotencyKey(ctx, api, serverAddr)
+	if err != nil {
+		stdErr(false, "Test idempotency key failed: %v\n", err)
+		return
+	}
+
+	// Store the result for printing later
+	structToMapStringBool(idempotencyKeyResult, &result, "IdempotencyKey")
+
+	// Test middleware
+	middlewareResult, err := testMiddleware(ctx, serverAddr)
+	if err != nil {
+		stdErr(false, "Test middleware failed: %v\n", err)
+		return
+	}
+
+	// Store the result for printing later
+	structToMapStringBool(middlewareResult, &result, "Middleware")
+
+	// Test pagination
+	paginationResult, err := testPagination(ctx, serverAddr)
+	if err != nil {
+		stdErr(false, "Test pagination failed: %v\n", err)
+		return
+	}
+
+	// Store the result for printing later
+	structToMapStringBool(paginationResult, &result, "Pagination")
+
 	// Test whoami
 	whoAmIResult, err := testWhoAmI(ctx, api)
 	if err != nil {
@@ -134,7 +264,7 @@ type UserLogoutResult struct {
 
 func testUserLogout(ctx context.Context, api *sdk.TestingAPI) (UserLogoutResult, error) {
 	var result UserLogoutResult
-	noApiKeyReq := sdk.NewLogoutUserReq("")
+	noApiKeyReq := sdk.NewLogoutUserReq()
 	_, err := api.LogoutUser(ctx, noApiKeyReq)
 	if err != nil {
 		if err.Reason == sdk.ReasonEncoding {
@@ -144,7 +274,7 @@ func testUserLogout(ctx context.Context, api *sdk.TestingAPI) (UserLogoutResult,
 		}
 	}
 
-	validApiKeyReq := sdk.NewLogoutUserReq(VALID_API_KEY)
+	validApiKeyReq := sdk.NewLogoutUserReq().WithAPIKeyAuth(VALID_API_KEY)
 	_, err = api.LogoutUser(ctx, validApiKeyReq)
 	if err != nil {
 		if err.Reason == sdk.ReasonEncoding {
@@ -154,7 +284,7 @@ func testUserLogout(ctx context.Context, api *sdk.TestingAPI) (UserLogoutResult,
 		}
 	}
 
-	invalidApiKeyReq := sdk.NewLogoutUserReq(INVALID_API_KEY).WithSessionTokenAuth(&VALID_SESSION_TOKEN)
+	invalidApiKeyReq := sdk.NewLogoutUserReq().WithAPIKeyAuth(INVALID_API_KEY).WithSessionTokenAuth(&VALID_SESSION_TOKEN)
 	invalidApiKeyResp, err := api.LogoutUser(ctx, invalidApiKeyReq)
 	if err != nil {
 		return result, err
@@ -163,7 +293,7 @@ func testUserLogout(ctx context.Context, api *sdk.TestingAPI) (UserLogoutResult,
 		result.WithInvalidAPIKey = true
 	}
 
-	invalidRefreshTokenReq := sdk.NewLogoutUserReq(VALID_API_KEY).WithRefreshTokenAuth(&INVALID_REFRESH_TOKEN)
+	invalidRefreshTokenReq := sdk.NewLogoutUserReq().WithAPIKeyAuth(VALID_API_KEY).WithRefreshTokenAuth(&INVALID_REFRESH_TOKEN)
 	invalidRefreshTokenResp, err := api.LogoutUser(ctx, invalidRefreshTokenReq)
 	if err != nil {
 		return result, err
@@ -172,7 +302,7 @@ func testUserLogout(ctx context.Context, api *sdk.TestingAPI) (UserLogoutResult,
 		result.WithInvalidRefreshToken = true
 	}
 
-	invalidSessionTokenReq := sdk.NewLogoutUserReq(VALID_API_KEY).WithSessionTokenAuth(&INVALID_SESSION_TOKEN)
+	invalidSessionTokenReq := sdk.NewLogoutUserReq().WithAPIKeyAuth(VALID_API_KEY).WithSessionTokenAuth(&INVALID_SESSION_TOKEN)
 	invalidSessionTokenResp, err := api.LogoutUser(ctx, invalidSessionTokenReq)
 	if err != nil {
 		return result, err
@@ -181,7 +311,7 @@ func testUserLogout(ctx context.Context, api *sdk.TestingAPI) (UserLogoutResult,
 		result.WithInvalidSessionToken = true
 	}
 
-	validSessionTokenReq := sdk.NewLogoutUserReq(VALID_API_KEY).WithSessionTokenAuth(&VALID_SESSION_TOKEN)
+	validSessionTokenReq := sdk.NewLogoutUserReq().WithAPIKeyAuth(VALID_API_KEY).WithSessionTokenAuth(&VALID_SESSION_TOKEN)
 	validSessionTokenResp, err := api.LogoutUser(ctx, validSessionTokenReq)
 	if err != nil {
 		return result, err
@@ -190,7 +320,7 @@ func testUserLogout(ctx context.Context, api *sdk.TestingAPI) (UserLogoutResult,
 		result.ValidOperationWithSessionToken = true
 	}
 
-	validRefreshTokenReq := sdk.NewLogoutUserReq(VALID_API_KEY).WithRefreshTokenAuth(&VALID_REFRESH_TOKEN)
+	validRefreshTokenReq := sdk.NewLogoutUserReq().WithAPIKeyAuth(VALID_API_KEY).WithRefreshTokenAuth(&VALID_REFRESH_TOKEN)
 	validRefreshTokenResp, err := api.LogoutUser(ctx, validRefreshTokenReq)
 	if err != nil {
 		return result, err
@@ -214,7 +344,7 @@ type ListUsersResult struct {
 func testListUsers(ctx context.Context, api *sdk.TestingAPI) (ListUsersResult, error) {
 	var result ListUsersResult
 
-	invalidReq := sdk.NewListUsersReq("", "")
+	invalidReq := sdk.NewListUsersReq()
 	_, err := api.ListUsers(ctx, invalidReq)
 	if err != nil {
 		if err.Reason == sdk.ReasonEncoding {
@@ -224,7 +354,7 @@ func testListUsers(ctx context.Context, api *sdk.TestingAPI) (ListUsersResult, e
 		}
 	}
 
-	validApiKeyReq := sdk.NewListUsersReq("", VALID_API_KEY)
+	validApiKeyReq := sdk.NewListUsersReq().WithAPIKeyAuth(VALID_API_KEY)
 	_, err = api.ListUsers(ctx, validApiKeyReq)
 	if err != nil {
 		if err.Reason == sdk.ReasonEncoding {
@@ -234,7 +364,7 @@ func testListUsers(ctx context.Context, api *sdk.TestingAPI) (ListUsersResult, e
 		}
 	}
 
-	reqInvalidAPIKey := sdk.NewListUsersReq(VALID_ADMIN_TOKEN, INVALID_API_KEY)
+	reqInvalidAPIKey := sdk.NewListUsersReq().WithAdminTokenAuth(VALID_ADMIN_TOKEN).WithAPIKeyAuth(INVALID_API_KEY)
 	resInvalidAPIKey, err := api.ListUsers(ctx, reqInvalidAPIKey)
 	if err != nil {
 		return result, err
@@ -243,7 +373,7 @@ func testListUsers(ctx context.Context, api *sdk.TestingAPI) (ListUsersResult, e
 		result.WithInvalidAPIKey = true
 	}
 
-	reqInvalidAdminToken := sdk.NewListUsersReq(INVALID_ADMIN_TOKEN, VALID_API_KEY)
+	reqInvalidAdminToken := sdk.NewListUsersReq().WithAdminTokenAuth(INVALID_ADMIN_TOKEN).WithAPIKeyAuth(VALID_API_KEY)
 	resInvalidAdminToken, err := api.ListUsers(ctx, reqInvalidAdminToken)
 	if err != nil {
 		return result, err
@@ -252,7 +382,7 @@ func testListUsers(ctx context.Context, api *sdk.TestingAPI) (ListUsersResult, e
 		result.WithInvalidAdminToken = true
 	}
 
-	reqValidWithoutQueryParams := sdk.NewListUsersReq(VALID_ADMIN_TOKEN, VALID_API_KEY)
+	reqValidWithoutQueryParams := sdk.NewListUsersReq().WithAdminTokenAuth(VALID_ADMIN_TOKEN).WithAPIKeyAuth(VALID_API_KEY)
 	resValidWithoutQueryParams, err := api.ListUsers(ctx, reqValidWithoutQueryParams)
 	if err != nil {
 		return result, err
@@ -261,7 +391,7 @@ func testListUsers(ctx context.Context, api *sdk.TestingAPI) (ListUsersResult, e
 		result.ValidOperationWithoutQueryParams = true
 	}
 
-	reqValidWithQueryParams := sdk.NewListUsersReq(VALID_ADMIN_TOKEN, VALID_API_KEY).WithPageSize(&PAGE_SIZE)
+	reqValidWithQueryParams := sdk.NewListUsersReq().WithAdminTokenAuth(VALID_ADMIN_TOKEN).WithAPIKeyAuth(VALID_API_KEY).WithPageSize(&PAGE_SIZE)
 	resValidWithQueryParams, err := api.ListUsers(ctx, reqValidWithQueryParams)
 	if err != nil {
 		return result, err
@@ -273,13 +403,18 @@ func testListUsers(ctx context.Context, api *sdk.TestingAPI) (ListUsersResult, e
 }
 
 type GetUserResult struct {
-	WithoutPathParam bool
-	ValidOperation   bool
+	WithoutPathParam        bool
+	ValidOperation          bool
+	WithInheritedFields     bool
+	ParseInheritedFields    bool
+	ConstructInheritedField bool
+	WithInlineObject        bool
+	ParseInlineObject       bool
 }
 
 func testGetUser(ctx context.Context, api *sdk.TestingAPI) (GetUserResult, error) {
 	var result GetUserResult
-	invalidReq := sdk.NewGetUserReq("", VALID_API_KEY, VALID_SESSION_TOKEN)
+	invalidReq := sdk.NewGetUserReq("").WithAPIKeyAuth(VALID_API_KEY).WithSessionTokenAuth(VALID_SESSION_TOKEN)
 	_, err := api.GetUser(ctx, invalidReq)
 	if err != nil {
 		if err.Reason == sdk.ReasonEncoding {
@@ -289,14 +424,38 @@ func testGetUser(ctx context.Context, api *sdk.TestingAPI) (GetUserResult, error
 		}
 	}
 
-	reqValid := sdk.NewGetUserReq("1", VALID_API_KEY, VALID_SESSION_TOKEN)
+	reqValid := sdk.NewGetUserReq("1").WithAPIKeyAuth(VALID_API_KEY).WithSessionTokenAuth(VALID_SESSION_TOKEN)
 	resValid, err := api.GetUser(ctx, reqValid)
 	if err != nil {
 		return result, err
 	}
 	if resValid.StatusCode == 200 && resValid.Response200.Body.UserId == "1" {
 		result.ValidOperation = true
+		// CreatedAt and UpdatedBy are inherited from Entity and AuditedEntity
+		body := resValid.Response200.Body
+		result.WithInheritedFields = body.CreatedAt == "2024-01-01T00:00:00Z" && body.UpdatedBy != nil && *body.UpdatedBy == "2"
+		// Address is an inline object, hoisted as UserAddress, with Geo hoisted as UserAddressGeo
+		var address *sdk.UserAddress = body.Address
+		result.WithInlineObject = address != nil && address.Street == "742 Evergreen Terrace" && address.City == "Springfield" &&
+			address.Geo != nil && address.Geo.Latitude == 39.78 && address.Geo.Longitude == -89.65
+	}
+
+	// A User without the CreatedAt it inherits is invalid
+	_, perr := sdk.ParseUser(map[string]any{"UserId": "1", "UserName": "Alice", "Email": "alice@example.com", "IsActive": true})
+	var verr *sdk.ValidationError
+	result.ParseInheritedFields = errors.As(perr, &verr) && len(verr.Issues) == 1 && verr.Issues[0].Path == "CreatedAt"
+
+	// The fields of inline objects are validated like those of named schemas
+	_, perr = sdk.ParseUser(map[string]any{
+		"CreatedAt": "2024-01-01T00:00:00Z", "UserId": "1", "UserName": "Alice", "Email": "alice@example.com", "IsActive": true,
+		"Address": map[string]any{"Street": "742 Evergreen Terrace", "Geo": map[string]any{"Latitude": "north", "Longitude": -89.65}},
+	})
+	if errors.As(perr, &verr) && len(verr.Issues) == 2 {
+		result.ParseInlineObject = verr.Issues[0].Path == "Address.City" && verr.Issues[1].Path == "Address.Geo.Latitude"
 	}
+
+	user := sdk.NewUser("2024-01-01T00:00:00Z", "alice@example.com", true, "1", "Alice").WithUpdatedBy("2").WithAge(AGE)
+	result.ConstructInheritedField = user.CreatedAt == "2024-01-01T00:00:00Z" && user.AuditedEntity.UpdatedBy != nil && *user.Age == AGE
 	return result, nil
 }
 
@@ -310,14 +469,12 @@ func testCreateUser(ctx context.Context, api *sdk.TestingAPI) (CreateUserResult,
 	var result CreateUserResult
 
 	reqOptionalFieldMissing := sdk.NewCreateUserReq(
-		VALID_ADMIN_TOKEN,
-		VALID_API_KEY,
 		sdk.NewCreateUserRequestBody(
 			"test@example.com",
 			sdk.UserStatusACTIVE,
 			"Test User",
 		),
-	)
+	).WithAdminTokenAuth(VALID_ADMIN_TOKEN).WithAPIKeyAuth(VALID_API_KEY)
 	resOptionalFieldMissing, err := api.CreateUser(ctx, reqOptionalFieldMissing)
 	if err != nil {
 		return result, err
@@ -331,14 +488,12 @@ func testCreateUser(ctx context.Context, api *sdk.TestingAPI) (CreateUserResult,
 	optionalUserStatus := sdk.UserStatusINACTIVE_USER
 
 	reqOptionalFieldPresent := sdk.NewCreateUserReq(
-		VALID_ADMIN_TOKEN,
-		VALID_API_KEY,
 		sdk.NewCreateUserRequestBody(
 			"test@example.com",
 			optionalUserStatus,
 			"Test User",
 		).WithAge(AGE).WithOptionalStatus(optionalUserStatus),
-	)
+	).WithAdminTokenAuth(VALID_ADMIN_TOKEN).WithAPIKeyAuth(VALID_API_KEY)
 	resOptionalFieldPresent, err := api.CreateUser(ctx, reqOptionalFieldPresent)
 	if err != nil {
 		return result, err
@@ -357,14 +512,12 @@ func testCreateUser(ctx context.Context, api *sdk.TestingAPI) (CreateUserResult,
 	}
 
 	reqWithArbitraryData := sdk.NewCreateUserReq(
-		VALID_ADMIN_TOKEN,
-		VALID_API_KEY,
 		sdk.NewCreateUserRequestBody(
 			"test@example.com",
 			sdk.UserStatusINACTIVE_USER,
 			"Test User",
 		).WithArbitraryData(arbitraryDataSent),
-	)
+	).WithAdminTokenAuth(VALID_ADMIN_TOKEN).WithAPIKeyAuth(VALID_API_KEY)
 	resWithArbitraryData, err := api.CreateUser(ctx, reqWithArbitraryData)
 	if err != nil {
 		return result, err
@@ -380,6 +533,981 @@ func testCreateUser(ctx context.Context, api *sdk.TestingAPI) (CreateUserResult,
 	return result, nil
 }
 
+type NotificationsResult struct {
+	RoundTrip            bool
+	WithUnknownChannel   bool
+	WithInvalidVariant   bool
+	ParsesVariantChannel bool
+	RoundsRoundTrip      bool
+	SendsEmailBody       bool
+	SendsSmsBody         bool
+	SendWithInvalidBody  bool
+}
+
+func testNotifications(ctx context.Context, api *sdk.TestingAPI, serverAddr string) (NotificationsResult, error) {
+	var result NotificationsResult
+
+	subject := "Welcome"
+	req := sdk.NewCreateUserReq(
+		sdk.NewCreateUserRequestBody("test@example.com", sdk.UserStatusACTIVE, "Test User").
+			WithWelcomeNotification(sdk.NewEmailNotification("test@example.com").WithSubject(subject)).
+			WithNotifications([]sdk.Notification{
+				sdk.NewSmsNotification("+15550100"),
+				sdk.NewEmailNotification("other@example.com"),
+			}).
+			WithNotificationRounds([][]sdk.Notification{
+				{sdk.NewEmailNotification("first@example.com")},
+				{sdk.NewSmsNotification("+15550101"), sdk.NewSmsNotification("+15550102")},
+			}),
+	).WithAdminTokenAuth(VALID_ADMIN_TOKEN).WithAPIKeyAuth(VALID_API_KEY)
+	res, err := api.CreateUser(ctx, req)
+	if err != nil {
+		return result, err
+	}
+	if res.StatusCode == 201 {
+		body := res.Response201.Body
+		welcome, okWelcome := body.WelcomeNotification.(*sdk.EmailNotification)
+		if okWelcome && welcome.Address == "test@example.com" && welcome.Subject != nil && *welcome.Subject == subject && len(body.Notifications) == 2 {
+			sms, okSms := body.Notifications[0].(*sdk.SmsNotification)
+			email, okEmail := body.Notifications[1].(*sdk.EmailNotification)
+			result.RoundTrip = okSms && sms.PhoneNumber == "+15550100" && okEmail && email.Address == "other@example.com" && email.Subject == nil
+		}
+		if rounds := body.NotificationRounds; len(rounds) == 2 && len(rounds[0]) == 1 && len(rounds[1]) == 2 {
+			email, okEmail := rounds[0][0].(*sdk.EmailNotification)
+			sms, okSms := rounds[1][1].(*sdk.SmsNotification)
+			result.RoundsRoundTrip = okEmail && email.Address == "first@example.com" && okSms && sms.PhoneNumber == "+15550102"
+		}
+	}
+
+	// A oneOf request body is sent as its variant, and the response body decoded as the variant named by its Channel
+	sent, err := api.SendNotification(ctx, sdk.NewSendNotificationReq(sdk.NewEmailNotification("test@example.com")))
+	if err != nil {
+		return result, err
+	}
+	if sent.StatusCode == 200 {
+		email, ok := sent.Response200.Body.(*sdk.EmailNotification)
+		result.SendsEmailBody = ok && email.Address == "test@example.com" && email.Subject != nil && *email.Subject == "(no subject)"
+	}
+	sent, err = api.SendNotification(ctx, sdk.NewSendNotificationReq(sdk.NewSmsNotification("+15550100")))
+	if err != nil {
+		return result, err
+	}
+	if sent.StatusCode == 200 {
+		sms, ok := sent.Response200.Body.(*sdk.SmsNotification)
+		result.SendsSmsBody = ok && sms.PhoneNumber == "+15550100"
+	}
+
+	// The SDK always sends a known Channel, so the invalid bodies are sent directly
+	post := func(path, body string) (*sdk.ErrorResponse, int, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, serverAddr+path, strings.NewReader(body))
+		if err != nil {
+			return nil, 0, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-App-API-Key", VALID_API_KEY)
+		req.Header.Set("X-App-Admin-Token", "Admin "+VALID_ADMIN_TOKEN)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, 0, err
+		}
+		defer resp.Body.Close()
+		var errResp sdk.ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+			return nil, resp.StatusCode, err
+		}
+		return &errResp, resp.StatusCode, nil
+	}
+	postUser := func(notification string) (*sdk.ErrorResponse, int, error) {
+		return post("/users/new", `{"UserName":"Test User","Email":"test@example.com","Status":"ACTIVE","WelcomeNotification":`+notification+`}`)
+	}
+
+	errResp, status, perr := postUser(`{"Channel":"fax","Address":"test@example.com"}`)
+	if perr != nil {
+		return result, perr
+	}
+	result.WithUnknownChannel = status == 400 && errResp.DebugMessage != nil && strings.Contains(*errResp.DebugMessage, "WelcomeNotification.Channel")
+
+	// A known Channel, without the required field of its variant
+	errResp, status, perr = postUser(`{"Channel":"sms","Address":"test@example.com"}`)
+	if perr != nil {
+		return result, perr
+	}
+	result.WithInvalidVariant = status == 400 && errResp.DebugMessage != nil && strings.Contains(*errResp.DebugMessage, "WelcomeNotification.PhoneNumber")
+
+	// The same, as the request body itself
+	errResp, status, perr = post("/notifications", `{"Channel":"sms","Address":"test@example.com"}`)
+	if perr != nil {
+		return result, perr
+	}
+	result.SendWithInvalidBody = status == 400 && errResp.DebugMessage != nil && strings.Contains(*errResp.DebugMessage, "'PhoneNumber'")
+
+	parsed, perr := sdk.ParseNotification(map[string]any{"Channel": "sms", "PhoneNumber": "+15550100"})
+	if perr != nil {
+		return result, perr
+	}
+	sms, ok := parsed.(*sdk.SmsNotification)
+	result.ParsesVariantChannel = ok && sms.PhoneNumber == "+15550100"
+
+	return result, nil
+}
+
+type MapsResult struct {
+	RoundTrip        bool
+	WithInvalidValue bool
+	ParsesValues     bool
+}
+
+func testMaps(ctx context.Context, api *sdk.TestingAPI) (MapsResult, error) {
+	var result MapsResult
+
+	newReq := func(contacts map[string]sdk.EmailNotification) *sdk.CreateUserReq {
+		return sdk.NewCreateUserReq(
+			sdk.NewCreateUserRequestBody("test@example.com", sdk.UserStatusACTIVE, "Test User").
+				WithLabels(map[string]string{"team": "core", "role": "admin"}).
+				WithContacts(contacts),
+		).WithAdminTokenAuth(VALID_ADMIN_TOKEN).WithAPIKeyAuth(VALID_API_KEY)
+	}
+
+	res, err := api.CreateUser(ctx, newReq(map[string]sdk.EmailNotification{
+		"work": *sdk.NewEmailNotification("work@example.com"),
+	}))
+	if err != nil {
+		return result, err
+	}
+	if res.StatusCode == 201 {
+		body := res.Response201.Body
+		result.RoundTrip = len(body.Labels) == 2 && body.Labels["team"] == "core" && body.Labels["role"] == "admin" &&
+			len(body.Contacts) == 1 && body.Contacts["work"].Address == "work@example.com"
+	}
+
+	// Each value is validated, with the key in the path of its issues
+	res, err = api.CreateUser(ctx, newReq(map[string]sdk.EmailNotification{
+		"work": *sdk.NewEmailNotification(" "),
+	}))
+	if err != nil {
+		return result, err
+	}
+	result.WithInvalidValue = res.StatusCode == 400 && res.Response400.Body.DebugMessage != nil &&
+		strings.Contains(*res.Response400.Body.DebugMessage, "Contacts.work.Address")
+
+	_, perr := sdk.ParseCreateUserRequestBody(map[string]any{
+		"UserName": "Test User",
+		"Email":    "test@example.com",
+		"Status":   "ACTIVE",
+		"Labels":   map[string]any{"team": "core", "size": 3},
+		"Contacts": map[string]any{"work": map[string]any{"Address": "work@example.com"}, "home": "home@example.com"},
+	})
+	var verr *sdk.ValidationError
+	if errors.As(perr, &verr) && len(verr.Issues) == 2 {
+		result.ParsesValues = verr.Issues[0].Path == "Contacts.home" && verr.Issues[1].Path == "Labels.size"
+	}
+
+	return result, nil
+}
+
+type NestedArraysResult struct {
+	RoundTrip        bool
+	ParsesEveryLevel bool
+}
+
+func testNestedArrays(ctx context.Context, api *sdk.TestingAPI) (NestedArraysResult, error) {
+	var result NestedArraysResult
+
+	coordinates := [][]float64{{39.78, -89.65}, {40.71, -74.01}}
+	req := sdk.NewCreateUserReq(
+		sdk.NewCreateUserRequestBody("test@example.com", sdk.UserStatusACTIVE, "Test User").WithCoordinates(coordinates),
+	).WithAdminTokenAuth(VALID_ADMIN_TOKEN).WithAPIKeyAuth(VALID_API_KEY)
+	res, err := api.CreateUser(ctx, req)
+	if err != nil {
+		return result, err
+	}
+	if res.StatusCode == 201 {
+		result.RoundTrip = reflect.DeepEqual(res.Response201.Body.Coordinates, coordinates)
+	}
+
+	// Each level is checked, with the index of every level in the path of the issues
+	_, perr := sdk.ParseCreateUserRequestBody(map[string]any{
+		"UserName":    "Test User",
+		"Email":       "test@example.com",
+		"Status":      "ACTIVE",
+		"Coordinates": []any{[]any{39.78, -89.65}, []any{40.71, "west"}, 12.0},
+	})
+	var verr *sdk.ValidationError
+	if errors.As(perr, &verr) && len(verr.Issues) == 2 {
+		result.ParsesEveryLevel = verr.Issues[0].Path == "Coordinates[1][1]" && verr.Issues[1].Path == "Coordinates[2]"
+	}
+
+	return result, nil
+}
+
+type GetSessionResult struct {
+	WithMissingCredentials       bool
+	ValidOperationWithBearer     bool
+	ValidOperationWithBasic      bool
+	ValidOperationWithQueryKey   bool
+	ValidOperationWithCookie     bool
+	WithMultipleCredentialsError bool
+	WithFormattedQueryKey        bool
+	WithMalformedBearerToken     bool
+	WithMalformedCookie          bool
+}
+
+func testGetSession(ctx context.Context, api *sdk.TestingAPI) (GetSessionResult, error) {
+	var result GetSessionResult
+
+	_, err := api.GetSession(ctx, sdk.NewGetSessionReq())
+	if err != nil {
+		if err.Reason == sdk.ReasonEncoding {
+			result.WithMissingCredentials = true
+		} else {
+			return result, err
+		}
+	}
+
+	token := "bearer-token"
+	cookie := "v1.cookie-session"
+	queryKey := "query key&="
+	formattedQueryKey := "qk_" + queryKey
+	credentials := sdk.BasicAuthCredentials{Username: "user", Password: "pass:word"}
+
+	cases := []struct {
+		req        *sdk.GetSessionReq
+		authMethod string
+		credential string
+		ok         *bool
+	}{
+		{sdk.NewGetSessionReq().WithBearerTokenAuth(&token), "BearerToken", token, &result.ValidOperationWithBearer},
+		{sdk.NewGetSessionReq().WithBasicAuth(&credentials), "Basic", "user:pass:word", &result.ValidOperationWithBasic},
+		{sdk.NewGetSessionReq().WithQueryKeyAuth(&queryKey), "QueryKey", queryKey, &result.ValidOperationWithQueryKey},
+		{sdk.NewGetSessionReq().WithSessionCookieAuth(&cookie), "SessionCookie", "cookie-session", &result.ValidOperationWithCookie},
+		{sdk.NewGetSessionReq().WithQueryKeyAuth(&formattedQueryKey), "QueryKey", queryKey, &result.WithFormattedQueryKey},
+	}
+	for _, c := range cases {
+		resp, err := api.GetSession(ctx, c.req)
+		if err != nil {
+			return result, err
+		}
+		if resp.StatusCode == 200 && resp.Response200.Body.AuthMethod == c.authMethod && resp.Response200.Body.Credential == c.credential {
+			*c.ok = true
+		}
+	}
+
+	_, err = api.GetSession(ctx, sdk.NewGetSessionReq().WithBearerTokenAuth(&token).WithSessionCookieAuth(&cookie))
+	if err != nil {
+		if err.Reason == sdk.ReasonEncoding {
+			result.WithMultipleCredentialsError = true
+		} else {
+			return result, err
+		}
+	}
+
+	malformedToken := "not a token!"
+	malformedCookie := "v2.cookie-session"
+	malformed := []struct {
+		req *sdk.GetSessionReq
+		ok  *bool
+	}{
+		{sdk.NewGetSessionReq().WithBearerTokenAuth(&malformedToken), &result.WithMalformedBearerToken},
+		{sdk.NewGetSessionReq().WithSessionCookieAuth(&malformedCookie), &result.WithMalformedCookie},
+	}
+	for _, c := range malformed {
+		resp, err := api.GetSession(ctx, c.req)
+		if err != nil {
+			return result, err
+		}
+		*c.ok = resp.StatusCode == 401
+	}
+
+	return result, nil
+}
+
+type GetSessionWithTokenProviderResult struct {
+	ValidOperationWithServiceToken bool
+	WithCachedServiceToken         bool
+	WithRetriedStaleToken          bool
+	WithInvalidClientCredentials   bool
+}
+
+// staleTokenProvider returns a token unknown to the server until it is invalidated, and then the tokens of TokenProvider.
+type staleTokenProvider struct {
+	sdk.TokenProvider
+	invalidated bool
+}
+
+func (p *staleTokenProvider) Token(ctx context.Context) (string, error) {
+	if !p.invalidated {
+		return "stale-token", nil
+	}
+	return p.TokenProvider.Token(ctx)
+}
+
+func (p *staleTokenProvider) Invalidate(token string) {
+	p.invalidated = true
+	p.TokenProvider.Invalidate(token)
+}
+
+func testGetSessionWithTokenProvider(ctx context.Context, serverAddr string) (GetSessionWithTokenProviderResult, error) {
+	var result GetSessionWithTokenProviderResult
+
+	newProvider := func(clientSecret string) *sdk.ClientCredentialsTokenProvider {
+		provider := sdk.NewServiceTokenProvider("service-client", clientSecret)
+		provider.TokenURL = serverAddr + "/oauth/token"
+		return provider
+	}
+
+	api := sdk.NewTestingAPI(serverAddr, sdk.WithServiceTokenProvider(newProvider("service-secret")))
+	var credentials []string
+	for range 2 {
+		resp, err := api.GetSession(ctx, sdk.NewGetSessionReq())
+		if err != nil {
+			return result, err
+		}
+		if resp.StatusCode != 200 || resp.Response200.Body.AuthMethod != "Service" {
+			return result, nil
+		}
+		credentials = append(credentials, resp.Response200.Body.Credential)
+	}
+	result.ValidOperationWithServiceToken = true
+	result.WithCachedServiceToken = credentials[0] == credentials[1]
+
+	staleAPI := sdk.NewTestingAPI(serverAddr, sdk.WithServiceTokenProvider(&staleTokenProvider{TokenProvider: newProvider("service-secret")}))
+	resp, err := staleAPI.GetSession(ctx, sdk.NewGetSessionReq())
+	if err != nil {
+		return result, err
+	}
+	result.WithRetriedStaleToken = resp.StatusCode == 200 && resp.Response200.Body.AuthMethod == "Service"
+
+	invalidAPI := sdk.NewTestingAPI(serverAddr, sdk.WithServiceTokenProvider(newProvider("wrong-secret")))
+	_, err = invalidAPI.GetSession(ctx, sdk.NewGetSessionReq())
+	if err != nil {
+		if err.Reason == sdk.ReasonAuth {
+			result.WithInvalidClientCredentials = true
+		} else {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+type ReceiveWebhookResult struct {
+	ValidOperation     bool
+	WithWrongSecret    bool
+	WithUnknownKey     bool
+	WithMissingKey     bool
+	WithStaleTimestamp bool
+	WithSignedQuery    bool
+	WithTamperedQuery  bool
+	WithOversizedBody  bool
+}
+
+func testReceiveWebhook(ctx context.Context, api *sdk.TestingAPI, serverAddr string) (ReceiveWebhookResult, error) {
+	var result ReceiveWebhookResult
+	body := sdk.NewReceiveWebhookRequestBody("user.created")
+
+	resp, err := api.ReceiveWebhook(ctx, sdk.NewReceiveWebhookReq(body).WithWebhookSignatureAuth(sdk.HMACKey{KeyID: "webhook-key", Secret: "webhook-secret"}))
+	if err != nil {
+		return result, err
+	}
+	result.ValidOperation = resp.StatusCode == 200 && resp.Response200.Body.KeyId == "webhook-key" && resp.Response200.Body.Event == "user.created"
+
+	for _, c := range []struct {
+		key    sdk.HMACKey
+		result *bool
+	}{
+		{sdk.HMACKey{KeyID: "webhook-key", Secret: "wrong-secret"}, &result.WithWrongSecret},
+		{sdk.HMACKey{KeyID: "unknown-key", Secret: "webhook-secret"}, &result.WithUnknownKey},
+	} {
+		resp, err := api.ReceiveWebhook(ctx, sdk.NewReceiveWebhookReq(body).WithWebhookSignatureAuth(c.key))
+		if err != nil {
+			return result, err
+		}
+		*c.result = resp.StatusCode == 401
+	}
+
+	_, err = api.ReceiveWebhook(ctx, sdk.NewReceiveWebhookReq(body).WithWebhookSignatureAuth(sdk.HMACKey{Secret: "webhook-secret"}))
+	if err != nil {
+		if err.Reason == sdk.ReasonEncoding {
+			result.WithMissingKey = true
+		} else {
+			return result, err
+		}
+	}
+
+	// sendSigned sends a request signed at signedAt over signedQuery, with sentQuery as its query
+	sendSigned := func(signedAt time.Time, signedQuery, sentQuery string) (int, error) {
+		bodyBytes := []byte(`{"Event":"user.created"}`)
+		timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+		bodyHash := sha256.Sum256(bodyBytes)
+		mac := hmac.New(sha256.New, []byte("webhook-secret"))
+		mac.Write([]byte("POST\n/webhooks\n" + signedQuery + "\n" + timestamp + "\n" + hex.EncodeToString(bodyHash[:])))
+
+		url := serverAddr + "/webhooks"
+		if sentQuery != "" {
+			url += "?" + sentQuery
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(bodyBytes))
+		if err != nil {
+			return 0, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Signature", hex.EncodeToString(mac.Sum(nil)))
+		req.Header.Set("X-Signature-Key-Id", "webhook-key")
+		req.Header.Set("X-Signature-Timestamp", timestamp)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return 0, err
+		}
+		resp.Body.Close()
+		return resp.StatusCode, nil
+	}
+
+	// A request signed correctly, but 10 minutes ago, outside of the 5 minutes clock skew window
+	status, rerr := sendSigned(time.Now().Add(-10*time.Minute), "", "")
+	if rerr != nil {
+		return result, rerr
+	}
+	result.WithStaleTimestamp = status == 401
+
+	// The query is signed, so it cannot be changed after signing
+	if status, rerr = sendSigned(time.Now(), "source=billing", "source=billing"); rerr != nil {
+		return result, rerr
+	}
+	result.WithSignedQuery = status == 200
+	if status, rerr = sendSigned(time.Now(), "source=billing", "source=admin"); rerr != nil {
+		return result, rerr
+	}
+	result.WithTamperedQuery = status == 401
+
+	// A body over the 256KB limit of the endpoint is rejected before it is read in full to be hashed
+	oversized := sdk.NewReceiveWebhookRequestBody(strings.Repeat("a", 300<<10))
+	resp, err = api.ReceiveWebhook(ctx, sdk.NewReceiveWebhookReq(oversized).WithWebhookSignatureAuth(sdk.HMACKey{KeyID: "webhook-key", Secret: "webhook-secret"}))
+	if err != nil {
+		return result, err
+	}
+	if resp.StatusCode == 401 && resp.UnknownResponse != nil {
+		defer resp.UnknownResponse.Body.Close()
+		var errResp sdk.ErrorResponse
+		if rerr := json.NewDecoder(resp.UnknownResponse.Body).Decode(&errResp); rerr != nil {
+			return result, rerr
+		}
+		result.WithOversizedBody = errResp.DebugMessage != nil &&
+			strings.Contains(*errResp.DebugMessage, "the signed request body exceeds the maximum allowed size of 262144 bytes")
+	}
+
+	return result, nil
+}
+
+type DefaultCredentialsResult struct {
+	ValidOperationWithDefaults        bool
+	WithOverriddenDefault             bool
+	WithCredentialsProvider           bool
+	WithDefaultAlternative            bool
+	WithDefaultWebhookKey             bool
+	WithFailingCredentialsProvider    bool
+	WithoutDefaultsMissingCredentials bool
+}
+
+func testDefaultCredentials(ctx context.Context, serverAddr string) (DefaultCredentialsResult, error) {
+	var result DefaultCredentialsResult
+
+	api := sdk.NewTestingAPI(serverAddr,
+		sdk.WithAdminTokenCredentials(VALID_ADMIN_TOKEN),
+		sdk.WithAPIKeyCredentials(VALID_API_KEY),
+		sdk.WithSessionTokenCredentials(VALID_SESSION_TOKEN),
+		sdk.WithWebhookSignatureCredentials(sdk.HMACKey{KeyID: "webhook-key", Secret: "webhook-secret"}),
+		sdk.WithBearerTokenCredentialsProvider(sdk.CredentialsProviderFunc[string](func(ctx context.Context) (string, error) {
+			return "provided-token", nil
+		})),
+	)
+
+	resp, err := api.ListUsers(ctx, sdk.NewListUsersReq())
+	if err != nil {
+		return result, err
+	}
+	result.ValidOperationWithDefaults = resp.StatusCode == 200
+
+	resp, err = api.ListUsers(ctx, sdk.NewListUsersReq().WithAPIKeyAuth(INVALID_API_KEY))
+	if err != nil {
+		return result, err
+	}
+	result.WithOverriddenDefault = resp.StatusCode == 400
+
+	sessionResp, err := api.GetSession(ctx, sdk.NewGetSessionReq())
+	if err != nil {
+		return result, err
+	}
+	result.WithCredentialsProvider = sessionResp.StatusCode == 200 && sessionResp.Response200.Body.AuthMethod == "BearerToken" && sessionResp.Response200.Body.Credential == "provided-token"
+
+	// The first alternative of DeleteUser, API key and session token, has defaults
+	deleteResp, err := api.DeleteUser(ctx, sdk.NewDeleteUserReq("user-1"))
+	if err != nil {
+		return result, err
+	}
+	result.WithDefaultAlternative = deleteResp.StatusCode == 200 && deleteResp.Response200.Body.AuthAlternative == "apiKeyAuth+sessionTokenAuth"
+
+	webhookResp, err := api.ReceiveWebhook(ctx, sdk.NewReceiveWebhookReq(sdk.NewReceiveWebhookRequestBody("user.created")))
+	if err != nil {
+		return result, err
+	}
+	result.WithDefaultWebhookKey = webhookResp.StatusCode == 200 && webhookResp.Response200.Body.KeyId == "webhook-key"
+
+	failingAPI := sdk.NewTestingAPI(serverAddr, sdk.WithBearerTokenCredentialsProvider(sdk.CredentialsProviderFunc[string](func(ctx context.Context) (string, error) {
+		return "", fmt.Errorf("no token available")
+	})))
+	_, err = failingAPI.GetSession(ctx, sdk.NewGetSessionReq())
+	if err != nil {
+		if err.Reason == sdk.ReasonAuth {
+			result.WithFailingCredentialsProvider = true
+		} else {
+			return result, err
+		}
+	}
+
+	_, err = sdk.NewTestingAPI(serverAddr).ListUsers(ctx, sdk.NewListUsersReq())
+	if err != nil {
+		if err.Reason == sdk.ReasonEncoding {
+			result.WithoutDefaultsMissingCredentials = true
+		} else {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+type DeleteUserResult struct {
+	WithMissingCredentials         bool
+	WithIncompleteAlternative      bool
+	WithBothAlternativesError      bool
+	WithIncompleteAlternativeSent  bool
+	WithMalformedUnusedAlternative bool
+	ValidOperationAsUser           bool
+	ValidOperationAsAdmin          bool
+	WithRevokedAdminToken          bool
+	WithInsufficientScope          bool
+}
+
+func testDeleteUser(ctx context.Context, api *sdk.TestingAPI, serverAddr string) (DeleteUserResult, error) {
+	var result DeleteUserResult
+
+	userId := "user-1"
+	apiKey, sessionToken, adminToken, revokedAdminToken := VALID_API_KEY, VALID_SESSION_TOKEN, VALID_ADMIN_TOKEN, "revoked"
+
+	encodingErrors := []struct {
+		req *sdk.DeleteUserReq
+		ok  *bool
+	}{
+		{sdk.NewDeleteUserReq(userId), &result.WithMissingCredentials},
+		{sdk.NewDeleteUserReq(userId).WithAPIKeyAuth(&apiKey), &result.WithIncompleteAlternative},
+		{sdk.NewDeleteUserReq(userId).WithAPIKeyAuth(&apiKey).WithSessionTokenAuth(&sessionToken).WithAdminTokenAuth(&adminToken), &result.WithBothAlternativesError},
+	}
+	for _, c := range encodingErrors {
+		_, err := api.DeleteUser(ctx, c.req)
+		if err == nil {
+			continue
+		}
+		if err.Reason != sdk.ReasonEncoding {
+			return result, err
+		}
+		*c.ok = true
+	}
+
+	// The SDK does not send incomplete alternatives, nor credentials of two alternatives, so the requests are sent directly
+	deleteDirectly := func(headers map[string]string) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodDelete, serverAddr+"/users/"+userId, nil)
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		return http.DefaultClient.Do(req)
+	}
+
+	httpResp, rerr := deleteDirectly(map[string]string{"X-App-API-Key": apiKey})
+	if rerr != nil {
+		return result, rerr
+	}
+	defer httpResp.Body.Close()
+	var errResp sdk.ErrorResponse
+	if rerr := json.NewDecoder(httpResp.Body).Decode(&errResp); rerr != nil {
+		return result, rerr
+	}
+	// The issue is about no single header, so it has no path
+	result.WithIncompleteAlternativeSent = httpResp.StatusCode == 401 && errResp.DebugMessage != nil &&
+		*errResp.DebugMessage == "unauthenticated: validation failed: auth: all of the authentication parameters of one of the following sets are required: [X-App-API-Key, X-App-Session-Token] or [X-App-Admin-Token]"
+
+	// A malformed admin token, without its "Admin " prefix, does not reject the request of a user
+	httpResp, rerr = deleteDirectly(map[string]string{"X-App-API-Key": apiKey, "X-App-Session-Token": sessionToken, "X-App-Admin-Token": "junk"})
+	if rerr != nil {
+		return result, rerr
+	}
+	defer httpResp.Body.Close()
+	var deleted sdk.DeleteUserResponseBody
+	if httpResp.StatusCode == 200 && json.NewDecoder(httpResp.Body).Decode(&deleted) == nil {
+		result.WithMalformedUnusedAlternative = deleted.AuthAlternative == "apiKeyAuth+sessionTokenAuth"
+	}
+
+	cases := []struct {
+		req         *sdk.DeleteUserReq
+		alternative string
+		ok          *bool
+	}{
+		{sdk.NewDeleteUserReq(userId).WithAPIKeyAuth(&apiKey).WithSessionTokenAuth(&sessionToken), "apiKeyAuth+sessionTokenAuth", &result.ValidOperationAsUser},
+		{sdk.NewDeleteUserReq(userId).WithAdminTokenAuth(&adminToken), "adminTokenAuth", &result.ValidOperationAsAdmin},
+	}
+	for _, c := range cases {
+		resp, err := api.DeleteUser(ctx, c.req)
+		if err != nil {
+			return result, err
+		}
+		if resp.StatusCode == 200 && resp.Response200.Body.UserId == userId && resp.Response200.Body.AuthAlternative == c.alternative {
+			*c.ok = true
+		}
+	}
+
+	resp, err := api.DeleteUser(ctx, sdk.NewDeleteUserReq(userId).WithAdminTokenAuth(&revokedAdminToken))
+	if err != nil {
+		return result, err
+	}
+	result.WithRevokedAdminToken = resp.StatusCode == 401
+
+	readOnlySessionToken := "read-only"
+	resp, err = api.DeleteUser(ctx, sdk.NewDeleteUserReq(userId).WithAPIKeyAuth(&apiKey).WithSessionTokenAuth(&readOnlySessionToken))
+	if err != nil {
+		return result, err
+	}
+	if resp.StatusCode == 403 && resp.UnknownResponse != nil {
+		defer resp.UnknownResponse.Body.Close()
+		var scopeErrResp sdk.ErrorResponse
+		if rerr := json.NewDecoder(resp.UnknownResponse.Body).Decode(&scopeErrResp); rerr != nil {
+			return result, rerr
+		}
+		// The required scopes are in the message, not the path
+		result.WithInsufficientScope = scopeErrResp.DebugMessage != nil &&
+			*scopeErrResp.DebugMessage == "forbidden: validation failed: auth: insufficient scope, required: users:delete: read-only session lacks scope users:delete"
+	}
+
+	return result, nil
+}
+
+type RetryResult struct {
+	RetriedToSuccess        bool
+	WithExhaustedAttempts   bool
+	WithLongRetryAfter      bool
+	NonIdempotentNotRetried bool
+}
+
+func testRetry(ctx context.Context, api *sdk.TestingAPI) (RetryResult, error) {
+	var result RetryResult
+	// The server counts the requests per key, so every run uses new keys
+	run := strconv.FormatInt(time.Now().UnixNano(), 36)
+
+	// GetFlaky is retried up to 4 attempts
+	resp, err := api.GetFlaky(ctx, sdk.NewGetFlakyReq(3, "go-success-"+run))
+	if err != nil {
+		return result, err
+	}
+	result.RetriedToSuccess = resp.StatusCode == 200 && resp.Response200.Body.Attempts == 4
+
+	resp, err = api.GetFlaky(ctx, sdk.NewGetFlakyReq(10, "go-exhausted-"+run))
+	if err != nil {
+		return result, err
+	}
+	if resp.StatusCode == 503 {
+		resp, err = api.GetFlaky(ctx, sdk.NewGetFlakyReq(0, "go-exhausted-"+run))
+		if err != nil {
+			return result, err
+		}
+		result.WithExhaustedAttempts = resp.StatusCode == 200 && resp.Response200.Body.Attempts == 5
+	}
+
+	// A Retry-After above the policy's maxBackoff is not waited for
+	resp, err = api.GetFlaky(ctx, sdk.NewGetFlakyReq(1, "slow-go-"+run))
+	if err != nil {
+		return result, err
+	}
+	if resp.StatusCode == 503 && resp.Response503.RetryAfter == 1 {
+		resp, err = api.GetFlaky(ctx, sdk.NewGetFlakyReq(1, "slow-go-"+run))
+		if err != nil {
+			return result, err
+		}
+		result.WithLongRetryAfter = resp.StatusCode == 200 && resp.Response200.Body.Attempts == 2
+	}
+
+	createResp, err := api.CreateFlaky(ctx, sdk.NewCreateFlakyReq(1, "go-create-"+run))
+	if err != nil {
+		return result, err
+	}
+	if createResp.StatusCode == 503 {
+		createResp, err = api.CreateFlaky(ctx, sdk.NewCreateFlakyReq(1, "go-create-"+run))
+		if err != nil {
+			return result, err
+		}
+		result.NonIdempotentNotRetried = createResp.StatusCode == 200 && createResp.Response200.Body.Attempts == 2
+	}
+
+	return result, nil
+}
+
+type IdempotencyKeyResult struct {
+	RetriedWithSameKey   bool
+	NewKeyPerCall        bool
+	WithReplayedResponse bool
+	WithReusedKey        bool
+	WithMissingKey       bool
+}
+
+func testIdempotencyKey(ctx context.Context, api *sdk.TestingAPI, serverAddr string) (IdempotencyKeyResult, error) {
+	var result IdempotencyKeyResult
+	run := strconv.FormatInt(time.Now().UnixNano(), 36)
+
+	// The 503 responses are not stored, so the retries with the same key reach the handler
+	resp, err := api.SubmitFlaky(ctx, sdk.NewSubmitFlakyReq(2, "go-submit-"+run))
+	if err != nil {
+		return result, err
+	}
+	result.RetriedWithSameKey = resp.StatusCode == 200 && resp.Response200.Body.Attempts == 3
+
+	resp, err = api.SubmitFlaky(ctx, sdk.NewSubmitFlakyReq(0, "go-submit-"+run))
+	if err != nil {
+		return result, err
+	}
+	result.NewKeyPerCall = resp.StatusCode == 200 && resp.Response200.Body.Attempts == 4
+
+	key := "go-key-" + run
+	first, err := api.SubmitFlaky(ctx, sdk.NewSubmitFlakyReq(0, "go-replayed-"+run).WithIdempotencyKey(key))
+	if err != nil {
+		return result, err
+	}
+	second, err := api.SubmitFlaky(ctx, sdk.NewSubmitFlakyReq(0, "go-replayed-"+run).WithIdempotencyKey(key))
+	if err != nil {
+		return result, err
+	}
+	result.WithReplayedResponse = first.StatusCode == 200 && second.StatusCode == 200 &&
+		first.Response200.Body.Attempts == 1 && second.Response200.Body.Attempts == 1
+
+	reused, err := api.SubmitFlaky(ctx, sdk.NewSubmitFlakyReq(1, "go-replayed-"+run).WithIdempotencyKey(key))
+	if err != nil {
+		return result, err
+	}
+	if reused.UnknownResponse != nil {
+		reused.UnknownResponse.Body.Close()
+	}
+	result.WithReusedKey = reused.StatusCode == 422
+
+	req, rerr := http.NewRequestWithContext(ctx, http.MethodPost, serverAddr+"/flaky/submit?key=go-missing-"+run+"&failures=0", nil)
+	if rerr != nil {
+		return result, rerr
+	}
+	missingResp, rerr := http.DefaultClient.Do(req)
+	if rerr != nil {
+		return result, rerr
+	}
+	missingResp.Body.Close()
+	result.WithMissingKey = missingResp.StatusCode == 400
+
+	return result, nil
+}
+
+type MiddlewareResult struct {
+	ReceivesRoute     bool
+	SeesEveryAttempt  bool
+	RunsInOrder       bool
+	CanAnswerRequests bool
+}
+
+func testMiddleware(ctx context.Context, serverAddr string) (MiddlewareResult, error) {
+	var result MiddlewareResult
+
+	var mu sync.Mutex
+	var routes []sdk.Route
+	var calls []string
+	record := func(name string) sdk.Middleware {
+		return func(next sdk.Doer) sdk.Doer {
+			return sdk.DoerFunc(func(route sdk.Route, req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				calls = append(calls, name+" "+route.Name)
+				if name == "outer" {
+					routes = append(routes, route)
+				}
+				mu.Unlock()
+				resp, err := next.Do(route, req)
+				mu.Lock()
+				calls = append(calls, name+" done")
+				mu.Unlock()
+				return resp, err
+			})
+		}
+	}
+	api := sdk.NewTestingAPI(serverAddr, sdk.WithMiddleware(record("outer")), sdk.WithMiddleware(record("inner")))
+
+	health, err := api.HealthCheck(ctx, sdk.NewHealthCheckReq())
+	if err != nil {
+		return result, err
+	}
+	result.ReceivesRoute = health.StatusCode == 200 && len(routes) == 1 &&
+		routes[0].Name == "HealthCheck" && routes[0].Method == "GET" && routes[0].Path == "/health" && routes[0].Idempotent
+	result.RunsInOrder = slices.Equal(calls, []string{"outer HealthCheck", "inner HealthCheck", "inner done", "outer done"})
+
+	routes = nil
+	flaky, err := api.GetFlaky(ctx, sdk.NewGetFlakyReq(2, "go-middleware-"+strconv.FormatInt(time.Now().UnixNano(), 36)))
+	if err != nil {
+		return result, err
+	}
+	result.SeesEveryAttempt = flaky.StatusCode == 200 && len(routes) == 3
+
+	cached := sdk.NewTestingAPI(serverAddr, sdk.WithMiddleware(func(next sdk.Doer) sdk.Doer {
+		return sdk.DoerFunc(func(route sdk.Route, req *http.Request) (*http.Response, error) {
+			if route.Name != sdk.HealthCheckRoute.Name {
+				return next.Do(route, req)
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"Status":"cached"}`)),
+				Request:    req,
+			}, nil
+		})
+	}))
+	health, err = cached.HealthCheck(ctx, sdk.NewHealthCheckReq())
+	if err != nil {
+		return result, err
+	}
+	result.CanAnswerRequests = health.StatusCode == 200 && health.Response200.Body.Status == "cached"
+
+	return result, nil
+}
+
+type PaginationResult struct {
+	IteratesAllPages    bool
+	StartsAtParamPage   bool
+	StopsWhenBroken     bool
+	YieldsErrors        bool
+	IteratesAllOffsets  bool
+	StartsAtParamOffset bool
+	IteratesAllCursors  bool
+	StartsAtParamCursor bool
+}
+
+func testPagination(ctx context.Context, serverAddr string) (PaginationResult, error) {
+	var result PaginationResult
+
+	var mu sync.Mutex
+	requests := 0
+	api := sdk.NewTestingAPI(serverAddr,
+		sdk.WithAPIKeyCredentials(VALID_API_KEY),
+		sdk.WithAdminTokenCredentials(VALID_ADMIN_TOKEN),
+		sdk.WithMiddleware(func(next sdk.Doer) sdk.Doer {
+			return sdk.DoerFunc(func(route sdk.Route, req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				requests++
+				mu.Unlock()
+				return next.Do(route, req)
+			})
+		}),
+	)
+
+	// The users in a single page, to compare with those of the iterator
+	var pageSize int64 = 100
+	all, err := api.ListUsers(ctx, sdk.NewListUsersReq().WithPageSize(&pageSize))
+	if err != nil {
+		return result, err
+	}
+	if all.StatusCode != 200 {
+		return result, fmt.Errorf("ListUsers responded with status %d", all.StatusCode)
+	}
+	var want []string
+	for _, user := range all.Response200.Body.Users {
+		want = append(want, user.UserId)
+	}
+
+	// One user per page, so every user takes a request, and the total count ends the iteration
+	pageSize = 1
+	requests = 0
+	var got []string
+	for user, err := range api.ListUsersAll(ctx, sdk.NewListUsersReq().WithPageSize(&pageSize)) {
+		if err != nil {
+			return result, err
+		}
+		got = append(got, user.UserId)
+	}
+	result.IteratesAllPages = len(want) >= 2 && slices.Equal(got, want) && requests == len(want)
+
+	// The users of the pages before the first one count towards the total, so no page is requested past it
+	page := int64(1)
+	requests = 0
+	got = nil
+	for user, err := range api.ListUsersAll(ctx, sdk.NewListUsersReq().WithPageSize(&pageSize).WithPageNumber(&page)) {
+		if err != nil {
+			return result, err
+		}
+		got = append(got, user.UserId)
+	}
+	result.StartsAtParamPage = slices.Equal(got, want[1:]) && requests == len(want)-1
+
+	requests = 0
+	for _, err := range api.ListUsersAll(ctx, sdk.NewListUsersReq().WithPageSize(&pageSize)) {
+		if err != nil {
+			return result, err
+		}
+		break
+	}
+	result.StopsWhenBroken = requests == 1
+
+	var errs []error
+	for _, err := range api.ListUsersAll(ctx, sdk.NewListUsersReq().WithAPIKeyAuth(INVALID_API_KEY)) {
+		errs = append(errs, err)
+	}
+	var apiErr *sdk.TestingAPIError
+	result.YieldsErrors = len(errs) == 1 && errors.As(errs[0], &apiErr) && apiErr.Reason == sdk.ReasonUnexpected
+
+	// The server has 5 events, listed 2 per page
+	wantEvents := []string{"event-1", "event-2", "event-3", "event-4", "event-5"}
+	limit := int64(2)
+	collect := func(events iter.Seq2[string, error]) ([]string, error) {
+		requests = 0
+		var got []string
+		for event, err := range events {
+			if err != nil {
+				return nil, err
+			}
+			got = append(got, event)
+		}
+		return got, nil
+	}
+
+	gotEvents, cerr := collect(api.ListEventsAll(ctx, sdk.NewListEventsReq().WithLimit(&limit)))
+	if cerr != nil {
+		return result, cerr
+	}
+	result.IteratesAllOffsets = slices.Equal(gotEvents, wantEvents) && requests == 3
+
+	// From the offset 3, the last page holds the total count
+	offset := int64(3)
+	gotEvents, cerr = collect(api.ListEventsAll(ctx, sdk.NewListEventsReq().WithLimit(&limit).WithOffset(&offset)))
+	if cerr != nil {
+		return result, cerr
+	}
+	result.StartsAtParamOffset = slices.Equal(gotEvents, wantEvents[3:]) && requests == 1
+
+	gotEvents, cerr = collect(api.ListEventFeedAll(ctx, sdk.NewListEventFeedReq().WithLimit(&limit)))
+	if cerr != nil {
+		return result, cerr
+	}
+	result.IteratesAllCursors = slices.Equal(gotEvents, wantEvents) && requests == 3
+
+	cursor := "3"
+	gotEvents, cerr = collect(api.ListEventFeedAll(ctx, sdk.NewListEventFeedReq().WithLimit(&limit).WithCursor(&cursor)))
+	if cerr != nil {
+		return result, cerr
+	}
+	result.StartsAtParamCursor = slices.Equal(gotEvents, wantEvents[3:]) && requests == 1
+
+	return result, nil
+}
+
 type WhoAmIResult struct {
 	ValidRawBody bool
 }
@@ -388,7 +1516,7 @@ func testWhoAmI(ctx context.Context, api *sdk.TestingAPI) (WhoAmIResult, error)
 	var result WhoAmIResult
 
 	userId := "test@example.com"
-	req := sdk.NewWhoAmIReq(VALID_API_KEY, VALID_SESSION_TOKEN)
+	req := sdk.NewWhoAmIReq().WithAPIKeyAuth(VALID_API_KEY).WithSessionTokenAuth(VALID_SESSION_TOKEN)
 	res, err := api.WhoAmI(ctx, req, strings.NewReader(userId))
 	if err != nil {
 		return result, err
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	sdk "github.com/nbrglm/napiway/testdata/out/go_sdk"
+)
+
+// Tests the generated OAuth2 token providers against local token and API servers.
+//
+//	go test ./...
+
+// tokenServer is an OAuth2 token endpoint issuing "token-<n>" tokens for the client credentials grant.
+type tokenServer struct {
+	*httptest.Server
+	expiresIn int
+
+	mu       sync.Mutex
+	requests int
+	scopes   []string
+}
+
+func newTokenServer(t *testing.T, expiresIn int) *tokenServer {
+	ts := &tokenServer{expiresIn: expiresIn}
+	ts.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The client credentials are form-encoded (RFC 6749, section 2.3.1)
+		clientID, clientSecret, ok := r.BasicAuth()
+		clientID, _ = url.QueryUnescape(clientID)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+		if r.Method != http.MethodPost || !ok || clientID != "client+id" || clientSecret != "s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+			return
+		}
+		if r.PostFormValue("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"unsupported_grant_type"}`))
+			return
+		}
+		ts.mu.Lock()
+		ts.requests++
+		ts.scopes = strings.Fields(r.PostFormValue("scope"))
+		token := fmt.Sprintf("token-%d", ts.requests)
+		ts.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": token, "token_type": "Bearer", "expires_in": ts.expiresIn})
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func (ts *tokenServer) numRequests() int {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.requests
+}
+
+func (ts *tokenServer) provider() *sdk.ClientCredentialsTokenProvider {
+	provider := sdk.NewServiceTokenProvider("client+id", "s3cret")
+	provider.TokenURL = ts.URL
+	return provider
+}
+
+func TestClientCredentialsTokenProvider(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("caches tokens", func(t *testing.T) {
+		ts := newTokenServer(t, 3600)
+		provider := ts.provider()
+		for range 3 {
+			token, err := provider.Token(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if token != "token-1" {
+				t.Fatalf("got token %q, want token-1", token)
+			}
+		}
+		if n := ts.numRequests(); n != 1 {
+			t.Errorf("got %d token requests, want 1", n)
+		}
+		if want := []string{"session:read"}; !slices.Equal(ts.scopes, want) {
+			t.Errorf("got scopes %v, want %v", ts.scopes, want)
+		}
+	})
+
+	t.Run("refreshes tokens before expiry", func(t *testing.T) {
+		// Tokens that expire within RefreshBefore are never reused
+		ts := newTokenServer(t, 10)
+		provider := ts.provider()
+		for i := range 2 {
+			token, err := provider.Token(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := fmt.Sprintf("token-%d", i+1); token != want {
+				t.Fatalf("got token %q, want %q", token, want)
+			}
+		}
+	})
+
+	t.Run("invalidates tokens", func(t *testing.T) {
+		ts := newTokenServer(t, 3600)
+		provider := ts.provider()
+		token, err := provider.Token(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		provider.Invalidate("another-token")
+		if again, _ := provider.Token(ctx); again != token {
+			t.Fatalf("got token %q after invalidating another token, want %q", again, token)
+		}
+		provider.Invalidate(token)
+		if again, _ := provider.Token(ctx); again != "token-2" {
+			t.Fatalf("got token %q after invalidating it, want token-2", again)
+		}
+	})
+
+	t.Run("rejected client credentials", func(t *testing.T) {
+		ts := newTokenServer(t, 3600)
+		provider := ts.provider()
+		provider.ClientSecret = "wrong"
+		if _, err := provider.Token(ctx); err == nil || !strings.Contains(err.Error(), "invalid_client") {
+			t.Fatalf("got error %v, want the invalid_client response", err)
+		}
+	})
+}
+
+// newSessionServer is an API server answering GetSession with the service token of the request,
+// and with 401 Unauthorized for the rejected tokens.
+func newSessionServer(t *testing.T, rejected ...string) (*httptest.Server, *int) {
+	var mu sync.Mutex
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		token := strings.TrimPrefix(r.Header.Get("X-App-Service-Token"), "Bearer ")
+		if r.URL.Path != "/session" || token == "" || slices.Contains(rejected, token) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"AuthMethod": "Service", "Credential": token})
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestClientTokenProvider(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sets tokens", func(t *testing.T) {
+		ts := newTokenServer(t, 3600)
+		server, requests := newSessionServer(t)
+		client := sdk.NewTestingAPI(server.URL, sdk.WithServiceTokenProvider(ts.provider()))
+
+		req := sdk.NewGetSessionReq()
+		for range 2 {
+			resp, err := client.GetSession(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != 200 || resp.Response200.Body.Credential != "token-1" {
+				t.Fatalf("got status %d, want 200 with token-1", resp.StatusCode)
+			}
+		}
+		if req.ServiceAuth != nil {
+			t.Errorf("the provided token was set on the caller's params")
+		}
+		if *requests != 2 || ts.numRequests() != 1 {
+			t.Errorf("got %d API and %d token requests, want 2 and 1", *requests, ts.numRequests())
+		}
+	})
+
+	t.Run("prefers given credentials", func(t *testing.T) {
+		ts := newTokenServer(t, 3600)
+		server, _ := newSessionServer(t)
+		client := sdk.NewTestingAPI(server.URL, sdk.WithServiceTokenProvider(ts.provider()))
+
+		token := "given-token"
+		resp, err := client.GetSession(ctx, sdk.NewGetSessionReq().WithServiceAuth(&token))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != 200 || resp.Response200.Body.Credential != token {
+			t.Fatalf("got status %d, want 200 with %s", resp.StatusCode, token)
+		}
+		if n := ts.numRequests(); n != 0 {
+			t.Errorf("got %d token requests, want 0", n)
+		}
+	})
+
+	t.Run("retries once on 401", func(t *testing.T) {
+		ts := newTokenServer(t, 3600)
+		server, requests := newSessionServer(t, "token-1")
+		client := sdk.NewTestingAPI(server.URL, sdk.WithServiceTokenProvider(ts.provider()))
+
+		resp, err := client.GetSession(ctx, sdk.NewGetSessionReq())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != 200 || resp.Response200.Body.Credential != "token-2" {
+			t.Fatalf("got status %d, want 200 with token-2", resp.StatusCode)
+		}
+		if *requests != 2 || ts.numRequests() != 2 {
+			t.Errorf("got %d API and %d token requests, want 2 and 2", *requests, ts.numRequests())
+		}
+	})
+
+	t.Run("does not retry twice", func(t *testing.T) {
+		ts := newTokenServer(t, 3600)
+		server, requests := newSessionServer(t, "token-1", "token-2", "token-3")
+		client := sdk.NewTestingAPI(server.URL, sdk.WithServiceTokenProvider(ts.provider()))
+
+		resp, err := client.GetSession(ctx, sdk.NewGetSessionReq())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != 401 {
+			t.Fatalf("got status %d, want 401", resp.StatusCode)
+		}
+		if *requests != 2 {
+			t.Errorf("got %d API requests, want 2", *requests)
+		}
+	})
+
+	t.Run("token errors", func(t *testing.T) {
+		ts := newTokenServer(t, 3600)
+		server, requests := newSessionServer(t)
+		provider := ts.provider()
+		provider.ClientSecret = "wrong"
+		client := sdk.NewTestingAPI(server.URL, sdk.WithServiceTokenProvider(provider))
+
+		_, err := client.GetSession(ctx, sdk.NewGetSessionReq())
+		if err == nil || err.Reason != sdk.ReasonAuth {
+			t.Fatalf("got error %v, want a %s error", err, sdk.ReasonAuth)
+		}
+		if *requests != 0 {
+			t.Errorf("got %d API requests, want 0", *requests)
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package go_sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	CreateFlakyReqHTTPMethod = "POST"
+	CreateFlakyReqRoutePath  = "/flaky"
+)
+
+// Same as GetFlaky, but not idempotent, so the SDKs do not retry it.
+type CreateFlakyReq struct {
+
+	// Source: query parameter "failures"
+	//
+
+	// The number of requests with the key that fail.
+	//
+	// Required
+	Failures int64
+
+	// Source: query parameter "key"
+	//
+
+	// The key the requests are counted by.
+	//
+	// Required
+	Key string
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type CreateFlaky200 struct {
+
+	// Response body
+	Body *FlakyResponseBody
+}
+
+// Service Unavailable
+type CreateFlaky503 struct {
+
+	// Source: header parameter "Retry-After"
+	//
+
+	// The number of seconds to wait before retrying.
+	//
+	// Required
+	RetryAfter int64
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// NewCreateFlakyReq creates a new instance of CreateFlakyReq with required fields as parameters
+func NewCreateFlakyReq(
+
+	Failures int64,
+
+	Key string,
+
+) *CreateFlakyReq {
+	return &CreateFlakyReq{
+
+		Failures: Failures,
+
+		Key: Key,
+	}
+}
+
+// ParseCreateFlaky200 creates a new instance of CreateFlaky200 by parsing a map[string]any
+func ParseCreateFlaky200(resp *http.Response) (*CreateFlaky200, error) {
+	result := new(CreateFlaky200)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(FlakyResponseBody)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for CreateFlaky200: %w", err)
+	}
+
+	return result, nil
+}
+
+// ParseCreateFlaky503 creates a new instance of CreateFlaky503 by parsing a map[string]any
+func ParseCreateFlaky503(resp *http.Response) (*CreateFlaky503, error) {
+	result := new(CreateFlaky503)
+
+	headerRetryAfter, err := parseint64Param(resp.Header.Get("Retry-After"), "header: Retry-After", true)
+	if err != nil {
+		return nil, err
+	}
+
+	result.RetryAfter = *headerRetryAfter
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(ErrorResponse)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for CreateFlaky503: %w", err)
+	}
+
+	return result, nil
+}
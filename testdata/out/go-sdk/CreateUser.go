@@ -21,7 +21,9 @@ type CreateUserReq struct {
 	//
 	// Authentication method that denotes an admin token passed in the request header.
 	//
-	// Format (NOT ENFORCED): admin_token
+	// Format (enforced by the server, applied by the SDK to a bare token): Admin {token}
+	//
+	// If unset, the client uses the default set with WithAdminTokenCredentials, if any.
 	//
 	AdminTokenAuth string
 
@@ -30,7 +32,9 @@ type CreateUserReq struct {
 	//
 	// Authentication method that denotes an API key passed in the request header.
 	//
-	// Format (NOT ENFORCED): api_key
+	// Format (documentation only): api_key
+	//
+	// If unset, the client uses the default set with WithAPIKeyCredentials, if any.
 	//
 	APIKeyAuth string
 
@@ -75,23 +79,27 @@ type CreateUser500 struct {
 // NewCreateUserReq creates a new instance of CreateUserReq with required fields as parameters
 func NewCreateUserReq(
 
-	AdminTokenAuth string,
-
-	APIKeyAuth string,
-
 	Body *CreateUserRequestBody,
 
 ) *CreateUserReq {
 	return &CreateUserReq{
 
-		AdminTokenAuth: AdminTokenAuth,
-
-		APIKeyAuth: APIKeyAuth,
-
 		Body: Body,
 	}
 }
 
+// WithAdminTokenAuth sets the authentication parameter AdminTokenAuth, overriding the default of the client, and returns the modified CreateUserReq instance
+func (o *CreateUserReq) WithAdminTokenAuth(value string) *CreateUserReq {
+	o.AdminTokenAuth = value
+	return o
+}
+
+// WithAPIKeyAuth sets the authentication parameter APIKeyAuth, overriding the default of the client, and returns the modified CreateUserReq instance
+func (o *CreateUserReq) WithAPIKeyAuth(value string) *CreateUserReq {
+	o.APIKeyAuth = value
+	return o
+}
+
 // ParseCreateUser201 creates a new instance of CreateUser201 by parsing a map[string]any
 func ParseCreateUser201(resp *http.Response) (*CreateUser201, error) {
 	result := new(CreateUser201)
@@ -0,0 +1,117 @@
+package go_sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	DeleteUserReqHTTPMethod = "DELETE"
+	DeleteUserReqRoutePath  = "/users/{userId}"
+)
+
+// Delete a user, either as the user (API key and session token) or as an admin (admin token alone).
+type DeleteUserReq struct {
+
+	// Source: path parameter "{userId}"
+	//
+
+	// The unique identifier of the user.
+	//
+	// Required
+	UserId string
+
+	// All of the authentication methods of at least one of the following alternatives (upto AUTH-ALTERNATIVES-END) are required:
+	//   - APIKeyAuth + SessionTokenAuth
+	//   - AdminTokenAuth
+
+	// Source: header "X-App-Admin-Token"
+	//
+	// Authentication method that denotes an admin token passed in the request header.
+	//
+	// Format (enforced by the server, applied by the SDK to a bare token): Admin {token}
+	//
+	// If unset, the client uses the default set with WithAdminTokenCredentials, if any.
+	//
+	AdminTokenAuth *string
+
+	// Source: header "X-App-API-Key"
+	//
+	// Authentication method that denotes an API key passed in the request header.
+	//
+	// Format (documentation only): api_key
+	//
+	// If unset, the client uses the default set with WithAPIKeyCredentials, if any.
+	//
+	APIKeyAuth *string
+
+	// Source: header "X-App-Session-Token"
+	//
+	// Authentication method that denotes a session token passed in the request header.
+	//
+	// Format (documentation only): session_token
+	//
+	// If unset, the client uses the default set with WithSessionTokenCredentials, if any.
+	//
+	SessionTokenAuth *string
+
+	// AUTH-ALTERNATIVES-END
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type DeleteUser200 struct {
+
+	// Response body
+	Body *DeleteUserResponseBody
+}
+
+// NewDeleteUserReq creates a new instance of DeleteUserReq with required fields as parameters
+func NewDeleteUserReq(
+
+	UserId string,
+
+) *DeleteUserReq {
+	return &DeleteUserReq{
+
+		UserId: UserId,
+	}
+}
+
+// WithAdminTokenAuth sets the authentication parameter AdminTokenAuth of the auth alternatives and returns the modified DeleteUserReq instance
+func (o *DeleteUserReq) WithAdminTokenAuth(value *string) *DeleteUserReq {
+	o.AdminTokenAuth = value
+	return o
+}
+
+// WithAPIKeyAuth sets the authentication parameter APIKeyAuth of the auth alternatives and returns the modified DeleteUserReq instance
+func (o *DeleteUserReq) WithAPIKeyAuth(value *string) *DeleteUserReq {
+	o.APIKeyAuth = value
+	return o
+}
+
+// WithSessionTokenAuth sets the authentication parameter SessionTokenAuth of the auth alternatives and returns the modified DeleteUserReq instance
+func (o *DeleteUserReq) WithSessionTokenAuth(value *string) *DeleteUserReq {
+	o.SessionTokenAuth = value
+	return o
+}
+
+// ParseDeleteUser200 creates a new instance of DeleteUser200 by parsing a map[string]any
+func ParseDeleteUser200(resp *http.Response) (*DeleteUser200, error) {
+	result := new(DeleteUser200)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(DeleteUserResponseBody)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for DeleteUser200: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,113 @@
+package go_sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	GetFlakyReqHTTPMethod = "GET"
+	GetFlakyReqRoutePath  = "/flaky"
+)
+
+// Fails with 503 Service Unavailable for the first Failures requests with the same key.
+type GetFlakyReq struct {
+
+	// Source: query parameter "failures"
+	//
+
+	// The number of requests with the key that fail.
+	//
+	// Required
+	Failures int64
+
+	// Source: query parameter "key"
+	//
+
+	// The key the requests are counted by.
+	//
+	// Required
+	Key string
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type GetFlaky200 struct {
+
+	// Response body
+	Body *FlakyResponseBody
+}
+
+// Service Unavailable
+type GetFlaky503 struct {
+
+	// Source: header parameter "Retry-After"
+	//
+
+	// The number of seconds to wait before retrying.
+	//
+	// Required
+	RetryAfter int64
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// NewGetFlakyReq creates a new instance of GetFlakyReq with required fields as parameters
+func NewGetFlakyReq(
+
+	Failures int64,
+
+	Key string,
+
+) *GetFlakyReq {
+	return &GetFlakyReq{
+
+		Failures: Failures,
+
+		Key: Key,
+	}
+}
+
+// ParseGetFlaky200 creates a new instance of GetFlaky200 by parsing a map[string]any
+func ParseGetFlaky200(resp *http.Response) (*GetFlaky200, error) {
+	result := new(GetFlaky200)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(FlakyResponseBody)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for GetFlaky200: %w", err)
+	}
+
+	return result, nil
+}
+
+// ParseGetFlaky503 creates a new instance of GetFlaky503 by parsing a map[string]any
+func ParseGetFlaky503(resp *http.Response) (*GetFlaky503, error) {
+	result := new(GetFlaky503)
+
+	headerRetryAfter, err := parseint64Param(resp.Header.Get("Retry-After"), "header: Retry-After", true)
+	if err != nil {
+		return nil, err
+	}
+
+	result.RetryAfter = *headerRetryAfter
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(ErrorResponse)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for GetFlaky503: %w", err)
+	}
+
+	return result, nil
+}
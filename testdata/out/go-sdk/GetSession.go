@@ -0,0 +1,128 @@
+package go_sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	GetSessionReqHTTPMethod = "GET"
+	GetSessionReqRoutePath  = "/session"
+)
+
+// Get the credential the request was authenticated with.
+type GetSessionReq struct {
+
+	// At least one of the following (upto AUTH-ANY-END) authentication methods is required
+
+	// Source: basic "Authorization"
+	//
+	// Authentication method that denotes a username and password passed in the Authorization header.
+	//
+	// If unset, the client uses the default set with WithBasicCredentials, if any.
+	//
+	BasicAuth *BasicAuthCredentials
+
+	// Source: bearer "Authorization"
+	//
+	// Authentication method that denotes a bearer token passed in the Authorization header.
+	//
+	// Format (enforced by the server): ^[A-Za-z0-9._~+/-]+=*$
+	//
+	// If unset, the client uses the default set with WithBearerTokenCredentials, if any.
+	//
+	BearerTokenAuth *string
+
+	// Source: query "api_key"
+	//
+	// Authentication method that denotes an API key passed in the query string.
+	//
+	// Format (enforced by the server, applied by the SDK to a bare token): qk_{token}
+	//
+	// If unset, the client uses the default set with WithQueryKeyCredentials, if any.
+	//
+	QueryKeyAuth *string
+
+	// Source: oauth2ClientCredentials "X-App-Service-Token"
+	//
+	// Authentication method that denotes an OAuth2 access token of a backend service, obtained with the client credentials grant.
+	//
+	// If unset, the client uses the default set with WithServiceCredentials, or obtains it from the TokenProvider set with WithServiceTokenProvider, if any.
+	//
+	ServiceAuth *string
+
+	// Source: cookie "app_session"
+	//
+	// Authentication method that denotes a session passed in a cookie.
+	//
+	// Format (enforced by the server): ^v1\.(?P<token>[A-Za-z0-9-]+)$
+	//
+	// If unset, the client uses the default set with WithSessionCookieCredentials, if any.
+	//
+	SessionCookieAuth *string
+
+	// AUTH-ANY-END
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type GetSession200 struct {
+
+	// Response body
+	Body *GetSessionResponseBody
+}
+
+// NewGetSessionReq creates a new instance of GetSessionReq with required fields as parameters
+func NewGetSessionReq() *GetSessionReq {
+	return &GetSessionReq{}
+}
+
+// WithBasicAuth sets the optional authentication parameter BasicAuth and returns the modified GetSessionReq instance
+func (o *GetSessionReq) WithBasicAuth(value *BasicAuthCredentials) *GetSessionReq {
+	o.BasicAuth = value
+	return o
+}
+
+// WithBearerTokenAuth sets the optional authentication parameter BearerTokenAuth and returns the modified GetSessionReq instance
+func (o *GetSessionReq) WithBearerTokenAuth(value *string) *GetSessionReq {
+	o.BearerTokenAuth = value
+	return o
+}
+
+// WithQueryKeyAuth sets the optional authentication parameter QueryKeyAuth and returns the modified GetSessionReq instance
+func (o *GetSessionReq) WithQueryKeyAuth(value *string) *GetSessionReq {
+	o.QueryKeyAuth = value
+	return o
+}
+
+// WithServiceAuth sets the optional authentication parameter ServiceAuth and returns the modified GetSessionReq instance
+func (o *GetSessionReq) WithServiceAuth(value *string) *GetSessionReq {
+	o.ServiceAuth = value
+	return o
+}
+
+// WithSessionCookieAuth sets the optional authentication parameter SessionCookieAuth and returns the modified GetSessionReq instance
+func (o *GetSessionReq) WithSessionCookieAuth(value *string) *GetSessionReq {
+	o.SessionCookieAuth = value
+	return o
+}
+
+// ParseGetSession200 creates a new instance of GetSession200 by parsing a map[string]any
+func ParseGetSession200(resp *http.Response) (*GetSession200, error) {
+	result := new(GetSession200)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(GetSessionResponseBody)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for GetSession200: %w", err)
+	}
+
+	return result, nil
+}
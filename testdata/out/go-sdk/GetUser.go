@@ -29,7 +29,9 @@ type GetUserReq struct {
 	//
 	// Authentication method that denotes an API key passed in the request header.
 	//
-	// Format (NOT ENFORCED): api_key
+	// Format (documentation only): api_key
+	//
+	// If unset, the client uses the default set with WithAPIKeyCredentials, if any.
 	//
 	APIKeyAuth string
 
@@ -38,7 +40,9 @@ type GetUserReq struct {
 	//
 	// Authentication method that denotes a session token passed in the request header.
 	//
-	// Format (NOT ENFORCED): session_token
+	// Format (documentation only): session_token
+	//
+	// If unset, the client uses the default set with WithSessionTokenCredentials, if any.
 	//
 	SessionTokenAuth string
 
@@ -82,19 +86,23 @@ func NewGetUserReq(
 
 	UserId string,
 
-	APIKeyAuth string,
-
-	SessionTokenAuth string,
-
 ) *GetUserReq {
 	return &GetUserReq{
 
 		UserId: UserId,
+	}
+}
 
-		APIKeyAuth: APIKeyAuth,
+// WithAPIKeyAuth sets the authentication parameter APIKeyAuth, overriding the default of the client, and returns the modified GetUserReq instance
+func (o *GetUserReq) WithAPIKeyAuth(value string) *GetUserReq {
+	o.APIKeyAuth = value
+	return o
+}
 
-		SessionTokenAuth: SessionTokenAuth,
-	}
+// WithSessionTokenAuth sets the authentication parameter SessionTokenAuth, overriding the default of the client, and returns the modified GetUserReq instance
+func (o *GetUserReq) WithSessionTokenAuth(value string) *GetUserReq {
+	o.SessionTokenAuth = value
+	return o
 }
 
 // ParseGetUser200 creates a new instance of GetUser200 by parsing a map[string]any
@@ -0,0 +1,76 @@
+package go_sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	ListEventFeedReqHTTPMethod = "GET"
+	ListEventFeedReqRoutePath  = "/events/feed"
+)
+
+// List events, with the cursor returned by the previous page.
+type ListEventFeedReq struct {
+
+	// Source: query parameter "cursor"
+	//
+
+	// The cursor of the page, from the NextCursor of the previous one. Default = the first page.
+	//
+	// Optional
+	Cursor *string
+
+	// Source: query parameter "limit"
+	//
+
+	// The maximum number of events of the page. Default = 10.
+	//
+	// Optional
+	Limit *int64
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type ListEventFeed200 struct {
+
+	// Response body
+	Body *EventFeed
+}
+
+// NewListEventFeedReq creates a new instance of ListEventFeedReq with required fields as parameters
+func NewListEventFeedReq() *ListEventFeedReq {
+	return &ListEventFeedReq{}
+}
+
+// WithCursor sets the optional query parameter Cursor and returns the modified ListEventFeedReq instance
+func (o *ListEventFeedReq) WithCursor(value *string) *ListEventFeedReq {
+	o.Cursor = value
+	return o
+}
+
+// WithLimit sets the optional query parameter Limit and returns the modified ListEventFeedReq instance
+func (o *ListEventFeedReq) WithLimit(value *int64) *ListEventFeedReq {
+	o.Limit = value
+	return o
+}
+
+// ParseListEventFeed200 creates a new instance of ListEventFeed200 by parsing a map[string]any
+func ParseListEventFeed200(resp *http.Response) (*ListEventFeed200, error) {
+	result := new(ListEventFeed200)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(EventFeed)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for ListEventFeed200: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,76 @@
+package go_sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	ListEventsReqHTTPMethod = "GET"
+	ListEventsReqRoutePath  = "/events"
+)
+
+// List events, by the offset of the first one.
+type ListEventsReq struct {
+
+	// Source: query parameter "limit"
+	//
+
+	// The maximum number of events of the page. Default = 10.
+	//
+	// Optional
+	Limit *int64
+
+	// Source: query parameter "offset"
+	//
+
+	// The offset of the first event of the page. Default = 0.
+	//
+	// Optional
+	Offset *int64
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type ListEvents200 struct {
+
+	// Response body
+	Body *EventPage
+}
+
+// NewListEventsReq creates a new instance of ListEventsReq with required fields as parameters
+func NewListEventsReq() *ListEventsReq {
+	return &ListEventsReq{}
+}
+
+// WithLimit sets the optional query parameter Limit and returns the modified ListEventsReq instance
+func (o *ListEventsReq) WithLimit(value *int64) *ListEventsReq {
+	o.Limit = value
+	return o
+}
+
+// WithOffset sets the optional query parameter Offset and returns the modified ListEventsReq instance
+func (o *ListEventsReq) WithOffset(value *int64) *ListEventsReq {
+	o.Offset = value
+	return o
+}
+
+// ParseListEvents200 creates a new instance of ListEvents200 by parsing a map[string]any
+func ParseListEvents200(resp *http.Response) (*ListEvents200, error) {
+	result := new(ListEvents200)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(EventPage)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for ListEvents200: %w", err)
+	}
+
+	return result, nil
+}
@@ -37,7 +37,9 @@ type ListUsersReq struct {
 	//
 	// Authentication method that denotes an admin token passed in the request header.
 	//
-	// Format (NOT ENFORCED): admin_token
+	// Format (enforced by the server, applied by the SDK to a bare token): Admin {token}
+	//
+	// If unset, the client uses the default set with WithAdminTokenCredentials, if any.
 	//
 	AdminTokenAuth string
 
@@ -46,7 +48,9 @@ type ListUsersReq struct {
 	//
 	// Authentication method that denotes an API key passed in the request header.
 	//
-	// Format (NOT ENFORCED): api_key
+	// Format (documentation only): api_key
+	//
+	// If unset, the client uses the default set with WithAPIKeyCredentials, if any.
 	//
 	APIKeyAuth string
 
@@ -87,19 +91,8 @@ type ListUsers500 struct {
 }
 
 // NewListUsersReq creates a new instance of ListUsersReq with required fields as parameters
-func NewListUsersReq(
-
-	AdminTokenAuth string,
-
-	APIKeyAuth string,
-
-) *ListUsersReq {
-	return &ListUsersReq{
-
-		AdminTokenAuth: AdminTokenAuth,
-
-		APIKeyAuth: APIKeyAuth,
-	}
+func NewListUsersReq() *ListUsersReq {
+	return &ListUsersReq{}
 }
 
 // WithPageNumber sets the optional query parameter PageNumber and returns the modified ListUsersReq instance
@@ -114,6 +107,18 @@ func (o *ListUsersReq) WithPageSize(value *int64) *ListUsersReq {
 	return o
 }
 
+// WithAdminTokenAuth sets the authentication parameter AdminTokenAuth, overriding the default of the client, and returns the modified ListUsersReq instance
+func (o *ListUsersReq) WithAdminTokenAuth(value string) *ListUsersReq {
+	o.AdminTokenAuth = value
+	return o
+}
+
+// WithAPIKeyAuth sets the authentication parameter APIKeyAuth, overriding the default of the client, and returns the modified ListUsersReq instance
+func (o *ListUsersReq) WithAPIKeyAuth(value string) *ListUsersReq {
+	o.APIKeyAuth = value
+	return o
+}
+
 // ParseListUsers200 creates a new instance of ListUsers200 by parsing a map[string]any
 func ParseListUsers200(resp *http.Response) (*ListUsers200, error) {
 	result := new(ListUsers200)
@@ -21,7 +21,9 @@ type LogoutUserReq struct {
 	//
 	// Authentication method that denotes an API key passed in the request header.
 	//
-	// Format (NOT ENFORCED): api_key
+	// Format (documentation only): api_key
+	//
+	// If unset, the client uses the default set with WithAPIKeyCredentials, if any.
 	//
 	APIKeyAuth string
 
@@ -33,7 +35,9 @@ type LogoutUserReq struct {
 	//
 	// Authentication method that denotes a refresh token passed in the request header.
 	//
-	// Format (NOT ENFORCED): refresh_token
+	// Format (documentation only): refresh_token
+	//
+	// If unset, the client uses the default set with WithRefreshTokenCredentials, if any.
 	//
 	RefreshTokenAuth *string
 
@@ -41,7 +45,9 @@ type LogoutUserReq struct {
 	//
 	// Authentication method that denotes a session token passed in the request header.
 	//
-	// Format (NOT ENFORCED): session_token
+	// Format (documentation only): session_token
+	//
+	// If unset, the client uses the default set with WithSessionTokenCredentials, if any.
 	//
 	SessionTokenAuth *string
 
@@ -74,15 +80,14 @@ type LogoutUser500 struct {
 }
 
 // NewLogoutUserReq creates a new instance of LogoutUserReq with required fields as parameters
-func NewLogoutUserReq(
-
-	APIKeyAuth string,
-
-) *LogoutUserReq {
-	return &LogoutUserReq{
+func NewLogoutUserReq() *LogoutUserReq {
+	return &LogoutUserReq{}
+}
 
-		APIKeyAuth: APIKeyAuth,
-	}
+// WithAPIKeyAuth sets the authentication parameter APIKeyAuth, overriding the default of the client, and returns the modified LogoutUserReq instance
+func (o *LogoutUserReq) WithAPIKeyAuth(value string) *LogoutUserReq {
+	o.APIKeyAuth = value
+	return o
 }
 
 // WithRefreshTokenAuth sets the optional authentication parameter RefreshTokenAuth and returns the modified LogoutUserReq instance
@@ -0,0 +1,93 @@
+package go_sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	ReceiveWebhookReqHTTPMethod = "POST"
+	ReceiveWebhookReqRoutePath  = "/webhooks"
+)
+
+// Receive a webhook delivery signed with the webhook secret.
+type ReceiveWebhookReq struct {
+
+	// All of the below (upto AUTH-ALL-END comment) are required for authentication
+
+	// Required Authentication Method
+	// Source: hmac "X-Signature"
+	//
+	// Authentication method that denotes a webhook delivery signed with a shared secret.
+	//
+	// If unset, the client uses the default set with WithWebhookSignatureCredentials, if any.
+	//
+	WebhookSignatureAuth HMACKey
+
+	// AUTH-ALL-END
+
+	// Request body
+	Body *ReceiveWebhookRequestBody
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type ReceiveWebhook200 struct {
+
+	// Response body
+	Body *ReceiveWebhookResponseBody
+}
+
+// Payload Too Large - the request body exceeds the maximum allowed size
+type ReceiveWebhook413Response struct {
+
+	// Raw response body. The HTTP response will be returned directly for this response, and it will be the responsibility of the caller to read/close the response body.
+	RawBody *http.Response
+}
+
+// NewReceiveWebhookReq creates a new instance of ReceiveWebhookReq with required fields as parameters
+func NewReceiveWebhookReq(
+
+	Body *ReceiveWebhookRequestBody,
+
+) *ReceiveWebhookReq {
+	return &ReceiveWebhookReq{
+
+		Body: Body,
+	}
+}
+
+// WithWebhookSignatureAuth sets the authentication parameter WebhookSignatureAuth, overriding the default of the client, and returns the modified ReceiveWebhookReq instance
+func (o *ReceiveWebhookReq) WithWebhookSignatureAuth(value HMACKey) *ReceiveWebhookReq {
+	o.WebhookSignatureAuth = value
+	return o
+}
+
+// ParseReceiveWebhook200 creates a new instance of ReceiveWebhook200 by parsing a map[string]any
+func ParseReceiveWebhook200(resp *http.Response) (*ReceiveWebhook200, error) {
+	result := new(ReceiveWebhook200)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(ReceiveWebhookResponseBody)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for ReceiveWebhook200: %w", err)
+	}
+
+	return result, nil
+}
+
+// ParseReceiveWebhook413Response creates a new instance of ReceiveWebhook413Response by parsing a map[string]any
+func ParseReceiveWebhook413Response(resp *http.Response) (*ReceiveWebhook413Response, error) {
+	result := new(ReceiveWebhook413Response)
+
+	result.RawBody = resp
+
+	return result, nil
+}
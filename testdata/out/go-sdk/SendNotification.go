@@ -0,0 +1,101 @@
+package go_sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	SendNotificationReqHTTPMethod = "POST"
+	SendNotificationReqRoutePath  = "/notifications"
+)
+
+// Send a notification, by email or SMS. Just for testing oneOf request and response bodies in the generator.
+type SendNotificationReq struct {
+
+	// Request body
+	Body Notification
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// The notification as sent.
+type SendNotification200 struct {
+
+	// Response body
+	Body Notification
+}
+
+// Bad Request
+type SendNotification400 struct {
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// Payload Too Large - the request body exceeds the maximum allowed size
+type SendNotification413Response struct {
+
+	// Raw response body. The HTTP response will be returned directly for this response, and it will be the responsibility of the caller to read/close the response body.
+	RawBody *http.Response
+}
+
+// NewSendNotificationReq creates a new instance of SendNotificationReq with required fields as parameters
+func NewSendNotificationReq(
+
+	Body Notification,
+
+) *SendNotificationReq {
+	return &SendNotificationReq{
+
+		Body: Body,
+	}
+}
+
+// ParseSendNotification200 creates a new instance of SendNotification200 by parsing a map[string]any
+func ParseSendNotification200(resp *http.Response) (*SendNotification200, error) {
+	result := new(SendNotification200)
+
+	defer resp.Body.Close()
+	bodyData, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body for SendNotification200: %w", err)
+	}
+	// Decoded as the variant named by the discriminator, see unmarshalNotification
+	body, err := unmarshalNotification(bodyData)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding response body for SendNotification200: %w", err)
+	}
+	result.Body = body
+
+	return result, nil
+}
+
+// ParseSendNotification400 creates a new instance of SendNotification400 by parsing a map[string]any
+func ParseSendNotification400(resp *http.Response) (*SendNotification400, error) {
+	result := new(SendNotification400)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(ErrorResponse)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for SendNotification400: %w", err)
+	}
+
+	return result, nil
+}
+
+// ParseSendNotification413Response creates a new instance of SendNotification413Response by parsing a map[string]any
+func ParseSendNotification413Response(resp *http.Response) (*SendNotification413Response, error) {
+	result := new(SendNotification413Response)
+
+	result.RawBody = resp
+
+	return result, nil
+}
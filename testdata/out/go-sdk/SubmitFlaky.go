@@ -0,0 +1,128 @@
+package go_sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	SubmitFlakyReqHTTPMethod = "POST"
+	SubmitFlakyReqRoutePath  = "/flaky/submit"
+)
+
+// Same as CreateFlaky, but made idempotent with an Idempotency-Key, so the SDKs retry it.
+type SubmitFlakyReq struct {
+
+	// Source: query parameter "failures"
+	//
+
+	// The number of requests with the key that fail.
+	//
+	// Required
+	Failures int64
+
+	// Source: query parameter "key"
+	//
+
+	// The key the requests are counted by.
+	//
+	// Required
+	Key string
+
+	// Source: header "Idempotency-Key"
+	//
+	// Identifies the call, so the server answers its retries with the response of the first attempt.
+	// If empty, the client generates a random key, sent with every attempt of the call.
+	IdempotencyKey string
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type SubmitFlaky200 struct {
+
+	// Response body
+	Body *FlakyResponseBody
+}
+
+// Service Unavailable
+type SubmitFlaky503 struct {
+
+	// Source: header parameter "Retry-After"
+	//
+
+	// The number of seconds to wait before retrying.
+	//
+	// Required
+	RetryAfter int64
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// NewSubmitFlakyReq creates a new instance of SubmitFlakyReq with required fields as parameters
+func NewSubmitFlakyReq(
+
+	Failures int64,
+
+	Key string,
+
+) *SubmitFlakyReq {
+	return &SubmitFlakyReq{
+
+		Failures: Failures,
+
+		Key: Key,
+	}
+}
+
+// WithIdempotencyKey sets the Idempotency-Key of the request, instead of a random key generated for each call,
+// and returns the modified SubmitFlakyReq instance
+//
+// Calls with the same key are answered with the response of the first one, so a key can be reused to retry a call.
+func (o *SubmitFlakyReq) WithIdempotencyKey(key string) *SubmitFlakyReq {
+	o.IdempotencyKey = key
+	return o
+}
+
+// ParseSubmitFlaky200 creates a new instance of SubmitFlaky200 by parsing a map[string]any
+func ParseSubmitFlaky200(resp *http.Response) (*SubmitFlaky200, error) {
+	result := new(SubmitFlaky200)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(FlakyResponseBody)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for SubmitFlaky200: %w", err)
+	}
+
+	return result, nil
+}
+
+// ParseSubmitFlaky503 creates a new instance of SubmitFlaky503 by parsing a map[string]any
+func ParseSubmitFlaky503(resp *http.Response) (*SubmitFlaky503, error) {
+	result := new(SubmitFlaky503)
+
+	headerRetryAfter, err := parseint64Param(resp.Header.Get("Retry-After"), "header: Retry-After", true)
+	if err != nil {
+		return nil, err
+	}
+
+	result.RetryAfter = *headerRetryAfter
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(ErrorResponse)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for SubmitFlaky503: %w", err)
+	}
+
+	return result, nil
+}
@@ -19,7 +19,9 @@ type WhoAmIReq struct {
 	//
 	// Authentication method that denotes an API key passed in the request header.
 	//
-	// Format (NOT ENFORCED): api_key
+	// Format (documentation only): api_key
+	//
+	// If unset, the client uses the default set with WithAPIKeyCredentials, if any.
 	//
 	APIKeyAuth string
 
@@ -28,7 +30,9 @@ type WhoAmIReq struct {
 	//
 	// Authentication method that denotes a session token passed in the request header.
 	//
-	// Format (NOT ENFORCED): session_token
+	// Format (documentation only): session_token
+	//
+	// If unset, the client uses the default set with WithSessionTokenCredentials, if any.
 	//
 	SessionTokenAuth string
 
@@ -58,19 +62,20 @@ type WhoAmI200 struct {
 // Invalid Request
 
 // NewWhoAmIReq creates a new instance of WhoAmIReq with required fields as parameters
-func NewWhoAmIReq(
-
-	APIKeyAuth string,
-
-	SessionTokenAuth string,
-
-) *WhoAmIReq {
-	return &WhoAmIReq{
+func NewWhoAmIReq() *WhoAmIReq {
+	return &WhoAmIReq{}
+}
 
-		APIKeyAuth: APIKeyAuth,
+// WithAPIKeyAuth sets the authentication parameter APIKeyAuth, overriding the default of the client, and returns the modified WhoAmIReq instance
+func (o *WhoAmIReq) WithAPIKeyAuth(value string) *WhoAmIReq {
+	o.APIKeyAuth = value
+	return o
+}
 
-		SessionTokenAuth: SessionTokenAuth,
-	}
+// WithSessionTokenAuth sets the authentication parameter SessionTokenAuth, overriding the default of the client, and returns the modified WhoAmIReq instance
+func (o *WhoAmIReq) WithSessionTokenAuth(value string) *WhoAmIReq {
+	o.SessionTokenAuth = value
+	return o
 }
 
 // NOTE: RawBody is true, so request body will not be handled.
@@ -3,10 +3,20 @@ package go_sdk
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"hash"
 	"io"
+	"math/rand/v2"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -24,6 +34,9 @@ const (
 
 	// Non-Spec Response
 	ReasonUnexpected TestingAPIErrorReason = "unexpected"
+
+	// Failed to obtain a credential from a CredentialsProvider or a TokenProvider
+	ReasonAuth TestingAPIErrorReason = "auth"
 )
 
 type TestingAPIError struct {
@@ -42,28 +55,194 @@ func (e *TestingAPIError) Error() string {
 type TestingAPI struct {
 	httpClient *http.Client
 	baseURL    string
+
+	// The middleware added with WithMiddleware, and the Doer sending the requests through them.
+	middleware []Middleware
+	doer       Doer
+
+	// Default credentials, see the With<AuthMethod>Credentials options.
+	credentials clientCredentials
+
+	// TokenProviders by auth method ID, see the With<AuthMethod>TokenProvider options.
+	tokenProviders map[string]TokenProvider
 }
 
-func NewTestingAPI(baseURL string) *TestingAPI {
-	return &TestingAPI{
-		httpClient: &http.Client{Timeout: 30 * time.Second},
-		baseURL:    baseURL,
-	}
+// ClientOption configures a TestingAPI created by NewTestingAPI, see WithMiddleware and the With<AuthMethod>Credentials and With<AuthMethod>TokenProvider options.
+type ClientOption func(c *TestingAPI)
+
+// NewTestingAPI returns a client for the API at baseURL, with a 30 seconds timeout, configured with opts.
+func NewTestingAPI(baseURL string, opts ...ClientOption) *TestingAPI {
+	return NewTestingAPIWithHTTPClient(baseURL, &http.Client{Timeout: 30 * time.Second}, opts...)
 }
 
-func NewTestingAPIWithHTTPClient(baseURL string, httpClient *http.Client) *TestingAPI {
-	return &TestingAPI{
+// NewTestingAPIWithHTTPClient returns a client for the API at baseURL that sends requests with httpClient, configured with opts.
+func NewTestingAPIWithHTTPClient(baseURL string, httpClient *http.Client, opts ...ClientOption) *TestingAPI {
+	c := &TestingAPI{
 		httpClient: httpClient,
 		baseURL:    baseURL,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.doer = DoerFunc(func(route Route, req *http.Request) (*http.Response, error) {
+		return c.httpClient.Do(req)
+	})
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		c.doer = c.middleware[i](c.doer)
+	}
+	return c
 }
 
-func (c *TestingAPI) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+// do sends req, a request to route, through the middleware of the client.
+func (c *TestingAPI) do(ctx context.Context, route Route, req *http.Request) (*http.Response, error) {
 	if req.Header.Get("Accept") == "" {
 		req.Header.Set("Accept", "application/json")
 	}
 	req.Header.Set("User-Agent", "TestingAPI-GoSDK/1.0.0")
-	return c.httpClient.Do(req.WithContext(ctx))
+	return c.doer.Do(route, req.WithContext(ctx))
+}
+
+// retryPolicy is the retry policy of an endpoint in the specification.
+type retryPolicy struct {
+	// Maximum number of attempts, including the first one.
+	maxAttempts int
+
+	// Response status codes that are retried.
+	statusCodes []int
+
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// doWithRetry sends req with c.do, and retries it according to policy after a transport error
+// or a response with a retryable status code, until an attempt succeeds or the attempts are exhausted.
+//
+// Retries wait for a random backoff up to initialBackoff, doubled for each retry up to maxBackoff,
+// or for the Retry-After of the response. Responses whose Retry-After exceeds maxBackoff are returned.
+//
+// Requests with a body that cannot be replayed (see http.Request.GetBody) are sent once.
+func (c *TestingAPI) doWithRetry(ctx context.Context, route Route, req *http.Request, policy retryPolicy) (*http.Response, error) {
+	backoff := min(policy.initialBackoff, policy.maxBackoff)
+	for attempt := 1; ; attempt++ {
+		resp, err := c.do(ctx, route, req)
+		if attempt >= policy.maxAttempts || ctx.Err() != nil || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+
+		// Full jitter, see https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/
+		delay := rand.N(backoff + 1)
+		if err == nil {
+			if !slices.Contains(policy.statusCodes, resp.StatusCode) {
+				return resp, nil
+			}
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				if retryAfter > policy.maxBackoff {
+					return resp, nil
+				}
+				delay = retryAfter
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+		backoff = min(backoff*2, policy.maxBackoff)
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+	}
+}
+
+// parseRetryAfter parses a Retry-After header, either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// newIdempotencyKey returns a random (version 4) UUID, the Idempotency-Key of a call that does not set one.
+func newIdempotencyKey() string {
+	var b [16]byte
+	_, _ = crand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40 // version 4
+	b[8] = b[8]&0x3f | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// basicAuthValue encodes credentials for a "basic" auth method, without the "Basic " prefix.
+func basicAuthValue(credentials BasicAuthCredentials) string {
+	return base64.StdEncoding.EncodeToString([]byte(credentials.Username + ":" + credentials.Password))
+}
+
+// HMACKey is the signing key of an "hmac" auth method, with which the client signs requests.
+type HMACKey struct {
+	// ID of the key, sent with the signature so the server can look up the secret.
+	KeyID  string
+	Secret string
+}
+
+// signHMAC signs req with key: the signature header holds the hex-encoded HMAC of hmacPayload,
+// with the ID of the key and the signing time, in Unix seconds, in their own headers.
+//
+// The URL of req must be complete, as its query is signed, and its body must be replayable
+// (see http.Request.GetBody) to be hashed.
+func signHMAC(req *http.Request, key HMACKey, algorithm, signatureHeader, keyIDHeader, timestampHeader string) {
+	bodyHash := hmacHash(algorithm)()
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			_, _ = io.Copy(bodyHash, body)
+			body.Close()
+		}
+	}
+	timestamp := time.Now().Unix()
+	mac := hmac.New(hmacHash(algorithm), []byte(key.Secret))
+	mac.Write([]byte(hmacPayload(req.Method, req.URL.EscapedPath(), req.URL.RawQuery, timestamp, bodyHash.Sum(nil))))
+
+	req.Header.Set(signatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	req.Header.Set(keyIDHeader, key.KeyID)
+	req.Header.Set(timestampHeader, strconv.FormatInt(timestamp, 10))
+}
+
+// hmacPayload returns the string signed by hmac auth methods: the method, escaped path, raw query (as sent,
+// without the "?"), signing time and hex-encoded body hash of the request, separated by newlines.
+func hmacPayload(method, path, query string, timestamp int64, bodyHash []byte) string {
+	return method + "\n" + path + "\n" + query + "\n" + strconv.FormatInt(timestamp, 10) + "\n" + hex.EncodeToString(bodyHash)
+}
+
+// hmacHash returns the hash function of an hmac algorithm of the specification.
+func hmacHash(algorithm string) func() hash.Hash {
+	if algorithm == "sha512" {
+		return sha512.New
+	}
+	return sha256.New
+}
+
+// formatAuthValue applies the "{token}" template format of an auth method, given by the text around the placeholder,
+// to a credential, unless it is already formatted.
+func formatAuthValue(credential, prefix, suffix string) string {
+	if len(credential) > len(prefix)+len(suffix) && strings.HasPrefix(credential, prefix) && strings.HasSuffix(credential, suffix) {
+		return credential
+	}
+	return prefix + credential + suffix
 }
 
 type CreateUserResult struct {
@@ -92,6 +271,7 @@ type CreateUserResult struct {
 	UnknownResponse *http.Response
 }
 
+// CreateUser calls POST /users/new.
 func (c *TestingAPI) CreateUser(ctx context.Context, params *CreateUserReq) (CreateUserResult, *TestingAPIError) {
 	var body io.Reader
 
@@ -123,6 +303,26 @@ func (c *TestingAPI) CreateUser(ctx context.Context, params *CreateUserReq) (Cre
 
 	req.Header.Set("Content-Type", "application/json")
 
+	// Copy the params, so the default credentials are not visible to the caller
+	paramsCopy := *params
+	params = &paramsCopy
+
+	if params.AdminTokenAuth == "" && c.credentials.AdminToken != nil {
+		credential, cerr := provideCredentials(ctx, c.credentials.AdminToken, "X-App-Admin-Token")
+		if cerr != nil {
+			return CreateUserResult{}, cerr
+		}
+		params.AdminTokenAuth = credential
+	}
+
+	if params.APIKeyAuth == "" && c.credentials.APIKey != nil {
+		credential, cerr := provideCredentials(ctx, c.credentials.APIKey, "X-App-API-Key")
+		if cerr != nil {
+			return CreateUserResult{}, cerr
+		}
+		params.APIKeyAuth = credential
+	}
+
 	authAdminToken, err := paramToString(params.AdminTokenAuth, "auth parameter: AdminToken", "string", true)
 	if err != nil {
 		return CreateUserResult{}, &TestingAPIError{
@@ -131,6 +331,9 @@ func (c *TestingAPI) CreateUser(ctx context.Context, params *CreateUserReq) (Cre
 			Err:     err,
 		}
 	}
+
+	authAdminToken = formatAuthValue(authAdminToken, "Admin ", "")
+
 	req.Header.Set("X-App-Admin-Token", authAdminToken)
 
 	authAPIKey, err := paramToString(params.APIKeyAuth, "auth parameter: APIKey", "string", true)
@@ -141,9 +344,10 @@ func (c *TestingAPI) CreateUser(ctx context.Context, params *CreateUserReq) (Cre
 			Err:     err,
 		}
 	}
+
 	req.Header.Set("X-App-API-Key", authAPIKey)
 
-	resp, err := c.do(ctx, req)
+	resp, err := c.do(ctx, CreateUserRoute, req)
 	if err != nil {
 		return CreateUserResult{}, &TestingAPIError{
 			Reason:  ReasonTransport,
@@ -244,6 +448,7 @@ type GetUserResult struct {
 	UnknownResponse *http.Response
 }
 
+// GetUser calls GET /users/{userId}.
 func (c *TestingAPI) GetUser(ctx context.Context, params *GetUserReq) (GetUserResult, *TestingAPIError) {
 	var body io.Reader
 
@@ -273,6 +478,26 @@ func (c *TestingAPI) GetUser(ctx context.Context, params *GetUserReq) (GetUserRe
 		}
 	}
 
+	// Copy the params, so the default credentials are not visible to the caller
+	paramsCopy := *params
+	params = &paramsCopy
+
+	if params.APIKeyAuth == "" && c.credentials.APIKey != nil {
+		credential, cerr := provideCredentials(ctx, c.credentials.APIKey, "X-App-API-Key")
+		if cerr != nil {
+			return GetUserResult{}, cerr
+		}
+		params.APIKeyAuth = credential
+	}
+
+	if params.SessionTokenAuth == "" && c.credentials.SessionToken != nil {
+		credential, cerr := provideCredentials(ctx, c.credentials.SessionToken, "X-App-Session-Token")
+		if cerr != nil {
+			return GetUserResult{}, cerr
+		}
+		params.SessionTokenAuth = credential
+	}
+
 	authAPIKey, err := paramToString(params.APIKeyAuth, "auth parameter: APIKey", "string", true)
 	if err != nil {
 		return GetUserResult{}, &TestingAPIError{
@@ -281,6 +506,7 @@ func (c *TestingAPI) GetUser(ctx context.Context, params *GetUserReq) (GetUserRe
 			Err:     err,
 		}
 	}
+
 	req.Header.Set("X-App-API-Key", authAPIKey)
 
 	authSessionToken, err := paramToString(params.SessionTokenAuth, "auth parameter: SessionToken", "string", true)
@@ -291,9 +517,15 @@ func (c *TestingAPI) GetUser(ctx context.Context, params *GetUserReq) (GetUserRe
 			Err:     err,
 		}
 	}
+
 	req.Header.Set("X-App-Session-Token", authSessionToken)
 
-	resp, err := c.do(ctx, req)
+	resp, err := c.doWithRetry(ctx, GetUserRoute, req, retryPolicy{
+		maxAttempts:    3,
+		statusCodes:    []int{503},
+		initialBackoff: 10 * time.Millisecond,
+		maxBackoff:     100 * time.Millisecond,
+	})
 	if err != nil {
 		return GetUserResult{}, &TestingAPIError{
 			Reason:  ReasonTransport,
@@ -391,6 +623,9 @@ type ListUsersResult struct {
 	UnknownResponse *http.Response
 }
 
+// ListUsers calls GET /users.
+//
+// Required scopes: users:read
 func (c *TestingAPI) ListUsers(ctx context.Context, params *ListUsersReq) (ListUsersResult, *TestingAPIError) {
 	var body io.Reader
 
@@ -410,6 +645,26 @@ func (c *TestingAPI) ListUsers(ctx context.Context, params *ListUsersReq) (ListU
 		}
 	}
 
+	// Copy the params, so the default credentials are not visible to the caller
+	paramsCopy := *params
+	params = &paramsCopy
+
+	if params.AdminTokenAuth == "" && c.credentials.AdminToken != nil {
+		credential, cerr := provideCredentials(ctx, c.credentials.AdminToken, "X-App-Admin-Token")
+		if cerr != nil {
+			return ListUsersResult{}, cerr
+		}
+		params.AdminTokenAuth = credential
+	}
+
+	if params.APIKeyAuth == "" && c.credentials.APIKey != nil {
+		credential, cerr := provideCredentials(ctx, c.credentials.APIKey, "X-App-API-Key")
+		if cerr != nil {
+			return ListUsersResult{}, cerr
+		}
+		params.APIKeyAuth = credential
+	}
+
 	authAdminToken, err := paramToString(params.AdminTokenAuth, "auth parameter: AdminToken", "string", true)
 	if err != nil {
 		return ListUsersResult{}, &TestingAPIError{
@@ -418,6 +673,9 @@ func (c *TestingAPI) ListUsers(ctx context.Context, params *ListUsersReq) (ListU
 			Err:     err,
 		}
 	}
+
+	authAdminToken = formatAuthValue(authAdminToken, "Admin ", "")
+
 	req.Header.Set("X-App-Admin-Token", authAdminToken)
 
 	authAPIKey, err := paramToString(params.APIKeyAuth, "auth parameter: APIKey", "string", true)
@@ -428,6 +686,7 @@ func (c *TestingAPI) ListUsers(ctx context.Context, params *ListUsersReq) (ListU
 			Err:     err,
 		}
 	}
+
 	req.Header.Set("X-App-API-Key", authAPIKey)
 
 	q := req.URL.Query()
@@ -454,7 +713,12 @@ func (c *TestingAPI) ListUsers(ctx context.Context, params *ListUsersReq) (ListU
 
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.do(ctx, req)
+	resp, err := c.doWithRetry(ctx, ListUsersRoute, req, retryPolicy{
+		maxAttempts:    3,
+		statusCodes:    []int{503},
+		initialBackoff: 10 * time.Millisecond,
+		maxBackoff:     100 * time.Millisecond,
+	})
 	if err != nil {
 		return ListUsersResult{}, &TestingAPIError{
 			Reason:  ReasonTransport,
@@ -538,6 +802,7 @@ type LogoutUserResult struct {
 	UnknownResponse *http.Response
 }
 
+// LogoutUser calls GET /users/logout.
 func (c *TestingAPI) LogoutUser(ctx context.Context, params *LogoutUserReq) (LogoutUserResult, *TestingAPIError) {
 	var body io.Reader
 
@@ -557,6 +822,36 @@ func (c *TestingAPI) LogoutUser(ctx context.Context, params *LogoutUserReq) (Log
 		}
 	}
 
+	// Copy the params, so the default credentials are not visible to the caller
+	paramsCopy := *params
+	params = &paramsCopy
+
+	if params.APIKeyAuth == "" && c.credentials.APIKey != nil {
+		credential, cerr := provideCredentials(ctx, c.credentials.APIKey, "X-App-API-Key")
+		if cerr != nil {
+			return LogoutUserResult{}, cerr
+		}
+		params.APIKeyAuth = credential
+	}
+
+	if params.RefreshTokenAuth == nil && params.SessionTokenAuth == nil {
+		// Use the first auth method with default credentials
+		switch {
+		case c.credentials.RefreshToken != nil:
+			credential, cerr := provideCredentials(ctx, c.credentials.RefreshToken, "X-App-Refresh-Token")
+			if cerr != nil {
+				return LogoutUserResult{}, cerr
+			}
+			params.RefreshTokenAuth = &credential
+		case c.credentials.SessionToken != nil:
+			credential, cerr := provideCredentials(ctx, c.credentials.SessionToken, "X-App-Session-Token")
+			if cerr != nil {
+				return LogoutUserResult{}, cerr
+			}
+			params.SessionTokenAuth = &credential
+		}
+	}
+
 	authAPIKey, err := paramToString(params.APIKeyAuth, "auth parameter: APIKey", "string", true)
 	if err != nil {
 		return LogoutUserResult{}, &TestingAPIError{
@@ -565,20 +860,23 @@ func (c *TestingAPI) LogoutUser(ctx context.Context, params *LogoutUserReq) (Log
 			Err:     err,
 		}
 	}
+
 	req.Header.Set("X-App-API-Key", authAPIKey)
 
 	numAuthParamsSet := 0
 
-	authRefreshToken, err := paramToString(params.RefreshTokenAuth, "auth parameter: RefreshToken", "*string", true)
-	if err == nil {
+	if authRefreshToken, err := paramToString(params.RefreshTokenAuth, "auth parameter: RefreshToken", "*string", true); err == nil {
 		numAuthParamsSet++
+
 		req.Header.Set("X-App-Refresh-Token", authRefreshToken)
+
 	}
 
-	authSessionToken, err := paramToString(params.SessionTokenAuth, "auth parameter: SessionToken", "*string", true)
-	if err == nil {
+	if authSessionToken, err := paramToString(params.SessionTokenAuth, "auth parameter: SessionToken", "*string", true); err == nil {
 		numAuthParamsSet++
+
 		req.Header.Set("X-App-Session-Token", authSessionToken)
+
 	}
 
 	if numAuthParamsSet != 1 {
@@ -589,7 +887,12 @@ func (c *TestingAPI) LogoutUser(ctx context.Context, params *LogoutUserReq) (Log
 		}
 	}
 
-	resp, err := c.do(ctx, req)
+	resp, err := c.doWithRetry(ctx, LogoutUserRoute, req, retryPolicy{
+		maxAttempts:    3,
+		statusCodes:    []int{503},
+		initialBackoff: 10 * time.Millisecond,
+		maxBackoff:     100 * time.Millisecond,
+	})
 	if err != nil {
 		return LogoutUserResult{}, &TestingAPIError{
 			Reason:  ReasonTransport,
@@ -670,10 +973,11 @@ type WhoAmIResult struct {
 	UnknownResponse *http.Response
 }
 
+// WhoAmI calls POST /users/whoami.
+//
 // NOTE: This endpoint has RawBody set to true, so the request body will not be handled by the generated client.
 //
 // Instead, the generated client function will have an additional parameter rawBody of type io.Reader, which will be the responsibility of the caller to read from and set the appropriate Content-Type header for the request.
-
 func (c *TestingAPI) WhoAmI(ctx context.Context, params *WhoAmIReq, rawBody io.Reader) (WhoAmIResult, *TestingAPIError) {
 	var body io.Reader
 
@@ -695,6 +999,26 @@ func (c *TestingAPI) WhoAmI(ctx context.Context, params *WhoAmIReq, rawBody io.R
 		}
 	}
 
+	// Copy the params, so the default credentials are not visible to the caller
+	paramsCopy := *params
+	params = &paramsCopy
+
+	if params.APIKeyAuth == "" && c.credentials.APIKey != nil {
+		credential, cerr := provideCredentials(ctx, c.credentials.APIKey, "X-App-API-Key")
+		if cerr != nil {
+			return WhoAmIResult{}, cerr
+		}
+		params.APIKeyAuth = credential
+	}
+
+	if params.SessionTokenAuth == "" && c.credentials.SessionToken != nil {
+		credential, cerr := provideCredentials(ctx, c.credentials.SessionToken, "X-App-Session-Token")
+		if cerr != nil {
+			return WhoAmIResult{}, cerr
+		}
+		params.SessionTokenAuth = credential
+	}
+
 	authAPIKey, err := paramToString(params.APIKeyAuth, "auth parameter: APIKey", "string", true)
 	if err != nil {
 		return WhoAmIResult{}, &TestingAPIError{
@@ -703,6 +1027,7 @@ func (c *TestingAPI) WhoAmI(ctx context.Context, params *WhoAmIReq, rawBody io.R
 			Err:     err,
 		}
 	}
+
 	req.Header.Set("X-App-API-Key", authAPIKey)
 
 	authSessionToken, err := paramToString(params.SessionTokenAuth, "auth parameter: SessionToken", "string", true)
@@ -713,9 +1038,10 @@ func (c *TestingAPI) WhoAmI(ctx context.Context, params *WhoAmIReq, rawBody io.R
 			Err:     err,
 		}
 	}
+
 	req.Header.Set("X-App-Session-Token", authSessionToken)
 
-	resp, err := c.do(ctx, req)
+	resp, err := c.do(ctx, WhoAmIRoute, req)
 	if err != nil {
 		return WhoAmIResult{}, &TestingAPIError{
 			Reason:  ReasonTransport,
@@ -753,6 +1079,1151 @@ func (c *TestingAPI) WhoAmI(ctx context.Context, params *WhoAmIReq, rawBody io.R
 	}
 }
 
+type GetSessionResult struct {
+
+	// OK
+	Response200 *GetSession200
+
+	StatusCode int
+
+	// The raw http.Response for non-spec responses (e.g. 502 NGINX Error) that don't have a defined response body or headers in the spec. This allows callers to inspect the full response for debugging or error handling purposes.
+	//
+	// The client will only populate this field for responses that don't match any of the defined status codes in the spec.
+	//
+	// Callers should check the StatusCode field to determine if the response was a known spec response or an unknown response, and handle accordingly.
+	//
+	// Callers MUST CLOSE THE BODY of this response when done inspecting it to avoid resource leaks.
+	UnknownResponse *http.Response
+}
+
+// GetSession calls GET /session.
+func (c *TestingAPI) GetSession(ctx context.Context, params *GetSessionReq) (GetSessionResult, *TestingAPIError) {
+	var body io.Reader
+
+	path := "/session"
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		c.baseURL+path,
+		body,
+	)
+	if err != nil {
+		return GetSessionResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "failed to create HTTP request",
+			Err:     err,
+		}
+	}
+
+	// Copy the params, so the default credentials and the tokens set from TokenProviders are not visible to the caller
+	paramsCopy := *params
+	params = &paramsCopy
+
+	if params.BasicAuth == nil && params.BearerTokenAuth == nil && params.QueryKeyAuth == nil && params.ServiceAuth == nil && params.SessionCookieAuth == nil {
+		// Use the first auth method with default credentials
+		switch {
+		case c.credentials.Basic != nil:
+			credential, cerr := provideCredentials(ctx, c.credentials.Basic, "Authorization")
+			if cerr != nil {
+				return GetSessionResult{}, cerr
+			}
+			params.BasicAuth = &credential
+		case c.credentials.BearerToken != nil:
+			credential, cerr := provideCredentials(ctx, c.credentials.BearerToken, "Authorization")
+			if cerr != nil {
+				return GetSessionResult{}, cerr
+			}
+			params.BearerTokenAuth = &credential
+		case c.credentials.QueryKey != nil:
+			credential, cerr := provideCredentials(ctx, c.credentials.QueryKey, "api_key")
+			if cerr != nil {
+				return GetSessionResult{}, cerr
+			}
+			params.QueryKeyAuth = &credential
+		case c.credentials.Service != nil:
+			credential, cerr := provideCredentials(ctx, c.credentials.Service, "X-App-Service-Token")
+			if cerr != nil {
+				return GetSessionResult{}, cerr
+			}
+			params.ServiceAuth = &credential
+		case c.credentials.SessionCookie != nil:
+			credential, cerr := provideCredentials(ctx, c.credentials.SessionCookie, "app_session")
+			if cerr != nil {
+				return GetSessionResult{}, cerr
+			}
+			params.SessionCookieAuth = &credential
+		}
+	}
+
+	var provided []providedToken
+
+	if params.BasicAuth == nil && params.BearerTokenAuth == nil && params.QueryKeyAuth == nil && params.ServiceAuth == nil && params.SessionCookieAuth == nil {
+		// Use the first auth method with a TokenProvider
+		switch {
+		case c.tokenProviders["serviceAuth"] != nil:
+			token, cerr := c.provideToken(ctx, "serviceAuth", "X-App-Service-Token", &provided)
+			if cerr != nil {
+				return GetSessionResult{}, cerr
+			}
+			params.ServiceAuth = &token
+		}
+	}
+
+	numAuthParamsSet := 0
+
+	if params.BasicAuth != nil && params.BasicAuth.Username != "" {
+		numAuthParamsSet++
+		authBasic := basicAuthValue(*params.BasicAuth)
+
+		req.Header.Set("Authorization", "Basic "+authBasic)
+
+	}
+
+	if authBearerToken, err := paramToString(params.BearerTokenAuth, "auth parameter: BearerToken", "*string", true); err == nil {
+		numAuthParamsSet++
+
+		req.Header.Set("Authorization", formatAuthValue(authBearerToken, "Bearer ", ""))
+
+	}
+
+	if authQueryKey, err := paramToString(params.QueryKeyAuth, "auth parameter: QueryKey", "*string", true); err == nil {
+		numAuthParamsSet++
+
+		authQueryKey = formatAuthValue(authQueryKey, "qk_", "")
+
+		authQueryQueryKey := req.URL.Query()
+		authQueryQueryKey.Set("api_key", authQueryKey)
+		req.URL.RawQuery = authQueryQueryKey.Encode()
+
+	}
+
+	if authService, err := paramToString(params.ServiceAuth, "auth parameter: Service", "*string", true); err == nil {
+		numAuthParamsSet++
+
+		req.Header.Set("X-App-Service-Token", formatAuthValue(authService, "Bearer ", ""))
+
+	}
+
+	if authSessionCookie, err := paramToString(params.SessionCookieAuth, "auth parameter: SessionCookie", "*string", true); err == nil {
+		numAuthParamsSet++
+
+		req.AddCookie(&http.Cookie{Name: "app_session", Value: authSessionCookie})
+
+	}
+
+	if numAuthParamsSet != 1 {
+		return GetSessionResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: fmt.Sprintf("exactly 1 auth parameter must be set, but %d were set", numAuthParamsSet),
+			Err:     nil,
+		}
+	}
+
+	resp, err := c.doWithRetry(ctx, GetSessionRoute, req, retryPolicy{
+		maxAttempts:    3,
+		statusCodes:    []int{503},
+		initialBackoff: 10 * time.Millisecond,
+		maxBackoff:     100 * time.Millisecond,
+	})
+	if err == nil {
+		resp, err = c.retryUnauthorized(ctx, GetSessionRoute, req, resp, provided)
+	}
+	if err != nil {
+		return GetSessionResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "HTTP request failed",
+			Err:     err,
+		}
+	}
+	response := GetSessionResult{
+		StatusCode: resp.StatusCode,
+	}
+	switch resp.StatusCode {
+
+	case 200:
+
+		parsedResp, err := ParseGetSession200(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 200),
+				Err:     err,
+			}
+		}
+		response.Response200 = parsedResp
+		return response, nil
+
+	default:
+		response.UnknownResponse = resp
+		return response, nil
+	}
+}
+
+type ReceiveWebhookResult struct {
+
+	// OK
+	Response200 *ReceiveWebhook200
+
+	// Payload Too Large - the request body exceeds the maximum allowed size
+	Response413 *ReceiveWebhook413Response
+
+	StatusCode int
+
+	// The raw http.Response for non-spec responses (e.g. 502 NGINX Error) that don't have a defined response body or headers in the spec. This allows callers to inspect the full response for debugging or error handling purposes.
+	//
+	// The client will only populate this field for responses that don't match any of the defined status codes in the spec.
+	//
+	// Callers should check the StatusCode field to determine if the response was a known spec response or an unknown response, and handle accordingly.
+	//
+	// Callers MUST CLOSE THE BODY of this response when done inspecting it to avoid resource leaks.
+	UnknownResponse *http.Response
+}
+
+// ReceiveWebhook calls POST /webhooks.
+func (c *TestingAPI) ReceiveWebhook(ctx context.Context, params *ReceiveWebhookReq) (ReceiveWebhookResult, *TestingAPIError) {
+	var body io.Reader
+
+	bodyBytes, err := json.Marshal(params.Body)
+	if err != nil {
+		return ReceiveWebhookResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "failed to marshal request body",
+			Err:     err,
+		}
+	}
+	body = bytes.NewReader(bodyBytes)
+
+	path := "/webhooks"
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		c.baseURL+path,
+		body,
+	)
+	if err != nil {
+		return ReceiveWebhookResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "failed to create HTTP request",
+			Err:     err,
+		}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	// Copy the params, so the default credentials are not visible to the caller
+	paramsCopy := *params
+	params = &paramsCopy
+
+	if params.WebhookSignatureAuth.KeyID == "" && c.credentials.WebhookSignature != nil {
+		credential, cerr := provideCredentials(ctx, c.credentials.WebhookSignature, "X-Signature")
+		if cerr != nil {
+			return ReceiveWebhookResult{}, cerr
+		}
+		params.WebhookSignatureAuth = credential
+	}
+
+	// The request is signed once its URL is complete, as the query is signed too
+	var signRequest func()
+
+	if params.WebhookSignatureAuth.KeyID == "" || params.WebhookSignatureAuth.Secret == "" {
+		return ReceiveWebhookResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid auth parameter X-Signature",
+			Err:     fmt.Errorf("auth parameter: WebhookSignature key ID and secret are required but were not provided"),
+		}
+	}
+	authWebhookSignature := params.WebhookSignatureAuth
+
+	signRequest = func() {
+		signHMAC(req, authWebhookSignature, "sha256", "X-Signature", "X-Signature-Key-Id", "X-Signature-Timestamp")
+	}
+
+	if signRequest != nil {
+		signRequest()
+	}
+
+	resp, err := c.do(ctx, ReceiveWebhookRoute, req)
+	if err != nil {
+		return ReceiveWebhookResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "HTTP request failed",
+			Err:     err,
+		}
+	}
+	response := ReceiveWebhookResult{
+		StatusCode: resp.StatusCode,
+	}
+	switch resp.StatusCode {
+
+	case 200:
+
+		parsedResp, err := ParseReceiveWebhook200(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 200),
+				Err:     err,
+			}
+		}
+		response.Response200 = parsedResp
+		return response, nil
+
+	case 413:
+
+		parsedResp, err := ParseReceiveWebhook413Response(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 413),
+				Err:     err,
+			}
+		}
+		response.Response413 = parsedResp
+		return response, nil
+
+	default:
+		response.UnknownResponse = resp
+		return response, nil
+	}
+}
+
+type DeleteUserResult struct {
+
+	// OK
+	Response200 *DeleteUser200
+
+	StatusCode int
+
+	// The raw http.Response for non-spec responses (e.g. 502 NGINX Error) that don't have a defined response body or headers in the spec. This allows callers to inspect the full response for debugging or error handling purposes.
+	//
+	// The client will only populate this field for responses that don't match any of the defined status codes in the spec.
+	//
+	// Callers should check the StatusCode field to determine if the response was a known spec response or an unknown response, and handle accordingly.
+	//
+	// Callers MUST CLOSE THE BODY of this response when done inspecting it to avoid resource leaks.
+	UnknownResponse *http.Response
+}
+
+// DeleteUser calls DELETE /users/{userId}.
+//
+// Required scopes: users:delete
+func (c *TestingAPI) DeleteUser(ctx context.Context, params *DeleteUserReq) (DeleteUserResult, *TestingAPIError) {
+	var body io.Reader
+
+	path := "/users/{userId}"
+
+	pathParamUserId, err := paramToString(params.UserId, "path parameter: UserId", "string", true)
+	if err != nil {
+		return DeleteUserResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid path parameter userId",
+			Err:     err,
+		}
+	}
+	path = strings.ReplaceAll(path, "{userId}", pathParamUserId)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		c.baseURL+path,
+		body,
+	)
+	if err != nil {
+		return DeleteUserResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "failed to create HTTP request",
+			Err:     err,
+		}
+	}
+
+	// Copy the params, so the default credentials are not visible to the caller
+	paramsCopy := *params
+	params = &paramsCopy
+
+	if params.AdminTokenAuth == nil && params.APIKeyAuth == nil && params.SessionTokenAuth == nil {
+		// Use the first alternative with default credentials for each of its auth methods
+		switch {
+		case c.credentials.APIKey != nil && c.credentials.SessionToken != nil:
+
+			credentialAPIKey, cerr := provideCredentials(ctx, c.credentials.APIKey, "X-App-API-Key")
+			if cerr != nil {
+				return DeleteUserResult{}, cerr
+			}
+			params.APIKeyAuth = &credentialAPIKey
+
+			credentialSessionToken, cerr := provideCredentials(ctx, c.credentials.SessionToken, "X-App-Session-Token")
+			if cerr != nil {
+				return DeleteUserResult{}, cerr
+			}
+			params.SessionTokenAuth = &credentialSessionToken
+
+		case c.credentials.AdminToken != nil:
+
+			credentialAdminToken, cerr := provideCredentials(ctx, c.credentials.AdminToken, "X-App-Admin-Token")
+			if cerr != nil {
+				return DeleteUserResult{}, cerr
+			}
+			params.AdminTokenAuth = &credentialAdminToken
+
+		}
+	}
+
+	// The auth parameters that are set must be exactly those of one of the alternatives
+	switch {
+
+	case params.APIKeyAuth != nil && params.SessionTokenAuth != nil && params.AdminTokenAuth == nil:
+
+		authAPIKey, err := paramToString(params.APIKeyAuth, "auth parameter: APIKey", "*string", true)
+		if err != nil {
+			return DeleteUserResult{}, &TestingAPIError{
+				Reason:  ReasonEncoding,
+				Message: "invalid auth parameter X-App-API-Key",
+				Err:     err,
+			}
+		}
+
+		req.Header.Set("X-App-API-Key", authAPIKey)
+
+		authSessionToken, err := paramToString(params.SessionTokenAuth, "auth parameter: SessionToken", "*string", true)
+		if err != nil {
+			return DeleteUserResult{}, &TestingAPIError{
+				Reason:  ReasonEncoding,
+				Message: "invalid auth parameter X-App-Session-Token",
+				Err:     err,
+			}
+		}
+
+		req.Header.Set("X-App-Session-Token", authSessionToken)
+
+	case params.AdminTokenAuth != nil && params.APIKeyAuth == nil && params.SessionTokenAuth == nil:
+
+		authAdminToken, err := paramToString(params.AdminTokenAuth, "auth parameter: AdminToken", "*string", true)
+		if err != nil {
+			return DeleteUserResult{}, &TestingAPIError{
+				Reason:  ReasonEncoding,
+				Message: "invalid auth parameter X-App-Admin-Token",
+				Err:     err,
+			}
+		}
+
+		authAdminToken = formatAuthValue(authAdminToken, "Admin ", "")
+
+		req.Header.Set("X-App-Admin-Token", authAdminToken)
+
+	default:
+		return DeleteUserResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "the auth parameters must be exactly those of one of: [X-App-API-Key, X-App-Session-Token] or [X-App-Admin-Token]",
+			Err:     nil,
+		}
+	}
+
+	resp, err := c.doWithRetry(ctx, DeleteUserRoute, req, retryPolicy{
+		maxAttempts:    3,
+		statusCodes:    []int{503},
+		initialBackoff: 10 * time.Millisecond,
+		maxBackoff:     100 * time.Millisecond,
+	})
+	if err != nil {
+		return DeleteUserResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "HTTP request failed",
+			Err:     err,
+		}
+	}
+	response := DeleteUserResult{
+		StatusCode: resp.StatusCode,
+	}
+	switch resp.StatusCode {
+
+	case 200:
+
+		parsedResp, err := ParseDeleteUser200(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 200),
+				Err:     err,
+			}
+		}
+		response.Response200 = parsedResp
+		return response, nil
+
+	default:
+		response.UnknownResponse = resp
+		return response, nil
+	}
+}
+
+type GetFlakyResult struct {
+
+	// OK
+	Response200 *GetFlaky200
+
+	// Service Unavailable
+	Response503 *GetFlaky503
+
+	StatusCode int
+
+	// The raw http.Response for non-spec responses (e.g. 502 NGINX Error) that don't have a defined response body or headers in the spec. This allows callers to inspect the full response for debugging or error handling purposes.
+	//
+	// The client will only populate this field for responses that don't match any of the defined status codes in the spec.
+	//
+	// Callers should check the StatusCode field to determine if the response was a known spec response or an unknown response, and handle accordingly.
+	//
+	// Callers MUST CLOSE THE BODY of this response when done inspecting it to avoid resource leaks.
+	UnknownResponse *http.Response
+}
+
+// GetFlaky calls GET /flaky.
+func (c *TestingAPI) GetFlaky(ctx context.Context, params *GetFlakyReq) (GetFlakyResult, *TestingAPIError) {
+	var body io.Reader
+
+	path := "/flaky"
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		c.baseURL+path,
+		body,
+	)
+	if err != nil {
+		return GetFlakyResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "failed to create HTTP request",
+			Err:     err,
+		}
+	}
+
+	q := req.URL.Query()
+
+	queryFailures, err := paramToString(params.Failures, "query parameter: Failures", "int64", true)
+	if err != nil {
+		return GetFlakyResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid query parameter failures",
+			Err:     err,
+		}
+	}
+	q.Set("failures", queryFailures)
+
+	queryKey, err := paramToString(params.Key, "query parameter: Key", "string", true)
+	if err != nil {
+		return GetFlakyResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid query parameter key",
+			Err:     err,
+		}
+	}
+	q.Set("key", queryKey)
+
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.doWithRetry(ctx, GetFlakyRoute, req, retryPolicy{
+		maxAttempts:    4,
+		statusCodes:    []int{503},
+		initialBackoff: 10 * time.Millisecond,
+		maxBackoff:     100 * time.Millisecond,
+	})
+	if err != nil {
+		return GetFlakyResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "HTTP request failed",
+			Err:     err,
+		}
+	}
+	response := GetFlakyResult{
+		StatusCode: resp.StatusCode,
+	}
+	switch resp.StatusCode {
+
+	case 200:
+
+		parsedResp, err := ParseGetFlaky200(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 200),
+				Err:     err,
+			}
+		}
+		response.Response200 = parsedResp
+		return response, nil
+
+	case 503:
+
+		parsedResp, err := ParseGetFlaky503(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 503),
+				Err:     err,
+			}
+		}
+		response.Response503 = parsedResp
+		return response, nil
+
+	default:
+		response.UnknownResponse = resp
+		return response, nil
+	}
+}
+
+type CreateFlakyResult struct {
+
+	// OK
+	Response200 *CreateFlaky200
+
+	// Service Unavailable
+	Response503 *CreateFlaky503
+
+	StatusCode int
+
+	// The raw http.Response for non-spec responses (e.g. 502 NGINX Error) that don't have a defined response body or headers in the spec. This allows callers to inspect the full response for debugging or error handling purposes.
+	//
+	// The client will only populate this field for responses that don't match any of the defined status codes in the spec.
+	//
+	// Callers should check the StatusCode field to determine if the response was a known spec response or an unknown response, and handle accordingly.
+	//
+	// Callers MUST CLOSE THE BODY of this response when done inspecting it to avoid resource leaks.
+	UnknownResponse *http.Response
+}
+
+// CreateFlaky calls POST /flaky.
+func (c *TestingAPI) CreateFlaky(ctx context.Context, params *CreateFlakyReq) (CreateFlakyResult, *TestingAPIError) {
+	var body io.Reader
+
+	path := "/flaky"
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		c.baseURL+path,
+		body,
+	)
+	if err != nil {
+		return CreateFlakyResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "failed to create HTTP request",
+			Err:     err,
+		}
+	}
+
+	q := req.URL.Query()
+
+	queryFailures, err := paramToString(params.Failures, "query parameter: Failures", "int64", true)
+	if err != nil {
+		return CreateFlakyResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid query parameter failures",
+			Err:     err,
+		}
+	}
+	q.Set("failures", queryFailures)
+
+	queryKey, err := paramToString(params.Key, "query parameter: Key", "string", true)
+	if err != nil {
+		return CreateFlakyResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid query parameter key",
+			Err:     err,
+		}
+	}
+	q.Set("key", queryKey)
+
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.do(ctx, CreateFlakyRoute, req)
+	if err != nil {
+		return CreateFlakyResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "HTTP request failed",
+			Err:     err,
+		}
+	}
+	response := CreateFlakyResult{
+		StatusCode: resp.StatusCode,
+	}
+	switch resp.StatusCode {
+
+	case 200:
+
+		parsedResp, err := ParseCreateFlaky200(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 200),
+				Err:     err,
+			}
+		}
+		response.Response200 = parsedResp
+		return response, nil
+
+	case 503:
+
+		parsedResp, err := ParseCreateFlaky503(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 503),
+				Err:     err,
+			}
+		}
+		response.Response503 = parsedResp
+		return response, nil
+
+	default:
+		response.UnknownResponse = resp
+		return response, nil
+	}
+}
+
+type SubmitFlakyResult struct {
+
+	// OK
+	Response200 *SubmitFlaky200
+
+	// Service Unavailable
+	Response503 *SubmitFlaky503
+
+	StatusCode int
+
+	// The raw http.Response for non-spec responses (e.g. 502 NGINX Error) that don't have a defined response body or headers in the spec. This allows callers to inspect the full response for debugging or error handling purposes.
+	//
+	// The client will only populate this field for responses that don't match any of the defined status codes in the spec.
+	//
+	// Callers should check the StatusCode field to determine if the response was a known spec response or an unknown response, and handle accordingly.
+	//
+	// Callers MUST CLOSE THE BODY of this response when done inspecting it to avoid resource leaks.
+	UnknownResponse *http.Response
+}
+
+// SubmitFlaky calls POST /flaky/submit.
+func (c *TestingAPI) SubmitFlaky(ctx context.Context, params *SubmitFlakyReq) (SubmitFlakyResult, *TestingAPIError) {
+	var body io.Reader
+
+	path := "/flaky/submit"
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		c.baseURL+path,
+		body,
+	)
+	if err != nil {
+		return SubmitFlakyResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "failed to create HTTP request",
+			Err:     err,
+		}
+	}
+
+	idempotencyKey := params.IdempotencyKey
+	if idempotencyKey == "" {
+		idempotencyKey = newIdempotencyKey()
+	}
+	req.Header.Set("Idempotency-Key", idempotencyKey)
+
+	q := req.URL.Query()
+
+	queryFailures, err := paramToString(params.Failures, "query parameter: Failures", "int64", true)
+	if err != nil {
+		return SubmitFlakyResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid query parameter failures",
+			Err:     err,
+		}
+	}
+	q.Set("failures", queryFailures)
+
+	queryKey, err := paramToString(params.Key, "query parameter: Key", "string", true)
+	if err != nil {
+		return SubmitFlakyResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid query parameter key",
+			Err:     err,
+		}
+	}
+	q.Set("key", queryKey)
+
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.doWithRetry(ctx, SubmitFlakyRoute, req, retryPolicy{
+		maxAttempts:    3,
+		statusCodes:    []int{503},
+		initialBackoff: 10 * time.Millisecond,
+		maxBackoff:     100 * time.Millisecond,
+	})
+	if err != nil {
+		return SubmitFlakyResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "HTTP request failed",
+			Err:     err,
+		}
+	}
+	response := SubmitFlakyResult{
+		StatusCode: resp.StatusCode,
+	}
+	switch resp.StatusCode {
+
+	case 200:
+
+		parsedResp, err := ParseSubmitFlaky200(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 200),
+				Err:     err,
+			}
+		}
+		response.Response200 = parsedResp
+		return response, nil
+
+	case 503:
+
+		parsedResp, err := ParseSubmitFlaky503(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 503),
+				Err:     err,
+			}
+		}
+		response.Response503 = parsedResp
+		return response, nil
+
+	default:
+		response.UnknownResponse = resp
+		return response, nil
+	}
+}
+
+type ListEventsResult struct {
+
+	// OK
+	Response200 *ListEvents200
+
+	StatusCode int
+
+	// The raw http.Response for non-spec responses (e.g. 502 NGINX Error) that don't have a defined response body or headers in the spec. This allows callers to inspect the full response for debugging or error handling purposes.
+	//
+	// The client will only populate this field for responses that don't match any of the defined status codes in the spec.
+	//
+	// Callers should check the StatusCode field to determine if the response was a known spec response or an unknown response, and handle accordingly.
+	//
+	// Callers MUST CLOSE THE BODY of this response when done inspecting it to avoid resource leaks.
+	UnknownResponse *http.Response
+}
+
+// ListEvents calls GET /events.
+func (c *TestingAPI) ListEvents(ctx context.Context, params *ListEventsReq) (ListEventsResult, *TestingAPIError) {
+	var body io.Reader
+
+	path := "/events"
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		c.baseURL+path,
+		body,
+	)
+	if err != nil {
+		return ListEventsResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "failed to create HTTP request",
+			Err:     err,
+		}
+	}
+
+	q := req.URL.Query()
+
+	queryLimit, err := paramToString(params.Limit, "query parameter: Limit", "*int64", false)
+	if err != nil {
+		return ListEventsResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid query parameter limit",
+			Err:     err,
+		}
+	}
+	q.Set("limit", queryLimit)
+
+	queryOffset, err := paramToString(params.Offset, "query parameter: Offset", "*int64", false)
+	if err != nil {
+		return ListEventsResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid query parameter offset",
+			Err:     err,
+		}
+	}
+	q.Set("offset", queryOffset)
+
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.doWithRetry(ctx, ListEventsRoute, req, retryPolicy{
+		maxAttempts:    3,
+		statusCodes:    []int{503},
+		initialBackoff: 10 * time.Millisecond,
+		maxBackoff:     100 * time.Millisecond,
+	})
+	if err != nil {
+		return ListEventsResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "HTTP request failed",
+			Err:     err,
+		}
+	}
+	response := ListEventsResult{
+		StatusCode: resp.StatusCode,
+	}
+	switch resp.StatusCode {
+
+	case 200:
+
+		parsedResp, err := ParseListEvents200(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 200),
+				Err:     err,
+			}
+		}
+		response.Response200 = parsedResp
+		return response, nil
+
+	default:
+		response.UnknownResponse = resp
+		return response, nil
+	}
+}
+
+type ListEventFeedResult struct {
+
+	// OK
+	Response200 *ListEventFeed200
+
+	StatusCode int
+
+	// The raw http.Response for non-spec responses (e.g. 502 NGINX Error) that don't have a defined response body or headers in the spec. This allows callers to inspect the full response for debugging or error handling purposes.
+	//
+	// The client will only populate this field for responses that don't match any of the defined status codes in the spec.
+	//
+	// Callers should check the StatusCode field to determine if the response was a known spec response or an unknown response, and handle accordingly.
+	//
+	// Callers MUST CLOSE THE BODY of this response when done inspecting it to avoid resource leaks.
+	UnknownResponse *http.Response
+}
+
+// ListEventFeed calls GET /events/feed.
+func (c *TestingAPI) ListEventFeed(ctx context.Context, params *ListEventFeedReq) (ListEventFeedResult, *TestingAPIError) {
+	var body io.Reader
+
+	path := "/events/feed"
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		c.baseURL+path,
+		body,
+	)
+	if err != nil {
+		return ListEventFeedResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "failed to create HTTP request",
+			Err:     err,
+		}
+	}
+
+	q := req.URL.Query()
+
+	queryCursor, err := paramToString(params.Cursor, "query parameter: Cursor", "*string", false)
+	if err != nil {
+		return ListEventFeedResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid query parameter cursor",
+			Err:     err,
+		}
+	}
+	q.Set("cursor", queryCursor)
+
+	queryLimit, err := paramToString(params.Limit, "query parameter: Limit", "*int64", false)
+	if err != nil {
+		return ListEventFeedResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid query parameter limit",
+			Err:     err,
+		}
+	}
+	q.Set("limit", queryLimit)
+
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.doWithRetry(ctx, ListEventFeedRoute, req, retryPolicy{
+		maxAttempts:    3,
+		statusCodes:    []int{503},
+		initialBackoff: 10 * time.Millisecond,
+		maxBackoff:     100 * time.Millisecond,
+	})
+	if err != nil {
+		return ListEventFeedResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "HTTP request failed",
+			Err:     err,
+		}
+	}
+	response := ListEventFeedResult{
+		StatusCode: resp.StatusCode,
+	}
+	switch resp.StatusCode {
+
+	case 200:
+
+		parsedResp, err := ParseListEventFeed200(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 200),
+				Err:     err,
+			}
+		}
+		response.Response200 = parsedResp
+		return response, nil
+
+	default:
+		response.UnknownResponse = resp
+		return response, nil
+	}
+}
+
+type SendNotificationResult struct {
+
+	// The notification as sent.
+	Response200 *SendNotification200
+
+	// Bad Request
+	Response400 *SendNotification400
+
+	// Payload Too Large - the request body exceeds the maximum allowed size
+	Response413 *SendNotification413Response
+
+	StatusCode int
+
+	// The raw http.Response for non-spec responses (e.g. 502 NGINX Error) that don't have a defined response body or headers in the spec. This allows callers to inspect the full response for debugging or error handling purposes.
+	//
+	// The client will only populate this field for responses that don't match any of the defined status codes in the spec.
+	//
+	// Callers should check the StatusCode field to determine if the response was a known spec response or an unknown response, and handle accordingly.
+	//
+	// Callers MUST CLOSE THE BODY of this response when done inspecting it to avoid resource leaks.
+	UnknownResponse *http.Response
+}
+
+// SendNotification calls POST /notifications.
+func (c *TestingAPI) SendNotification(ctx context.Context, params *SendNotificationReq) (SendNotificationResult, *TestingAPIError) {
+	var body io.Reader
+
+	bodyBytes, err := json.Marshal(params.Body)
+	if err != nil {
+		return SendNotificationResult{}, &TestingAPIError{
+			Reason:  ReasonEncoding,
+			Message: "failed to marshal request body",
+			Err:     err,
+		}
+	}
+	body = bytes.NewReader(bodyBytes)
+
+	path := "/notifications"
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		c.baseURL+path,
+		body,
+	)
+	if err != nil {
+		return SendNotificationResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "failed to create HTTP request",
+			Err:     err,
+		}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(ctx, SendNotificationRoute, req)
+	if err != nil {
+		return SendNotificationResult{}, &TestingAPIError{
+			Reason:  ReasonTransport,
+			Message: "HTTP request failed",
+			Err:     err,
+		}
+	}
+	response := SendNotificationResult{
+		StatusCode: resp.StatusCode,
+	}
+	switch resp.StatusCode {
+
+	case 200:
+
+		parsedResp, err := ParseSendNotification200(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 200),
+				Err:     err,
+			}
+		}
+		response.Response200 = parsedResp
+		return response, nil
+
+	case 400:
+
+		parsedResp, err := ParseSendNotification400(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 400),
+				Err:     err,
+			}
+		}
+		response.Response400 = parsedResp
+		return response, nil
+
+	case 413:
+
+		parsedResp, err := ParseSendNotification413Response(resp)
+		if err != nil {
+			response.UnknownResponse = resp
+			return response, &TestingAPIError{
+				Reason:  ReasonUnexpected,
+				Message: fmt.Sprintf("failed to parse response for status code %d", 413),
+				Err:     err,
+			}
+		}
+		response.Response413 = parsedResp
+		return response, nil
+
+	default:
+		response.UnknownResponse = resp
+		return response, nil
+	}
+}
+
 type HealthCheckResult struct {
 
 	// OK
@@ -770,6 +2241,7 @@ type HealthCheckResult struct {
 	UnknownResponse *http.Response
 }
 
+// HealthCheck calls GET /health.
 func (c *TestingAPI) HealthCheck(ctx context.Context, params *HealthCheckReq) (HealthCheckResult, *TestingAPIError) {
 	var body io.Reader
 
@@ -789,7 +2261,12 @@ func (c *TestingAPI) HealthCheck(ctx context.Context, params *HealthCheckReq) (H
 		}
 	}
 
-	resp, err := c.do(ctx, req)
+	resp, err := c.doWithRetry(ctx, HealthCheckRoute, req, retryPolicy{
+		maxAttempts:    3,
+		statusCodes:    []int{503},
+		initialBackoff: 10 * time.Millisecond,
+		maxBackoff:     100 * time.Millisecond,
+	})
 	if err != nil {
 		return HealthCheckResult{}, &TestingAPIError{
 			Reason:  ReasonTransport,
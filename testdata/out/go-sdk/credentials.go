@@ -0,0 +1,196 @@
+package go_sdk
+
+import (
+	"context"
+)
+
+// CredentialsProvider supplies the default credential of an auth method to the TestingAPI,
+// for the requests that do not set it, see the With<AuthMethod>CredentialsProvider options.
+//
+// Implementations must be safe for concurrent use.
+type CredentialsProvider[T any] interface {
+	// Credentials returns the credential to send with a request.
+	Credentials(ctx context.Context) (T, error)
+}
+
+// CredentialsProviderFunc is a CredentialsProvider implemented by a function.
+type CredentialsProviderFunc[T any] func(ctx context.Context) (T, error)
+
+// Credentials calls f.
+func (f CredentialsProviderFunc[T]) Credentials(ctx context.Context) (T, error) {
+	return f(ctx)
+}
+
+// StaticCredentials returns a CredentialsProvider that always supplies credential.
+func StaticCredentials[T any](credential T) CredentialsProvider[T] {
+	return CredentialsProviderFunc[T](func(context.Context) (T, error) {
+		return credential, nil
+	})
+}
+
+// clientCredentials holds the CredentialsProvider of each auth method of the TestingAPI, nil if it has none.
+type clientCredentials struct {
+	AdminToken       CredentialsProvider[string]
+	APIKey           CredentialsProvider[string]
+	Basic            CredentialsProvider[BasicAuthCredentials]
+	BearerToken      CredentialsProvider[string]
+	QueryKey         CredentialsProvider[string]
+	RefreshToken     CredentialsProvider[string]
+	Service          CredentialsProvider[string]
+	SessionCookie    CredentialsProvider[string]
+	SessionToken     CredentialsProvider[string]
+	WebhookSignature CredentialsProvider[HMACKey]
+}
+
+// WithAdminTokenCredentials sets the default AdminToken credential (header: "X-App-Admin-Token") of the TestingAPI,
+// sent with the requests whose AdminTokenAuth is unset.
+func WithAdminTokenCredentials(credential string) ClientOption {
+	return WithAdminTokenCredentialsProvider(StaticCredentials(credential))
+}
+
+// WithAdminTokenCredentialsProvider sets the CredentialsProvider of the default AdminToken credential (header: "X-App-Admin-Token")
+// of the TestingAPI, called for each request whose AdminTokenAuth is unset.
+func WithAdminTokenCredentialsProvider(provider CredentialsProvider[string]) ClientOption {
+	return func(c *TestingAPI) {
+		c.credentials.AdminToken = provider
+	}
+}
+
+// WithAPIKeyCredentials sets the default APIKey credential (header: "X-App-API-Key") of the TestingAPI,
+// sent with the requests whose APIKeyAuth is unset.
+func WithAPIKeyCredentials(credential string) ClientOption {
+	return WithAPIKeyCredentialsProvider(StaticCredentials(credential))
+}
+
+// WithAPIKeyCredentialsProvider sets the CredentialsProvider of the default APIKey credential (header: "X-App-API-Key")
+// of the TestingAPI, called for each request whose APIKeyAuth is unset.
+func WithAPIKeyCredentialsProvider(provider CredentialsProvider[string]) ClientOption {
+	return func(c *TestingAPI) {
+		c.credentials.APIKey = provider
+	}
+}
+
+// WithBasicCredentials sets the default Basic credential (basic: "Authorization") of the TestingAPI,
+// sent with the requests whose BasicAuth is unset.
+func WithBasicCredentials(credential BasicAuthCredentials) ClientOption {
+	return WithBasicCredentialsProvider(StaticCredentials(credential))
+}
+
+// WithBasicCredentialsProvider sets the CredentialsProvider of the default Basic credential (basic: "Authorization")
+// of the TestingAPI, called for each request whose BasicAuth is unset.
+func WithBasicCredentialsProvider(provider CredentialsProvider[BasicAuthCredentials]) ClientOption {
+	return func(c *TestingAPI) {
+		c.credentials.Basic = provider
+	}
+}
+
+// WithBearerTokenCredentials sets the default BearerToken credential (bearer: "Authorization") of the TestingAPI,
+// sent with the requests whose BearerTokenAuth is unset.
+func WithBearerTokenCredentials(credential string) ClientOption {
+	return WithBearerTokenCredentialsProvider(StaticCredentials(credential))
+}
+
+// WithBearerTokenCredentialsProvider sets the CredentialsProvider of the default BearerToken credential (bearer: "Authorization")
+// of the TestingAPI, called for each request whose BearerTokenAuth is unset.
+func WithBearerTokenCredentialsProvider(provider CredentialsProvider[string]) ClientOption {
+	return func(c *TestingAPI) {
+		c.credentials.BearerToken = provider
+	}
+}
+
+// WithQueryKeyCredentials sets the default QueryKey credential (query: "api_key") of the TestingAPI,
+// sent with the requests whose QueryKeyAuth is unset.
+func WithQueryKeyCredentials(credential string) ClientOption {
+	return WithQueryKeyCredentialsProvider(StaticCredentials(credential))
+}
+
+// WithQueryKeyCredentialsProvider sets the CredentialsProvider of the default QueryKey credential (query: "api_key")
+// of the TestingAPI, called for each request whose QueryKeyAuth is unset.
+func WithQueryKeyCredentialsProvider(provider CredentialsProvider[string]) ClientOption {
+	return func(c *TestingAPI) {
+		c.credentials.QueryKey = provider
+	}
+}
+
+// WithRefreshTokenCredentials sets the default RefreshToken credential (header: "X-App-Refresh-Token") of the TestingAPI,
+// sent with the requests whose RefreshTokenAuth is unset.
+func WithRefreshTokenCredentials(credential string) ClientOption {
+	return WithRefreshTokenCredentialsProvider(StaticCredentials(credential))
+}
+
+// WithRefreshTokenCredentialsProvider sets the CredentialsProvider of the default RefreshToken credential (header: "X-App-Refresh-Token")
+// of the TestingAPI, called for each request whose RefreshTokenAuth is unset.
+func WithRefreshTokenCredentialsProvider(provider CredentialsProvider[string]) ClientOption {
+	return func(c *TestingAPI) {
+		c.credentials.RefreshToken = provider
+	}
+}
+
+// WithServiceCredentials sets the default Service credential (oauth2ClientCredentials: "X-App-Service-Token") of the TestingAPI,
+// sent with the requests whose ServiceAuth is unset.
+func WithServiceCredentials(credential string) ClientOption {
+	return WithServiceCredentialsProvider(StaticCredentials(credential))
+}
+
+// WithServiceCredentialsProvider sets the CredentialsProvider of the default Service credential (oauth2ClientCredentials: "X-App-Service-Token")
+// of the TestingAPI, called for each request whose ServiceAuth is unset.
+func WithServiceCredentialsProvider(provider CredentialsProvider[string]) ClientOption {
+	return func(c *TestingAPI) {
+		c.credentials.Service = provider
+	}
+}
+
+// WithSessionCookieCredentials sets the default SessionCookie credential (cookie: "app_session") of the TestingAPI,
+// sent with the requests whose SessionCookieAuth is unset.
+func WithSessionCookieCredentials(credential string) ClientOption {
+	return WithSessionCookieCredentialsProvider(StaticCredentials(credential))
+}
+
+// WithSessionCookieCredentialsProvider sets the CredentialsProvider of the default SessionCookie credential (cookie: "app_session")
+// of the TestingAPI, called for each request whose SessionCookieAuth is unset.
+func WithSessionCookieCredentialsProvider(provider CredentialsProvider[string]) ClientOption {
+	return func(c *TestingAPI) {
+		c.credentials.SessionCookie = provider
+	}
+}
+
+// WithSessionTokenCredentials sets the default SessionToken credential (header: "X-App-Session-Token") of the TestingAPI,
+// sent with the requests whose SessionTokenAuth is unset.
+func WithSessionTokenCredentials(credential string) ClientOption {
+	return WithSessionTokenCredentialsProvider(StaticCredentials(credential))
+}
+
+// WithSessionTokenCredentialsProvider sets the CredentialsProvider of the default SessionToken credential (header: "X-App-Session-Token")
+// of the TestingAPI, called for each request whose SessionTokenAuth is unset.
+func WithSessionTokenCredentialsProvider(provider CredentialsProvider[string]) ClientOption {
+	return func(c *TestingAPI) {
+		c.credentials.SessionToken = provider
+	}
+}
+
+// WithWebhookSignatureCredentials sets the default WebhookSignature credential (hmac: "X-Signature") of the TestingAPI,
+// sent with the requests whose WebhookSignatureAuth is unset.
+func WithWebhookSignatureCredentials(credential HMACKey) ClientOption {
+	return WithWebhookSignatureCredentialsProvider(StaticCredentials(credential))
+}
+
+// WithWebhookSignatureCredentialsProvider sets the CredentialsProvider of the default WebhookSignature credential (hmac: "X-Signature")
+// of the TestingAPI, called for each request whose WebhookSignatureAuth is unset.
+func WithWebhookSignatureCredentialsProvider(provider CredentialsProvider[HMACKey]) ClientOption {
+	return func(c *TestingAPI) {
+		c.credentials.WebhookSignature = provider
+	}
+}
+
+// provideCredentials returns the credential supplied by provider for the auth parameter sent in header.
+func provideCredentials[T any](ctx context.Context, provider CredentialsProvider[T], header string) (T, *TestingAPIError) {
+	credential, err := provider.Credentials(ctx)
+	if err != nil {
+		return credential, &TestingAPIError{
+			Reason:  ReasonAuth,
+			Message: "failed to obtain default credential for auth parameter " + header,
+			Err:     err,
+		}
+	}
+	return credential, nil
+}
@@ -1,3 +1,3 @@
 module github.com/nbrglm/napiway/testdata/out/go_sdk
 
-go 1.26.3
+go 1.27.1
@@ -0,0 +1,200 @@
+package go_sdk
+
+import (
+	"net/http"
+)
+
+// Route describes an endpoint of the specification, as passed to the Middleware of the TestingAPI.
+type Route struct {
+	// Name of the endpoint, e.g. "CreateUser".
+	Name string
+
+	Method string
+
+	// Path pattern of the endpoint, e.g. "/users/{userId}".
+	Path string
+
+	// Authorization scopes required by the endpoint, if any.
+	Scopes []string
+
+	// IDs of the auth methods of the endpoint in the specification, if any.
+	AuthMethods []string
+
+	// Whether the endpoint is idempotent: its method is GET, PUT or DELETE, or it is marked idempotent in the specification.
+	Idempotent bool
+}
+
+var (
+	// CreateUserRoute is the Route of the CreateUser endpoint.
+	CreateUserRoute = Route{
+		Name:        "CreateUser",
+		Method:      CreateUserReqHTTPMethod,
+		Path:        CreateUserReqRoutePath,
+		AuthMethods: []string{"adminTokenAuth", "apiKeyAuth"},
+	}
+
+	// GetUserRoute is the Route of the GetUser endpoint.
+	GetUserRoute = Route{
+		Name:        "GetUser",
+		Method:      GetUserReqHTTPMethod,
+		Path:        GetUserReqRoutePath,
+		AuthMethods: []string{"apiKeyAuth", "sessionTokenAuth"},
+		Idempotent:  true,
+	}
+
+	// ListUsersRoute is the Route of the ListUsers endpoint.
+	ListUsersRoute = Route{
+		Name:        "ListUsers",
+		Method:      ListUsersReqHTTPMethod,
+		Path:        ListUsersReqRoutePath,
+		Scopes:      []string{"users:read"},
+		AuthMethods: []string{"adminTokenAuth", "apiKeyAuth"},
+		Idempotent:  true,
+	}
+
+	// LogoutUserRoute is the Route of the LogoutUser endpoint.
+	LogoutUserRoute = Route{
+		Name:        "LogoutUser",
+		Method:      LogoutUserReqHTTPMethod,
+		Path:        LogoutUserReqRoutePath,
+		AuthMethods: []string{"apiKeyAuth", "refreshTokenAuth", "sessionTokenAuth"},
+		Idempotent:  true,
+	}
+
+	// WhoAmIRoute is the Route of the WhoAmI endpoint.
+	WhoAmIRoute = Route{
+		Name:        "WhoAmI",
+		Method:      WhoAmIReqHTTPMethod,
+		Path:        WhoAmIReqRoutePath,
+		AuthMethods: []string{"apiKeyAuth", "sessionTokenAuth"},
+	}
+
+	// GetSessionRoute is the Route of the GetSession endpoint.
+	GetSessionRoute = Route{
+		Name:        "GetSession",
+		Method:      GetSessionReqHTTPMethod,
+		Path:        GetSessionReqRoutePath,
+		AuthMethods: []string{"basicAuth", "bearerAuth", "queryKeyAuth", "serviceAuth", "sessionCookieAuth"},
+		Idempotent:  true,
+	}
+
+	// ReceiveWebhookRoute is the Route of the ReceiveWebhook endpoint.
+	ReceiveWebhookRoute = Route{
+		Name:        "ReceiveWebhook",
+		Method:      ReceiveWebhookReqHTTPMethod,
+		Path:        ReceiveWebhookReqRoutePath,
+		AuthMethods: []string{"webhookAuth"},
+	}
+
+	// DeleteUserRoute is the Route of the DeleteUser endpoint.
+	DeleteUserRoute = Route{
+		Name:        "DeleteUser",
+		Method:      DeleteUserReqHTTPMethod,
+		Path:        DeleteUserReqRoutePath,
+		Scopes:      []string{"users:delete"},
+		AuthMethods: []string{"adminTokenAuth", "apiKeyAuth", "sessionTokenAuth"},
+		Idempotent:  true,
+	}
+
+	// GetFlakyRoute is the Route of the GetFlaky endpoint.
+	GetFlakyRoute = Route{
+		Name:       "GetFlaky",
+		Method:     GetFlakyReqHTTPMethod,
+		Path:       GetFlakyReqRoutePath,
+		Idempotent: true,
+	}
+
+	// CreateFlakyRoute is the Route of the CreateFlaky endpoint.
+	CreateFlakyRoute = Route{
+		Name:   "CreateFlaky",
+		Method: CreateFlakyReqHTTPMethod,
+		Path:   CreateFlakyReqRoutePath,
+	}
+
+	// SubmitFlakyRoute is the Route of the SubmitFlaky endpoint.
+	SubmitFlakyRoute = Route{
+		Name:       "SubmitFlaky",
+		Method:     SubmitFlakyReqHTTPMethod,
+		Path:       SubmitFlakyReqRoutePath,
+		Idempotent: true,
+	}
+
+	// ListEventsRoute is the Route of the ListEvents endpoint.
+	ListEventsRoute = Route{
+		Name:       "ListEvents",
+		Method:     ListEventsReqHTTPMethod,
+		Path:       ListEventsReqRoutePath,
+		Idempotent: true,
+	}
+
+	// ListEventFeedRoute is the Route of the ListEventFeed endpoint.
+	ListEventFeedRoute = Route{
+		Name:       "ListEventFeed",
+		Method:     ListEventFeedReqHTTPMethod,
+		Path:       ListEventFeedReqRoutePath,
+		Idempotent: true,
+	}
+
+	// SendNotificationRoute is the Route of the SendNotification endpoint.
+	SendNotificationRoute = Route{
+		Name:   "SendNotification",
+		Method: SendNotificationReqHTTPMethod,
+		Path:   SendNotificationReqRoutePath,
+	}
+
+	// HealthCheckRoute is the Route of the HealthCheck endpoint.
+	HealthCheckRoute = Route{
+		Name:       "HealthCheck",
+		Method:     HealthCheckReqHTTPMethod,
+		Path:       HealthCheckReqRoutePath,
+		Idempotent: true,
+	}
+)
+
+// Routes lists the Route of every endpoint in the specification.
+var Routes = []Route{
+	CreateUserRoute,
+	GetUserRoute,
+	ListUsersRoute,
+	LogoutUserRoute,
+	WhoAmIRoute,
+	GetSessionRoute,
+	ReceiveWebhookRoute,
+	DeleteUserRoute,
+	GetFlakyRoute,
+	CreateFlakyRoute,
+	SubmitFlakyRoute,
+	ListEventsRoute,
+	ListEventFeedRoute,
+	SendNotificationRoute,
+	HealthCheckRoute,
+}
+
+// Doer sends the HTTP requests of the TestingAPI.
+type Doer interface {
+	// Do sends req, a request to route, and returns its response, like http.Client.Do.
+	Do(route Route, req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is a Doer implemented by a function.
+type DoerFunc func(route Route, req *http.Request) (*http.Response, error)
+
+// Do calls f.
+func (f DoerFunc) Do(route Route, req *http.Request) (*http.Response, error) {
+	return f(route, req)
+}
+
+// Middleware wraps the Doer of the TestingAPI, e.g. to log requests, add tracing headers, record metrics or
+// authenticate requests. It returns a Doer that calls next to send the request, or answers it without calling next.
+//
+// The Doer it returns is called for every HTTP request, including each retry, once the request is complete:
+// its parameters, credentials and the Accept and User-Agent headers are set. It must be safe for concurrent use.
+type Middleware func(next Doer) Doer
+
+// WithMiddleware adds middleware to the TestingAPI. The first middleware added is the outermost one,
+// which receives the requests first and their responses last.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(c *TestingAPI) {
+		c.middleware = append(c.middleware, middleware...)
+	}
+}
@@ -0,0 +1,219 @@
+package go_sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenProvider supplies the access tokens of an oauth2ClientCredentials auth method to the TestingAPI,
+// see the With<AuthMethod>TokenProvider options.
+//
+// Implementations must be safe for concurrent use.
+type TokenProvider interface {
+	// Token returns a valid access token, obtaining a new one if needed.
+	Token(ctx context.Context) (string, error)
+
+	// Invalidate discards token, if it is still the current one, so that the next call to Token obtains a new one.
+	//
+	// It is called when the server answers a request made with token with 401 Unauthorized.
+	Invalidate(token string)
+}
+
+// ClientCredentialsTokenProvider is a TokenProvider that obtains access tokens from a token endpoint
+// with the OAuth2 client credentials grant (RFC 6749, section 4.4).
+//
+// Tokens are cached, and obtained again RefreshBefore their expiry.
+type ClientCredentialsTokenProvider struct {
+	// URL of the token endpoint.
+	TokenURL string
+
+	ClientID     string
+	ClientSecret string
+
+	// Scopes requested from the token endpoint, if any.
+	Scopes []string
+
+	// HTTPClient is used for the token requests. Defaults to a client with a 30 seconds timeout.
+	HTTPClient *http.Client
+
+	// How long before its expiry a cached token is replaced. Defaults to 30 seconds.
+	RefreshBefore time.Duration
+
+	// Guards the fields below, and is held while a token is obtained, so concurrent callers wait for the same token.
+	mu     sync.Mutex
+	token  string
+	expiry time.Time // zero if the token endpoint did not return expires_in
+}
+
+// Token returns the cached access token, or obtains a new one if there is none or it is about to expire.
+func (p *ClientCredentialsTokenProvider) Token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	refreshBefore := p.RefreshBefore
+	if refreshBefore == 0 {
+		refreshBefore = 30 * time.Second
+	}
+	if p.token != "" && (p.expiry.IsZero() || time.Now().Add(refreshBefore).Before(p.expiry)) {
+		return p.token, nil
+	}
+
+	token, expiry, err := p.requestToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	p.token, p.expiry = token, expiry
+	return token, nil
+}
+
+// Invalidate discards the cached access token if it is token.
+func (p *ClientCredentialsTokenProvider) Invalidate(token string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.token == token {
+		p.token, p.expiry = "", time.Time{}
+	}
+}
+
+// tokenResponse is the successful response of a token endpoint (RFC 6749, section 5.1).
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// requestToken obtains a new access token from the token endpoint, and returns it with its expiry.
+func (p *ClientCredentialsTokenProvider) requestToken(ctx context.Context) (string, time.Time, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(p.Scopes) > 0 {
+		form.Set("scope", strings.Join(p.Scopes, " "))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	// The client credentials are form-encoded before being used as basic auth credentials (RFC 6749, section 2.3.1).
+	req.SetBasicAuth(url.QueryEscape(p.ClientID), url.QueryEscape(p.ClientSecret))
+
+	httpClient := p.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	requestedAt := time.Now()
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", time.Time{}, fmt.Errorf("token endpoint responded with status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+	var tokenResp tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to decode token response: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return "", time.Time{}, fmt.Errorf("token response has no access_token")
+	}
+	if tokenResp.TokenType != "" && !strings.EqualFold(tokenResp.TokenType, "bearer") {
+		return "", time.Time{}, fmt.Errorf("unsupported token type %q", tokenResp.TokenType)
+	}
+
+	var expiry time.Time
+	if tokenResp.ExpiresIn > 0 {
+		expiry = requestedAt.Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	}
+	return tokenResp.AccessToken, expiry, nil
+}
+
+// NewServiceTokenProvider returns a ClientCredentialsTokenProvider for the Service auth method,
+// with the token URL and scopes of the specification.
+func NewServiceTokenProvider(clientID, clientSecret string) *ClientCredentialsTokenProvider {
+	return &ClientCredentialsTokenProvider{
+		TokenURL:     "http://localhost:5000/oauth/token",
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scopes:       []string{"session:read"},
+	}
+}
+
+// WithServiceTokenProvider sets the TokenProvider of the Service auth method of the TestingAPI.
+//
+// Requests that need the Service credential and are not given one get a token from provider,
+// and are retried once with a new token if the server answers them with 401 Unauthorized.
+func WithServiceTokenProvider(provider TokenProvider) ClientOption {
+	return func(c *TestingAPI) {
+		if c.tokenProviders == nil {
+			c.tokenProviders = make(map[string]TokenProvider)
+		}
+		c.tokenProviders["serviceAuth"] = provider
+	}
+}
+
+// providedToken is a token set on a request by provideToken.
+type providedToken struct {
+	provider TokenProvider
+	header   string
+	token    string
+}
+
+// provideToken returns a token from the TokenProvider of the auth method, and records it in provided.
+//
+// It returns an empty string if the auth method has no TokenProvider.
+func (c *TestingAPI) provideToken(ctx context.Context, authMethodID, header string, provided *[]providedToken) (string, *TestingAPIError) {
+	provider := c.tokenProviders[authMethodID]
+	if provider == nil {
+		return "", nil
+	}
+	token, err := provider.Token(ctx)
+	if err != nil {
+		return "", &TestingAPIError{
+			Reason:  ReasonAuth,
+			Message: "failed to obtain token for auth parameter " + header,
+			Err:     err,
+		}
+	}
+	*provided = append(*provided, providedToken{provider: provider, header: header, token: token})
+	return token, nil
+}
+
+// retryUnauthorized retries req once with new tokens if resp is 401 Unauthorized and req carries provided tokens,
+// in case they were revoked before their expiry. Otherwise, it returns resp.
+//
+// Requests with a body that cannot be replayed (see http.Request.GetBody) are not retried.
+func (c *TestingAPI) retryUnauthorized(ctx context.Context, route Route, req *http.Request, resp *http.Response, provided []providedToken) (*http.Response, error) {
+	if resp.StatusCode != http.StatusUnauthorized || len(provided) == 0 || (req.Body != nil && req.GetBody == nil) {
+		return resp, nil
+	}
+	retry := req.Clone(ctx)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	for _, p := range provided {
+		p.provider.Invalidate(p.token)
+		token, err := p.provider.Token(ctx)
+		if err != nil {
+			return resp, nil
+		}
+		retry.Header.Set(p.header, formatAuthValue(token, "Bearer ", ""))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	return c.do(ctx, route, retry)
+}
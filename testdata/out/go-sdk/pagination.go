@@ -0,0 +1,151 @@
+package go_sdk
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// ListUsersAll returns an iterator over the Users of every page of ListUsers, requested by their PageNumber, from the one of params, or 0.
+//
+// Each page is requested once the items of the previous one are consumed. The iteration stops at the first error,
+// yielded with the zero User: the error of ListUsers, or a *TestingAPIError with ReasonUnexpected
+// for a page whose response is not a 200.
+func (c *TestingAPI) ListUsersAll(ctx context.Context, params *ListUsersReq) iter.Seq2[User, error] {
+	return func(yield func(User, error) bool) {
+		var zero User
+		// Copy the params, so the pages requested are not visible to the caller
+		paramsCopy := *params
+		params := &paramsCopy
+		position := int64(0)
+		if params.PageNumber != nil {
+			position = *params.PageNumber
+		}
+		// count is the number of items up to the current page, including those of the pages before startPage
+		startPage := position
+		var count int64
+		for {
+			params.PageNumber = &position
+			result, err := c.ListUsers(ctx, params)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if result.Response200 == nil {
+				yield(zero, unexpectedPageError("ListUsers", result.StatusCode, result.UnknownResponse))
+				return
+			}
+			body := result.Response200.Body
+			for _, item := range body.Users {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if position == startPage {
+				// The pages before the first one requested are assumed to be as large as it
+				count = startPage * int64(len(body.Users))
+			}
+			count += int64(len(body.Users))
+			if len(body.Users) == 0 || count >= body.TotalCount {
+				return
+			}
+			position++
+		}
+	}
+}
+
+// ListEventsAll returns an iterator over the Events of every page of ListEvents, requested by the Offset of their first item, from the one of params, or 0.
+//
+// Each page is requested once the items of the previous one are consumed. The iteration stops at the first error,
+// yielded with the zero string: the error of ListEvents, or a *TestingAPIError with ReasonUnexpected
+// for a page whose response is not a 200.
+func (c *TestingAPI) ListEventsAll(ctx context.Context, params *ListEventsReq) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		var zero string
+		// Copy the params, so the pages requested are not visible to the caller
+		paramsCopy := *params
+		params := &paramsCopy
+		position := int64(0)
+		if params.Offset != nil {
+			position = *params.Offset
+		}
+		for {
+			params.Offset = &position
+			result, err := c.ListEvents(ctx, params)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if result.Response200 == nil {
+				yield(zero, unexpectedPageError("ListEvents", result.StatusCode, result.UnknownResponse))
+				return
+			}
+			body := result.Response200.Body
+			for _, item := range body.Events {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			position += int64(len(body.Events))
+			if len(body.Events) == 0 || position >= body.TotalCount {
+				return
+			}
+		}
+	}
+}
+
+// ListEventFeedAll returns an iterator over the Events of every page of ListEventFeed, requested with the Cursor returned by the previous page in NextCursor, from the one of params.
+//
+// Each page is requested once the items of the previous one are consumed. The iteration stops at the first error,
+// yielded with the zero string: the error of ListEventFeed, or a *TestingAPIError with ReasonUnexpected
+// for a page whose response is not a 200.
+func (c *TestingAPI) ListEventFeedAll(ctx context.Context, params *ListEventFeedReq) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		var zero string
+		// Copy the params, so the pages requested are not visible to the caller
+		paramsCopy := *params
+		params := &paramsCopy
+		var cursor string
+		if params.Cursor != nil {
+			cursor = *params.Cursor
+		}
+		for {
+			params.Cursor = &cursor
+			result, err := c.ListEventFeed(ctx, params)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if result.Response200 == nil {
+				yield(zero, unexpectedPageError("ListEventFeed", result.StatusCode, result.UnknownResponse))
+				return
+			}
+			body := result.Response200.Body
+			for _, item := range body.Events {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			cursor = ""
+			if body.NextCursor != nil {
+				cursor = *body.NextCursor
+			}
+			if cursor == "" {
+				return
+			}
+		}
+	}
+}
+
+// unexpectedPageError returns the error of a page of endpoint answered with an unexpected statusCode,
+// closing the body of the unknown response, if any.
+func unexpectedPageError(endpoint string, statusCode int, unknown *http.Response) *TestingAPIError {
+	if unknown != nil {
+		unknown.Body.Close()
+	}
+	return &TestingAPIError{
+		Reason:  ReasonUnexpected,
+		Message: fmt.Sprintf("unexpected response status %d for a page of %s", statusCode, endpoint),
+	}
+}
@@ -1,7 +1,11 @@
 package go_sdk
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 )
 
@@ -11,10 +15,86 @@ const AdminTokenAuthKey = "X-App-Admin-Token"
 
 const APIKeyAuthKey = "X-App-API-Key"
 
+const BasicAuthKey = "Authorization"
+
+const BearerTokenAuthKey = "Authorization"
+
+const QueryKeyAuthKey = "api_key"
+
 const RefreshTokenAuthKey = "X-App-Refresh-Token"
 
+const ServiceAuthKey = "X-App-Service-Token"
+
+const SessionCookieAuthKey = "app_session"
+
 const SessionTokenAuthKey = "X-App-Session-Token"
 
+const WebhookSignatureAuthKey = "X-Signature"
+
+type AuditedEntity struct {
+	Entity
+
+	// The user who last updated the entity, if any.
+	//
+	// Optional
+	//
+	UpdatedBy *string `json:"UpdatedBy,omitempty"`
+}
+
+// NewAuditedEntity creates a new instance of AuditedEntity with required fields as parameters
+func NewAuditedEntity(
+
+	CreatedAt string,
+
+) *AuditedEntity {
+	return &AuditedEntity{
+		Entity: *NewEntity(CreatedAt),
+	}
+}
+
+// WithUpdatedBy sets the optional field UpdatedBy and returns the modified AuditedEntity instance
+func (o *AuditedEntity) WithUpdatedBy(value string) *AuditedEntity {
+	o.UpdatedBy = &value
+	return o
+}
+
+// ParseAuditedEntity creates a new instance of AuditedEntity from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseAuditedEntity(data map[string]any) (*AuditedEntity, error) {
+	verr := &ValidationError{}
+	body := parseAuditedEntity(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseAuditedEntity parses data into a new AuditedEntity, recording issues in verr with paths relative to path.
+func parseAuditedEntity(data map[string]any, path string, verr *ValidationError) *AuditedEntity {
+	body := new(AuditedEntity)
+	body.Entity = *parseEntity(data, path, verr)
+
+	pathUpdatedBy := joinValidationPath(path, "UpdatedBy")
+
+	valUpdatedBy, ok := data["UpdatedBy"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valUpdatedByTyped, ok := valUpdatedBy.(string); !ok {
+			verr.add(ValidationLocationBody, pathUpdatedBy, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valUpdatedByTyped = strings.TrimSpace(valUpdatedByTyped)
+
+			body.UpdatedBy = &valUpdatedByTyped
+		}
+
+	}
+
+	return body
+}
+
 type CreateUserRequestBody struct {
 
 	// The age of the user to be created.
@@ -29,6 +109,18 @@ type CreateUserRequestBody struct {
 	//
 	ArbitraryData *map[string]any `json:"ArbitraryData,omitempty"`
 
+	// The email contacts of the created user, by name, returned by the server in the response body.
+	//
+	// Optional
+	//
+	Contacts map[string]EmailNotification `json:"Contacts,omitempty"`
+
+	// Pairs of latitude and longitude, returned by the server in the response body. Just for testing nested array support in the generator.
+	//
+	// Optional
+	//
+	Coordinates [][]float64 `json:"Coordinates,omitempty"`
+
 	// The email address of the user to be created.
 	//
 	// Required
@@ -36,6 +128,24 @@ type CreateUserRequestBody struct {
 	// Must be non-empty
 	Email string `json:"Email"`
 
+	// Labels of the created user, by name, returned by the server in the response body. Just for testing map support in the generator.
+	//
+	// Optional
+	//
+	Labels map[string]string `json:"Labels,omitempty"`
+
+	// Rounds of notifications of the created user, returned by the server in the response body. Just for testing nested arrays of oneOf schemas in the generator.
+	//
+	// Optional
+	//
+	NotificationRounds [][]Notification `json:"NotificationRounds,omitempty"`
+
+	// Further notifications of the created user, returned by the server in the response body.
+	//
+	// Optional
+	//
+	Notifications []Notification `json:"Notifications,omitempty"`
+
 	// An optional status of the user to be created. Just for testing optional enum support in the generator.
 	//
 	// Optional
@@ -54,6 +164,12 @@ type CreateUserRequestBody struct {
 	//
 	// Must be non-empty
 	UserName string `json:"UserName"`
+
+	// The notification sent to the created user, returned by the server in the response body. Just for testing oneOf support in the generator.
+	//
+	// Optional
+	//
+	WelcomeNotification Notification `json:"WelcomeNotification,omitempty"`
 }
 
 // NewCreateUserRequestBody creates a new instance of CreateUserRequestBody with required fields as parameters
@@ -88,15 +204,63 @@ func (o *CreateUserRequestBody) WithArbitraryData(value map[string]any) *CreateU
 	return o
 }
 
+// WithContacts sets the optional field Contacts and returns the modified CreateUserRequestBody instance
+func (o *CreateUserRequestBody) WithContacts(value map[string]EmailNotification) *CreateUserRequestBody {
+	o.Contacts = value
+	return o
+}
+
+// WithCoordinates sets the optional field Coordinates and returns the modified CreateUserRequestBody instance
+func (o *CreateUserRequestBody) WithCoordinates(value [][]float64) *CreateUserRequestBody {
+	o.Coordinates = value
+	return o
+}
+
+// WithLabels sets the optional field Labels and returns the modified CreateUserRequestBody instance
+func (o *CreateUserRequestBody) WithLabels(value map[string]string) *CreateUserRequestBody {
+	o.Labels = value
+	return o
+}
+
+// WithNotificationRounds sets the optional field NotificationRounds and returns the modified CreateUserRequestBody instance
+func (o *CreateUserRequestBody) WithNotificationRounds(value [][]Notification) *CreateUserRequestBody {
+	o.NotificationRounds = value
+	return o
+}
+
+// WithNotifications sets the optional field Notifications and returns the modified CreateUserRequestBody instance
+func (o *CreateUserRequestBody) WithNotifications(value []Notification) *CreateUserRequestBody {
+	o.Notifications = value
+	return o
+}
+
 // WithOptionalStatus sets the optional field OptionalStatus and returns the modified CreateUserRequestBody instance
 func (o *CreateUserRequestBody) WithOptionalStatus(value UserStatus) *CreateUserRequestBody {
 	o.OptionalStatus = &value
 	return o
 }
 
+// WithWelcomeNotification sets the optional field WelcomeNotification and returns the modified CreateUserRequestBody instance
+func (o *CreateUserRequestBody) WithWelcomeNotification(value Notification) *CreateUserRequestBody {
+	o.WelcomeNotification = value
+	return o
+}
+
+// ParseCreateUserRequestBody creates a new instance of CreateUserRequestBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
 func ParseCreateUserRequestBody(data map[string]any) (*CreateUserRequestBody, error) {
+	verr := &ValidationError{}
+	body := parseCreateUserRequestBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseCreateUserRequestBody parses data into a new CreateUserRequestBody, recording issues in verr with paths relative to path.
+func parseCreateUserRequestBody(data map[string]any, path string, verr *ValidationError) *CreateUserRequestBody {
 	body := new(CreateUserRequestBody)
 
+	pathAge := joinValidationPath(path, "Age")
+
 	valAge, ok := data["Age"]
 	if !ok {
 
@@ -104,122 +268,408 @@ func ParseCreateUserRequestBody(data map[string]any) (*CreateUserRequestBody, er
 
 	} else {
 
-		var valAgeTyped int64
 		// JSON numbers are float64 by default, so we need to handle that case
 		switch v := valAge.(type) {
 		case float64:
-			valAgeTyped = int64(v)
+			valAgeTyped := int64(v)
+			body.Age = &valAgeTyped
 		case int64:
-			valAgeTyped = v
+			body.Age = &v
 		default:
-			return body, fmt.Errorf("field 'Age' has incorrect type")
+			verr.add(ValidationLocationBody, pathAge, ValidationCodeInvalidType, "must be an integer")
 		}
 
-		body.Age = &valAgeTyped
-
 	}
 
+	pathArbitraryData := joinValidationPath(path, "ArbitraryData")
+
 	valArbitraryData, ok := data["ArbitraryData"]
 	if !ok {
 
 		// skip, leave as zero value
 
+	} else if valArbitraryDataTyped, ok := valArbitraryData.(map[string]any); !ok {
+		verr.add(ValidationLocationBody, pathArbitraryData, ValidationCodeInvalidType, "must be an object")
+
+	} else {
+		body.ArbitraryData = &valArbitraryDataTyped
+	}
+
+	pathContacts := joinValidationPath(path, "Contacts")
+
+	valContacts, ok := data["Contacts"]
+	if !ok {
+
+		// skip, leave as zero value
+
 	} else {
-		valArbitraryDataTyped, ok := valArbitraryData.(map[string]any)
+
+		valContactsMap, ok := valContacts.(map[string]any)
 		if !ok {
-			return body, fmt.Errorf("field 'ArbitraryData' has incorrect type")
+			verr.add(ValidationLocationBody, pathContacts, ValidationCodeInvalidType, "must be an object")
+
+		} else {
+			valContactsTyped := make(map[string]EmailNotification, len(valContactsMap))
+			numIssues := len(verr.Issues)
+			// The keys are sorted, so that the issues are recorded in a stable order
+			for _, key := range slices.Sorted(maps.Keys(valContactsMap)) {
+				item := valContactsMap[key]
+				itemPath := joinValidationPath(pathContacts, key)
+
+				itemMap, ok := item.(map[string]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an object")
+					continue
+				}
+				itemTyped := *parseEmailNotification(itemMap, itemPath, verr)
+
+				valContactsTyped[key] = itemTyped
+			}
+			if len(verr.Issues) == numIssues {
+				body.Contacts = valContactsTyped
+			}
 		}
 
-		body.ArbitraryData = &valArbitraryDataTyped
 	}
 
-	valEmail, ok := data["Email"]
+	pathCoordinates := joinValidationPath(path, "Coordinates")
+
+	valCoordinates, ok := data["Coordinates"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'Email'")
+		// skip, leave as zero value
 
 	} else {
 
-		valEmailTyped, ok := valEmail.(string)
+		valCoordinatesSlice, ok := valCoordinates.([]any)
 		if !ok {
-			return body, fmt.Errorf("field 'Email' has incorrect type")
+			verr.add(ValidationLocationBody, pathCoordinates, ValidationCodeInvalidType, "must be an array")
+
+		} else {
+			valCoordinatesTyped := make([][]float64, 0, len(valCoordinatesSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valCoordinatesSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathCoordinates, idx)
+
+				itemSlice1, ok := item.([]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an array")
+					continue
+				}
+				items1 := make([]float64, 0, len(itemSlice1))
+				for idx, item := range itemSlice1 {
+					itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+
+					itemTyped, ok := item.(float64)
+					if !ok {
+						verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be of type float64")
+						continue
+					}
+
+					items1 = append(items1, itemTyped)
+				}
+				itemTyped := items1
+
+				valCoordinatesTyped = append(valCoordinatesTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.Coordinates = valCoordinatesTyped
+			}
 		}
 
-		valEmailTyped = strings.TrimSpace(valEmailTyped)
+	}
 
-		valEmailTyped = strings.TrimSpace(valEmailTyped)
-		if len(valEmailTyped) == 0 {
-			return body, fmt.Errorf("field 'Email' must be non-empty")
-		}
+	pathEmail := joinValidationPath(path, "Email")
+
+	valEmail, ok := data["Email"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathEmail, ValidationCodeRequired, "missing required field")
+
+	} else {
 
-		body.Email = valEmailTyped
+		if valEmailTyped, ok := valEmail.(string); !ok {
+			verr.add(ValidationLocationBody, pathEmail, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valEmailTyped = strings.TrimSpace(valEmailTyped)
+
+			if len(valEmailTyped) == 0 {
+				verr.add(ValidationLocationBody, pathEmail, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Email = valEmailTyped
+		}
 
 	}
 
-	valOptionalStatus, ok := data["OptionalStatus"]
+	pathLabels := joinValidationPath(path, "Labels")
+
+	valLabels, ok := data["Labels"]
 	if !ok {
 
 		// skip, leave as zero value
 
 	} else {
 
-		valOptionalStatusStr, ok := valOptionalStatus.(string)
+		valLabelsMap, ok := valLabels.(map[string]any)
 		if !ok {
-			return body, fmt.Errorf("field 'OptionalStatus' has incorrect type")
-		}
-		valOptionalStatusTyped, err := ParseUserStatus(valOptionalStatusStr)
-		if err != nil {
-			return body, fmt.Errorf("field 'OptionalStatus' is invalid: %w", err)
+			verr.add(ValidationLocationBody, pathLabels, ValidationCodeInvalidType, "must be an object")
+
+		} else {
+			valLabelsTyped := make(map[string]string, len(valLabelsMap))
+			numIssues := len(verr.Issues)
+			// The keys are sorted, so that the issues are recorded in a stable order
+			for _, key := range slices.Sorted(maps.Keys(valLabelsMap)) {
+				item := valLabelsMap[key]
+				itemPath := joinValidationPath(pathLabels, key)
+
+				itemTyped, ok := item.(string)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be a string")
+					continue
+				}
+				itemTyped = strings.TrimSpace(itemTyped)
+
+				valLabelsTyped[key] = itemTyped
+			}
+			if len(verr.Issues) == numIssues {
+				body.Labels = valLabelsTyped
+			}
 		}
 
-		body.OptionalStatus = valOptionalStatusTyped
+	}
+
+	pathNotificationRounds := joinValidationPath(path, "NotificationRounds")
+
+	valNotificationRounds, ok := data["NotificationRounds"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		valNotificationRoundsSlice, ok := valNotificationRounds.([]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathNotificationRounds, ValidationCodeInvalidType, "must be an array")
+
+		} else {
+			valNotificationRoundsTyped := make([][]Notification, 0, len(valNotificationRoundsSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valNotificationRoundsSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathNotificationRounds, idx)
+
+				itemSlice1, ok := item.([]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an array")
+					continue
+				}
+				items1 := make([]Notification, 0, len(itemSlice1))
+				for idx, item := range itemSlice1 {
+					itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+
+					itemMap, ok := item.(map[string]any)
+					if !ok {
+						verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an object")
+						continue
+					}
+					itemTyped := parseNotification(itemMap, itemPath, verr)
+
+					items1 = append(items1, itemTyped)
+				}
+				itemTyped := items1
+
+				valNotificationRoundsTyped = append(valNotificationRoundsTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.NotificationRounds = valNotificationRoundsTyped
+			}
+		}
 
 	}
 
-	valStatus, ok := data["Status"]
+	pathNotifications := joinValidationPath(path, "Notifications")
+
+	valNotifications, ok := data["Notifications"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'Status'")
+		// skip, leave as zero value
 
 	} else {
 
-		valStatusStr, ok := valStatus.(string)
+		valNotificationsSlice, ok := valNotifications.([]any)
 		if !ok {
-			return body, fmt.Errorf("field 'Status' has incorrect type")
+			verr.add(ValidationLocationBody, pathNotifications, ValidationCodeInvalidType, "must be an array")
+
+		} else {
+			valNotificationsTyped := make([]Notification, 0, len(valNotificationsSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valNotificationsSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathNotifications, idx)
+
+				itemMap, ok := item.(map[string]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an object")
+					continue
+				}
+				itemTyped := parseNotification(itemMap, itemPath, verr)
+
+				valNotificationsTyped = append(valNotificationsTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.Notifications = valNotificationsTyped
+			}
 		}
-		valStatusTyped, err := ParseUserStatus(valStatusStr)
-		if err != nil {
-			return body, fmt.Errorf("field 'Status' is invalid: %w", err)
+
+	}
+
+	pathOptionalStatus := joinValidationPath(path, "OptionalStatus")
+
+	valOptionalStatus, ok := data["OptionalStatus"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valOptionalStatusStr, ok := valOptionalStatus.(string); !ok {
+			verr.add(ValidationLocationBody, pathOptionalStatus, ValidationCodeInvalidType, "must be a string")
+		} else if valOptionalStatusTyped, err := ParseUserStatus(valOptionalStatusStr); err != nil {
+			verr.add(ValidationLocationBody, pathOptionalStatus, ValidationCodeInvalidValue, err.Error())
+		} else {
+			body.OptionalStatus = valOptionalStatusTyped
 		}
 
-		body.Status = *valStatusTyped
+	}
+
+	pathStatus := joinValidationPath(path, "Status")
+
+	valStatus, ok := data["Status"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathStatus, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valStatusStr, ok := valStatus.(string); !ok {
+			verr.add(ValidationLocationBody, pathStatus, ValidationCodeInvalidType, "must be a string")
+		} else if valStatusTyped, err := ParseUserStatus(valStatusStr); err != nil {
+			verr.add(ValidationLocationBody, pathStatus, ValidationCodeInvalidValue, err.Error())
+		} else {
+			body.Status = *valStatusTyped
+		}
 
 	}
 
+	pathUserName := joinValidationPath(path, "UserName")
+
 	valUserName, ok := data["UserName"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'UserName'")
+		verr.add(ValidationLocationBody, pathUserName, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valUserNameTyped, ok := valUserName.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'UserName' has incorrect type")
-		}
+		if valUserNameTyped, ok := valUserName.(string); !ok {
+			verr.add(ValidationLocationBody, pathUserName, ValidationCodeInvalidType, "must be of type string")
+		} else {
 
-		valUserNameTyped = strings.TrimSpace(valUserNameTyped)
+			valUserNameTyped = strings.TrimSpace(valUserNameTyped)
+
+			if len(valUserNameTyped) == 0 {
+				verr.add(ValidationLocationBody, pathUserName, ValidationCodeNonEmpty, "must be non-empty")
+			}
 
-		valUserNameTyped = strings.TrimSpace(valUserNameTyped)
-		if len(valUserNameTyped) == 0 {
-			return body, fmt.Errorf("field 'UserName' must be non-empty")
+			body.UserName = valUserNameTyped
 		}
 
-		body.UserName = valUserNameTyped
+	}
+
+	pathWelcomeNotification := joinValidationPath(path, "WelcomeNotification")
+
+	valWelcomeNotification, ok := data["WelcomeNotification"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valWelcomeNotificationMap, ok := valWelcomeNotification.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, pathWelcomeNotification, ValidationCodeInvalidType, "must be an object")
+		} else {
+			body.WelcomeNotification = parseNotification(valWelcomeNotificationMap, pathWelcomeNotification, verr)
+		}
 
 	}
 
-	return body, nil
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into CreateUserRequestBody, decoding each oneOf field as the variant named by its discriminator.
+func (t *CreateUserRequestBody) UnmarshalJSON(data []byte) error {
+	// The fields are declared again, rather than with the type of t, whose UnmarshalJSON method would be called
+	var raw struct {
+		Age                 *int64                       `json:"Age,omitempty"`
+		ArbitraryData       *map[string]any              `json:"ArbitraryData,omitempty"`
+		Contacts            map[string]EmailNotification `json:"Contacts,omitempty"`
+		Coordinates         [][]float64                  `json:"Coordinates,omitempty"`
+		Email               string                       `json:"Email"`
+		Labels              map[string]string            `json:"Labels,omitempty"`
+		NotificationRounds  [][]json.RawMessage          `json:"NotificationRounds"`
+		Notifications       []json.RawMessage            `json:"Notifications"`
+		OptionalStatus      *UserStatus                  `json:"OptionalStatus,omitempty"`
+		Status              UserStatus                   `json:"Status"`
+		UserName            string                       `json:"UserName"`
+		WelcomeNotification json.RawMessage              `json:"WelcomeNotification"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	t.Age = raw.Age
+	t.ArbitraryData = raw.ArbitraryData
+	t.Contacts = raw.Contacts
+	t.Coordinates = raw.Coordinates
+	t.Email = raw.Email
+	t.Labels = raw.Labels
+	if raw.NotificationRounds != nil {
+		t.NotificationRounds = make([][]Notification, 0, len(raw.NotificationRounds))
+		for idx, rawItem := range raw.NotificationRounds {
+			itemPath := fmt.Sprintf("CreateUserRequestBody.NotificationRounds[%d]", idx)
+			items1 := make([]Notification, 0, len(rawItem))
+			for idx, rawItem := range rawItem {
+				itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+				item, err := unmarshalNotification(rawItem)
+				if err != nil {
+					return fmt.Errorf("%s: %w", itemPath, err)
+				}
+				items1 = append(items1, item)
+			}
+			item := items1
+			t.NotificationRounds = append(t.NotificationRounds, item)
+		}
+	}
+	if raw.Notifications != nil {
+		t.Notifications = make([]Notification, 0, len(raw.Notifications))
+		for idx, rawItem := range raw.Notifications {
+			itemPath := fmt.Sprintf("CreateUserRequestBody.Notifications[%d]", idx)
+			item, err := unmarshalNotification(rawItem)
+			if err != nil {
+				return fmt.Errorf("%s: %w", itemPath, err)
+			}
+			t.Notifications = append(t.Notifications, item)
+		}
+	}
+	t.OptionalStatus = raw.OptionalStatus
+	t.Status = raw.Status
+	t.UserName = raw.UserName
+	if len(raw.WelcomeNotification) > 0 && string(raw.WelcomeNotification) != "null" {
+		value, err := unmarshalNotification(raw.WelcomeNotification)
+		if err != nil {
+			return fmt.Errorf("CreateUserRequestBody.WelcomeNotification: %w", err)
+		}
+		t.WelcomeNotification = value
+	}
+	return nil
 }
 
 type CreateUserResponseBody struct {
@@ -230,6 +680,36 @@ type CreateUserResponseBody struct {
 	//
 	ArbitraryData *map[string]any `json:"ArbitraryData,omitempty"`
 
+	// The email contacts of the request, if any.
+	//
+	// Optional
+	//
+	Contacts map[string]EmailNotification `json:"Contacts,omitempty"`
+
+	// The pairs of latitude and longitude of the request, if any.
+	//
+	// Optional
+	//
+	Coordinates [][]float64 `json:"Coordinates,omitempty"`
+
+	// The labels of the request, if any.
+	//
+	// Optional
+	//
+	Labels map[string]string `json:"Labels,omitempty"`
+
+	// The rounds of notifications of the request, if any.
+	//
+	// Optional
+	//
+	NotificationRounds [][]Notification `json:"NotificationRounds,omitempty"`
+
+	// The notifications of the request, if any.
+	//
+	// Optional
+	//
+	Notifications []Notification `json:"Notifications,omitempty"`
+
 	// An optional status of the user. Just for testing optional enum support in the generator.
 	//
 	// Optional
@@ -247,6 +727,12 @@ type CreateUserResponseBody struct {
 	// Required
 	//
 	User *User `json:"User"`
+
+	// The welcome notification of the request, if any.
+	//
+	// Optional
+	//
+	WelcomeNotification Notification `json:"WelcomeNotification,omitempty"`
 }
 
 // NewCreateUserResponseBody creates a new instance of CreateUserResponseBody with required fields as parameters
@@ -271,171 +757,1142 @@ func (o *CreateUserResponseBody) WithArbitraryData(value map[string]any) *Create
 	return o
 }
 
+// WithContacts sets the optional field Contacts and returns the modified CreateUserResponseBody instance
+func (o *CreateUserResponseBody) WithContacts(value map[string]EmailNotification) *CreateUserResponseBody {
+	o.Contacts = value
+	return o
+}
+
+// WithCoordinates sets the optional field Coordinates and returns the modified CreateUserResponseBody instance
+func (o *CreateUserResponseBody) WithCoordinates(value [][]float64) *CreateUserResponseBody {
+	o.Coordinates = value
+	return o
+}
+
+// WithLabels sets the optional field Labels and returns the modified CreateUserResponseBody instance
+func (o *CreateUserResponseBody) WithLabels(value map[string]string) *CreateUserResponseBody {
+	o.Labels = value
+	return o
+}
+
+// WithNotificationRounds sets the optional field NotificationRounds and returns the modified CreateUserResponseBody instance
+func (o *CreateUserResponseBody) WithNotificationRounds(value [][]Notification) *CreateUserResponseBody {
+	o.NotificationRounds = value
+	return o
+}
+
+// WithNotifications sets the optional field Notifications and returns the modified CreateUserResponseBody instance
+func (o *CreateUserResponseBody) WithNotifications(value []Notification) *CreateUserResponseBody {
+	o.Notifications = value
+	return o
+}
+
 // WithOptionalStatus sets the optional field OptionalStatus and returns the modified CreateUserResponseBody instance
 func (o *CreateUserResponseBody) WithOptionalStatus(value UserStatus) *CreateUserResponseBody {
 	o.OptionalStatus = &value
 	return o
 }
 
+// WithWelcomeNotification sets the optional field WelcomeNotification and returns the modified CreateUserResponseBody instance
+func (o *CreateUserResponseBody) WithWelcomeNotification(value Notification) *CreateUserResponseBody {
+	o.WelcomeNotification = value
+	return o
+}
+
+// ParseCreateUserResponseBody creates a new instance of CreateUserResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
 func ParseCreateUserResponseBody(data map[string]any) (*CreateUserResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseCreateUserResponseBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseCreateUserResponseBody parses data into a new CreateUserResponseBody, recording issues in verr with paths relative to path.
+func parseCreateUserResponseBody(data map[string]any, path string, verr *ValidationError) *CreateUserResponseBody {
 	body := new(CreateUserResponseBody)
 
+	pathArbitraryData := joinValidationPath(path, "ArbitraryData")
+
 	valArbitraryData, ok := data["ArbitraryData"]
 	if !ok {
 
 		// skip, leave as zero value
 
-	} else {
-		valArbitraryDataTyped, ok := valArbitraryData.(map[string]any)
-		if !ok {
-			return body, fmt.Errorf("field 'ArbitraryData' has incorrect type")
-		}
+	} else if valArbitraryDataTyped, ok := valArbitraryData.(map[string]any); !ok {
+		verr.add(ValidationLocationBody, pathArbitraryData, ValidationCodeInvalidType, "must be an object")
 
+	} else {
 		body.ArbitraryData = &valArbitraryDataTyped
 	}
 
-	valOptionalStatus, ok := data["OptionalStatus"]
+	pathContacts := joinValidationPath(path, "Contacts")
+
+	valContacts, ok := data["Contacts"]
 	if !ok {
 
 		// skip, leave as zero value
 
 	} else {
 
-		valOptionalStatusStr, ok := valOptionalStatus.(string)
+		valContactsMap, ok := valContacts.(map[string]any)
 		if !ok {
-			return body, fmt.Errorf("field 'OptionalStatus' has incorrect type")
-		}
-		valOptionalStatusTyped, err := ParseUserStatus(valOptionalStatusStr)
-		if err != nil {
-			return body, fmt.Errorf("field 'OptionalStatus' is invalid: %w", err)
+			verr.add(ValidationLocationBody, pathContacts, ValidationCodeInvalidType, "must be an object")
+
+		} else {
+			valContactsTyped := make(map[string]EmailNotification, len(valContactsMap))
+			numIssues := len(verr.Issues)
+			// The keys are sorted, so that the issues are recorded in a stable order
+			for _, key := range slices.Sorted(maps.Keys(valContactsMap)) {
+				item := valContactsMap[key]
+				itemPath := joinValidationPath(pathContacts, key)
+
+				itemMap, ok := item.(map[string]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an object")
+					continue
+				}
+				itemTyped := *parseEmailNotification(itemMap, itemPath, verr)
+
+				valContactsTyped[key] = itemTyped
+			}
+			if len(verr.Issues) == numIssues {
+				body.Contacts = valContactsTyped
+			}
 		}
 
-		body.OptionalStatus = valOptionalStatusTyped
-
 	}
 
-	valStatus, ok := data["Status"]
+	pathCoordinates := joinValidationPath(path, "Coordinates")
+
+	valCoordinates, ok := data["Coordinates"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'Status'")
+		// skip, leave as zero value
 
 	} else {
 
-		valStatusStr, ok := valStatus.(string)
+		valCoordinatesSlice, ok := valCoordinates.([]any)
 		if !ok {
-			return body, fmt.Errorf("field 'Status' has incorrect type")
-		}
-		valStatusTyped, err := ParseUserStatus(valStatusStr)
-		if err != nil {
-			return body, fmt.Errorf("field 'Status' is invalid: %w", err)
+			verr.add(ValidationLocationBody, pathCoordinates, ValidationCodeInvalidType, "must be an array")
+
+		} else {
+			valCoordinatesTyped := make([][]float64, 0, len(valCoordinatesSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valCoordinatesSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathCoordinates, idx)
+
+				itemSlice1, ok := item.([]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an array")
+					continue
+				}
+				items1 := make([]float64, 0, len(itemSlice1))
+				for idx, item := range itemSlice1 {
+					itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+
+					itemTyped, ok := item.(float64)
+					if !ok {
+						verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be of type float64")
+						continue
+					}
+
+					items1 = append(items1, itemTyped)
+				}
+				itemTyped := items1
+
+				valCoordinatesTyped = append(valCoordinatesTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.Coordinates = valCoordinatesTyped
+			}
 		}
 
-		body.Status = *valStatusTyped
-
 	}
 
+	pathLabels := joinValidationPath(path, "Labels")
+
+	valLabels, ok := data["Labels"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		valLabelsMap, ok := valLabels.(map[string]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathLabels, ValidationCodeInvalidType, "must be an object")
+
+		} else {
+			valLabelsTyped := make(map[string]string, len(valLabelsMap))
+			numIssues := len(verr.Issues)
+			// The keys are sorted, so that the issues are recorded in a stable order
+			for _, key := range slices.Sorted(maps.Keys(valLabelsMap)) {
+				item := valLabelsMap[key]
+				itemPath := joinValidationPath(pathLabels, key)
+
+				itemTyped, ok := item.(string)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be a string")
+					continue
+				}
+				itemTyped = strings.TrimSpace(itemTyped)
+
+				valLabelsTyped[key] = itemTyped
+			}
+			if len(verr.Issues) == numIssues {
+				body.Labels = valLabelsTyped
+			}
+		}
+
+	}
+
+	pathNotificationRounds := joinValidationPath(path, "NotificationRounds")
+
+	valNotificationRounds, ok := data["NotificationRounds"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		valNotificationRoundsSlice, ok := valNotificationRounds.([]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathNotificationRounds, ValidationCodeInvalidType, "must be an array")
+
+		} else {
+			valNotificationRoundsTyped := make([][]Notification, 0, len(valNotificationRoundsSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valNotificationRoundsSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathNotificationRounds, idx)
+
+				itemSlice1, ok := item.([]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an array")
+					continue
+				}
+				items1 := make([]Notification, 0, len(itemSlice1))
+				for idx, item := range itemSlice1 {
+					itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+
+					itemMap, ok := item.(map[string]any)
+					if !ok {
+						verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an object")
+						continue
+					}
+					itemTyped := parseNotification(itemMap, itemPath, verr)
+
+					items1 = append(items1, itemTyped)
+				}
+				itemTyped := items1
+
+				valNotificationRoundsTyped = append(valNotificationRoundsTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.NotificationRounds = valNotificationRoundsTyped
+			}
+		}
+
+	}
+
+	pathNotifications := joinValidationPath(path, "Notifications")
+
+	valNotifications, ok := data["Notifications"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		valNotificationsSlice, ok := valNotifications.([]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathNotifications, ValidationCodeInvalidType, "must be an array")
+
+		} else {
+			valNotificationsTyped := make([]Notification, 0, len(valNotificationsSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valNotificationsSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathNotifications, idx)
+
+				itemMap, ok := item.(map[string]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an object")
+					continue
+				}
+				itemTyped := parseNotification(itemMap, itemPath, verr)
+
+				valNotificationsTyped = append(valNotificationsTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.Notifications = valNotificationsTyped
+			}
+		}
+
+	}
+
+	pathOptionalStatus := joinValidationPath(path, "OptionalStatus")
+
+	valOptionalStatus, ok := data["OptionalStatus"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valOptionalStatusStr, ok := valOptionalStatus.(string); !ok {
+			verr.add(ValidationLocationBody, pathOptionalStatus, ValidationCodeInvalidType, "must be a string")
+		} else if valOptionalStatusTyped, err := ParseUserStatus(valOptionalStatusStr); err != nil {
+			verr.add(ValidationLocationBody, pathOptionalStatus, ValidationCodeInvalidValue, err.Error())
+		} else {
+			body.OptionalStatus = valOptionalStatusTyped
+		}
+
+	}
+
+	pathStatus := joinValidationPath(path, "Status")
+
+	valStatus, ok := data["Status"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathStatus, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valStatusStr, ok := valStatus.(string); !ok {
+			verr.add(ValidationLocationBody, pathStatus, ValidationCodeInvalidType, "must be a string")
+		} else if valStatusTyped, err := ParseUserStatus(valStatusStr); err != nil {
+			verr.add(ValidationLocationBody, pathStatus, ValidationCodeInvalidValue, err.Error())
+		} else {
+			body.Status = *valStatusTyped
+		}
+
+	}
+
+	pathUser := joinValidationPath(path, "User")
+
 	valUser, ok := data["User"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'User'")
+		verr.add(ValidationLocationBody, pathUser, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valUserMap, ok := valUser.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, pathUser, ValidationCodeInvalidType, "must be an object")
+		} else {
+			body.User = parseUser(valUserMap, pathUser, verr)
+		}
+
+	}
+
+	pathWelcomeNotification := joinValidationPath(path, "WelcomeNotification")
+
+	valWelcomeNotification, ok := data["WelcomeNotification"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valWelcomeNotificationMap, ok := valWelcomeNotification.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, pathWelcomeNotification, ValidationCodeInvalidType, "must be an object")
+		} else {
+			body.WelcomeNotification = parseNotification(valWelcomeNotificationMap, pathWelcomeNotification, verr)
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into CreateUserResponseBody, decoding each oneOf field as the variant named by its discriminator.
+func (t *CreateUserResponseBody) UnmarshalJSON(data []byte) error {
+	// The fields are declared again, rather than with the type of t, whose UnmarshalJSON method would be called
+	var raw struct {
+		ArbitraryData       *map[string]any              `json:"ArbitraryData,omitempty"`
+		Contacts            map[string]EmailNotification `json:"Contacts,omitempty"`
+		Coordinates         [][]float64                  `json:"Coordinates,omitempty"`
+		Labels              map[string]string            `json:"Labels,omitempty"`
+		NotificationRounds  [][]json.RawMessage          `json:"NotificationRounds"`
+		Notifications       []json.RawMessage            `json:"Notifications"`
+		OptionalStatus      *UserStatus                  `json:"OptionalStatus,omitempty"`
+		Status              UserStatus                   `json:"Status"`
+		User                *User                        `json:"User"`
+		WelcomeNotification json.RawMessage              `json:"WelcomeNotification"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	t.ArbitraryData = raw.ArbitraryData
+	t.Contacts = raw.Contacts
+	t.Coordinates = raw.Coordinates
+	t.Labels = raw.Labels
+	if raw.NotificationRounds != nil {
+		t.NotificationRounds = make([][]Notification, 0, len(raw.NotificationRounds))
+		for idx, rawItem := range raw.NotificationRounds {
+			itemPath := fmt.Sprintf("CreateUserResponseBody.NotificationRounds[%d]", idx)
+			items1 := make([]Notification, 0, len(rawItem))
+			for idx, rawItem := range rawItem {
+				itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+				item, err := unmarshalNotification(rawItem)
+				if err != nil {
+					return fmt.Errorf("%s: %w", itemPath, err)
+				}
+				items1 = append(items1, item)
+			}
+			item := items1
+			t.NotificationRounds = append(t.NotificationRounds, item)
+		}
+	}
+	if raw.Notifications != nil {
+		t.Notifications = make([]Notification, 0, len(raw.Notifications))
+		for idx, rawItem := range raw.Notifications {
+			itemPath := fmt.Sprintf("CreateUserResponseBody.Notifications[%d]", idx)
+			item, err := unmarshalNotification(rawItem)
+			if err != nil {
+				return fmt.Errorf("%s: %w", itemPath, err)
+			}
+			t.Notifications = append(t.Notifications, item)
+		}
+	}
+	t.OptionalStatus = raw.OptionalStatus
+	t.Status = raw.Status
+	t.User = raw.User
+	if len(raw.WelcomeNotification) > 0 && string(raw.WelcomeNotification) != "null" {
+		value, err := unmarshalNotification(raw.WelcomeNotification)
+		if err != nil {
+			return fmt.Errorf("CreateUserResponseBody.WelcomeNotification: %w", err)
+		}
+		t.WelcomeNotification = value
+	}
+	return nil
+}
+
+type DeleteUserResponseBody struct {
+
+	// The auth alternative the request was authorized with, e.g. "adminTokenAuth".
+	//
+	// Required
+	//
+	// Must be non-empty
+	AuthAlternative string `json:"AuthAlternative"`
+
+	// The unique identifier of the deleted user.
+	//
+	// Required
+	//
+	// Must be non-empty
+	UserId string `json:"UserId"`
+}
+
+// NewDeleteUserResponseBody creates a new instance of DeleteUserResponseBody with required fields as parameters
+func NewDeleteUserResponseBody(
+
+	AuthAlternative string,
+
+	UserId string,
+
+) *DeleteUserResponseBody {
+	return &DeleteUserResponseBody{
+
+		AuthAlternative: AuthAlternative,
+
+		UserId: UserId,
+	}
+}
+
+// ParseDeleteUserResponseBody creates a new instance of DeleteUserResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseDeleteUserResponseBody(data map[string]any) (*DeleteUserResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseDeleteUserResponseBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseDeleteUserResponseBody parses data into a new DeleteUserResponseBody, recording issues in verr with paths relative to path.
+func parseDeleteUserResponseBody(data map[string]any, path string, verr *ValidationError) *DeleteUserResponseBody {
+	body := new(DeleteUserResponseBody)
+
+	pathAuthAlternative := joinValidationPath(path, "AuthAlternative")
+
+	valAuthAlternative, ok := data["AuthAlternative"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathAuthAlternative, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valAuthAlternativeTyped, ok := valAuthAlternative.(string); !ok {
+			verr.add(ValidationLocationBody, pathAuthAlternative, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valAuthAlternativeTyped = strings.TrimSpace(valAuthAlternativeTyped)
+
+			if len(valAuthAlternativeTyped) == 0 {
+				verr.add(ValidationLocationBody, pathAuthAlternative, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.AuthAlternative = valAuthAlternativeTyped
+		}
+
+	}
+
+	pathUserId := joinValidationPath(path, "UserId")
+
+	valUserId, ok := data["UserId"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathUserId, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valUserIdTyped, ok := valUserId.(string); !ok {
+			verr.add(ValidationLocationBody, pathUserId, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valUserIdTyped = strings.TrimSpace(valUserIdTyped)
+
+			if len(valUserIdTyped) == 0 {
+				verr.add(ValidationLocationBody, pathUserId, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.UserId = valUserIdTyped
+		}
+
+	}
+
+	return body
+}
+
+type EmailNotification struct {
+
+	// The email address the notification is sent to.
+	//
+	// Required
+	//
+	// Must be non-empty
+	Address string `json:"Address"`
+
+	// The subject of the email.
+	//
+	// Optional
+	//
+	Subject *string `json:"Subject,omitempty"`
+}
+
+// NewEmailNotification creates a new instance of EmailNotification with required fields as parameters
+func NewEmailNotification(
+
+	Address string,
+
+) *EmailNotification {
+	return &EmailNotification{
+
+		Address: Address,
+	}
+}
+
+// WithSubject sets the optional field Subject and returns the modified EmailNotification instance
+func (o *EmailNotification) WithSubject(value string) *EmailNotification {
+	o.Subject = &value
+	return o
+}
+
+// ParseEmailNotification creates a new instance of EmailNotification from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseEmailNotification(data map[string]any) (*EmailNotification, error) {
+	verr := &ValidationError{}
+	body := parseEmailNotification(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseEmailNotification parses data into a new EmailNotification, recording issues in verr with paths relative to path.
+func parseEmailNotification(data map[string]any, path string, verr *ValidationError) *EmailNotification {
+	body := new(EmailNotification)
+
+	pathAddress := joinValidationPath(path, "Address")
+
+	valAddress, ok := data["Address"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathAddress, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valAddressTyped, ok := valAddress.(string); !ok {
+			verr.add(ValidationLocationBody, pathAddress, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valAddressTyped = strings.TrimSpace(valAddressTyped)
+
+			if len(valAddressTyped) == 0 {
+				verr.add(ValidationLocationBody, pathAddress, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Address = valAddressTyped
+		}
+
+	}
+
+	pathSubject := joinValidationPath(path, "Subject")
+
+	valSubject, ok := data["Subject"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valSubjectTyped, ok := valSubject.(string); !ok {
+			verr.add(ValidationLocationBody, pathSubject, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valSubjectTyped = strings.TrimSpace(valSubjectTyped)
+
+			body.Subject = &valSubjectTyped
+		}
+
+	}
+
+	return body
+}
+
+type Entity struct {
+
+	// When the entity was created, in RFC 3339 format.
+	//
+	// Required
+	//
+	// Must be non-empty
+	CreatedAt string `json:"CreatedAt"`
+}
+
+// NewEntity creates a new instance of Entity with required fields as parameters
+func NewEntity(
+
+	CreatedAt string,
+
+) *Entity {
+	return &Entity{
+
+		CreatedAt: CreatedAt,
+	}
+}
+
+// ParseEntity creates a new instance of Entity from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseEntity(data map[string]any) (*Entity, error) {
+	verr := &ValidationError{}
+	body := parseEntity(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseEntity parses data into a new Entity, recording issues in verr with paths relative to path.
+func parseEntity(data map[string]any, path string, verr *ValidationError) *Entity {
+	body := new(Entity)
+
+	pathCreatedAt := joinValidationPath(path, "CreatedAt")
+
+	valCreatedAt, ok := data["CreatedAt"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathCreatedAt, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valCreatedAtTyped, ok := valCreatedAt.(string); !ok {
+			verr.add(ValidationLocationBody, pathCreatedAt, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valCreatedAtTyped = strings.TrimSpace(valCreatedAtTyped)
+
+			if len(valCreatedAtTyped) == 0 {
+				verr.add(ValidationLocationBody, pathCreatedAt, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.CreatedAt = valCreatedAtTyped
+		}
+
+	}
+
+	return body
+}
+
+type ErrorResponse struct {
+
+	// A detailed debug message for developers. Only passed if in debug mode.
+	//
+	// Optional
+	//
+	DebugMessage *string `json:"DebugMessage,omitempty"`
+
+	// An error message which is user-friendly.
+	//
+	// Required
+	//
+	// Must be non-empty
+	ErrorMessage string `json:"ErrorMessage"`
+}
+
+// NewErrorResponse creates a new instance of ErrorResponse with required fields as parameters
+func NewErrorResponse(
+
+	ErrorMessage string,
+
+) *ErrorResponse {
+	return &ErrorResponse{
+
+		ErrorMessage: ErrorMessage,
+	}
+}
+
+// WithDebugMessage sets the optional field DebugMessage and returns the modified ErrorResponse instance
+func (o *ErrorResponse) WithDebugMessage(value string) *ErrorResponse {
+	o.DebugMessage = &value
+	return o
+}
+
+// ParseErrorResponse creates a new instance of ErrorResponse from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseErrorResponse(data map[string]any) (*ErrorResponse, error) {
+	verr := &ValidationError{}
+	body := parseErrorResponse(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseErrorResponse parses data into a new ErrorResponse, recording issues in verr with paths relative to path.
+func parseErrorResponse(data map[string]any, path string, verr *ValidationError) *ErrorResponse {
+	body := new(ErrorResponse)
+
+	pathDebugMessage := joinValidationPath(path, "DebugMessage")
+
+	valDebugMessage, ok := data["DebugMessage"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valDebugMessageTyped, ok := valDebugMessage.(string); !ok {
+			verr.add(ValidationLocationBody, pathDebugMessage, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valDebugMessageTyped = strings.TrimSpace(valDebugMessageTyped)
+
+			body.DebugMessage = &valDebugMessageTyped
+		}
+
+	}
+
+	pathErrorMessage := joinValidationPath(path, "ErrorMessage")
+
+	valErrorMessage, ok := data["ErrorMessage"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valErrorMessageTyped, ok := valErrorMessage.(string); !ok {
+			verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valErrorMessageTyped = strings.TrimSpace(valErrorMessageTyped)
+
+			if len(valErrorMessageTyped) == 0 {
+				verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.ErrorMessage = valErrorMessageTyped
+		}
+
+	}
+
+	return body
+}
+
+type EventFeed struct {
+
+	// The IDs of the events of the page.
+	//
+	// Required
+	//
+	Events []string `json:"Events"`
+
+	// The cursor of the next page, absent on the last one.
+	//
+	// Optional
+	//
+	NextCursor *string `json:"NextCursor,omitempty"`
+}
+
+// NewEventFeed creates a new instance of EventFeed with required fields as parameters
+func NewEventFeed(
+
+	Events []string,
+
+) *EventFeed {
+	return &EventFeed{
+
+		Events: Events,
+	}
+}
+
+// WithNextCursor sets the optional field NextCursor and returns the modified EventFeed instance
+func (o *EventFeed) WithNextCursor(value string) *EventFeed {
+	o.NextCursor = &value
+	return o
+}
+
+// ParseEventFeed creates a new instance of EventFeed from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseEventFeed(data map[string]any) (*EventFeed, error) {
+	verr := &ValidationError{}
+	body := parseEventFeed(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseEventFeed parses data into a new EventFeed, recording issues in verr with paths relative to path.
+func parseEventFeed(data map[string]any, path string, verr *ValidationError) *EventFeed {
+	body := new(EventFeed)
+
+	pathEvents := joinValidationPath(path, "Events")
+
+	valEvents, ok := data["Events"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathEvents, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		valEventsSlice, ok := valEvents.([]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathEvents, ValidationCodeInvalidType, "must be an array")
+
+		} else if len(valEventsSlice) == 0 {
+			verr.add(ValidationLocationBody, pathEvents, ValidationCodeNonEmpty, "must be non-empty")
+
+		} else {
+			valEventsTyped := make([]string, 0, len(valEventsSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valEventsSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathEvents, idx)
+
+				itemTyped, ok := item.(string)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be a string")
+					continue
+				}
+				itemTyped = strings.TrimSpace(itemTyped)
+
+				if len(itemTyped) == 0 {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeNonEmpty, "must be non-empty")
+					continue
+				}
+
+				valEventsTyped = append(valEventsTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.Events = valEventsTyped
+			}
+		}
+
+	}
+
+	pathNextCursor := joinValidationPath(path, "NextCursor")
+
+	valNextCursor, ok := data["NextCursor"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valNextCursorTyped, ok := valNextCursor.(string); !ok {
+			verr.add(ValidationLocationBody, pathNextCursor, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valNextCursorTyped = strings.TrimSpace(valNextCursorTyped)
+
+			body.NextCursor = &valNextCursorTyped
+		}
+
+	}
+
+	return body
+}
+
+type EventPage struct {
+
+	// The IDs of the events of the page.
+	//
+	// Required
+	//
+	Events []string `json:"Events"`
+
+	// The total number of events.
+	//
+	// Required
+	//
+	TotalCount int64 `json:"TotalCount"`
+}
+
+// NewEventPage creates a new instance of EventPage with required fields as parameters
+func NewEventPage(
+
+	Events []string,
+
+	TotalCount int64,
+
+) *EventPage {
+	return &EventPage{
+
+		Events: Events,
+
+		TotalCount: TotalCount,
+	}
+}
+
+// ParseEventPage creates a new instance of EventPage from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseEventPage(data map[string]any) (*EventPage, error) {
+	verr := &ValidationError{}
+	body := parseEventPage(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseEventPage parses data into a new EventPage, recording issues in verr with paths relative to path.
+func parseEventPage(data map[string]any, path string, verr *ValidationError) *EventPage {
+	body := new(EventPage)
+
+	pathEvents := joinValidationPath(path, "Events")
+
+	valEvents, ok := data["Events"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathEvents, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valUserMap, ok := valUser.(map[string]any)
+		valEventsSlice, ok := valEvents.([]any)
 		if !ok {
-			return body, fmt.Errorf("field 'User' has incorrect type")
+			verr.add(ValidationLocationBody, pathEvents, ValidationCodeInvalidType, "must be an array")
+
+		} else if len(valEventsSlice) == 0 {
+			verr.add(ValidationLocationBody, pathEvents, ValidationCodeNonEmpty, "must be non-empty")
+
+		} else {
+			valEventsTyped := make([]string, 0, len(valEventsSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valEventsSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathEvents, idx)
+
+				itemTyped, ok := item.(string)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be a string")
+					continue
+				}
+				itemTyped = strings.TrimSpace(itemTyped)
+
+				if len(itemTyped) == 0 {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeNonEmpty, "must be non-empty")
+					continue
+				}
+
+				valEventsTyped = append(valEventsTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.Events = valEventsTyped
+			}
 		}
-		valUserTyped, err := ParseUser(valUserMap)
-		if err != nil {
-			return body, fmt.Errorf("field 'User' is invalid: %w", err)
+
+	}
+
+	pathTotalCount := joinValidationPath(path, "TotalCount")
+
+	valTotalCount, ok := data["TotalCount"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		// JSON numbers are float64 by default, so we need to handle that case
+		switch v := valTotalCount.(type) {
+		case float64:
+			valTotalCountTyped := int64(v)
+			body.TotalCount = valTotalCountTyped
+		case int64:
+			body.TotalCount = v
+		default:
+			verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeInvalidType, "must be an integer")
 		}
 
-		body.User = valUserTyped
+	}
+
+	return body
+}
+
+type FlakyResponseBody struct {
+
+	// The number of requests received for the key, including this one.
+	//
+	// Required
+	//
+	Attempts int64 `json:"Attempts"`
+}
+
+// NewFlakyResponseBody creates a new instance of FlakyResponseBody with required fields as parameters
+func NewFlakyResponseBody(
+
+	Attempts int64,
 
+) *FlakyResponseBody {
+	return &FlakyResponseBody{
+
+		Attempts: Attempts,
 	}
+}
 
-	return body, nil
+// ParseFlakyResponseBody creates a new instance of FlakyResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseFlakyResponseBody(data map[string]any) (*FlakyResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseFlakyResponseBody(data, "", verr)
+	return body, verr.errOrNil()
 }
 
-type ErrorResponse struct {
+// parseFlakyResponseBody parses data into a new FlakyResponseBody, recording issues in verr with paths relative to path.
+func parseFlakyResponseBody(data map[string]any, path string, verr *ValidationError) *FlakyResponseBody {
+	body := new(FlakyResponseBody)
 
-	// A detailed debug message for developers. Only passed if in debug mode.
+	pathAttempts := joinValidationPath(path, "Attempts")
+
+	valAttempts, ok := data["Attempts"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathAttempts, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		// JSON numbers are float64 by default, so we need to handle that case
+		switch v := valAttempts.(type) {
+		case float64:
+			valAttemptsTyped := int64(v)
+			body.Attempts = valAttemptsTyped
+		case int64:
+			body.Attempts = v
+		default:
+			verr.add(ValidationLocationBody, pathAttempts, ValidationCodeInvalidType, "must be an integer")
+		}
+
+	}
+
+	return body
+}
+
+type GetSessionResponseBody struct {
+
+	// The name of the authentication method the request was authenticated with.
 	//
-	// Optional
+	// Required
 	//
-	DebugMessage *string `json:"DebugMessage,omitempty"`
+	// Must be non-empty
+	AuthMethod string `json:"AuthMethod"`
 
-	// An error message which is user-friendly.
+	// The credential the request was authenticated with. For basic authentication, "username:password".
 	//
 	// Required
 	//
 	// Must be non-empty
-	ErrorMessage string `json:"ErrorMessage"`
+	Credential string `json:"Credential"`
 }
 
-// NewErrorResponse creates a new instance of ErrorResponse with required fields as parameters
-func NewErrorResponse(
+// NewGetSessionResponseBody creates a new instance of GetSessionResponseBody with required fields as parameters
+func NewGetSessionResponseBody(
 
-	ErrorMessage string,
+	AuthMethod string,
 
-) *ErrorResponse {
-	return &ErrorResponse{
+	Credential string,
 
-		ErrorMessage: ErrorMessage,
+) *GetSessionResponseBody {
+	return &GetSessionResponseBody{
+
+		AuthMethod: AuthMethod,
+
+		Credential: Credential,
 	}
 }
 
-// WithDebugMessage sets the optional field DebugMessage and returns the modified ErrorResponse instance
-func (o *ErrorResponse) WithDebugMessage(value string) *ErrorResponse {
-	o.DebugMessage = &value
-	return o
+// ParseGetSessionResponseBody creates a new instance of GetSessionResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseGetSessionResponseBody(data map[string]any) (*GetSessionResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseGetSessionResponseBody(data, "", verr)
+	return body, verr.errOrNil()
 }
 
-func ParseErrorResponse(data map[string]any) (*ErrorResponse, error) {
-	body := new(ErrorResponse)
+// parseGetSessionResponseBody parses data into a new GetSessionResponseBody, recording issues in verr with paths relative to path.
+func parseGetSessionResponseBody(data map[string]any, path string, verr *ValidationError) *GetSessionResponseBody {
+	body := new(GetSessionResponseBody)
 
-	valDebugMessage, ok := data["DebugMessage"]
+	pathAuthMethod := joinValidationPath(path, "AuthMethod")
+
+	valAuthMethod, ok := data["AuthMethod"]
 	if !ok {
 
-		// skip, leave as zero value
+		verr.add(ValidationLocationBody, pathAuthMethod, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valDebugMessageTyped, ok := valDebugMessage.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'DebugMessage' has incorrect type")
-		}
+		if valAuthMethodTyped, ok := valAuthMethod.(string); !ok {
+			verr.add(ValidationLocationBody, pathAuthMethod, ValidationCodeInvalidType, "must be of type string")
+		} else {
 
-		valDebugMessageTyped = strings.TrimSpace(valDebugMessageTyped)
+			valAuthMethodTyped = strings.TrimSpace(valAuthMethodTyped)
 
-		body.DebugMessage = &valDebugMessageTyped
+			if len(valAuthMethodTyped) == 0 {
+				verr.add(ValidationLocationBody, pathAuthMethod, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.AuthMethod = valAuthMethodTyped
+		}
 
 	}
 
-	valErrorMessage, ok := data["ErrorMessage"]
+	pathCredential := joinValidationPath(path, "Credential")
+
+	valCredential, ok := data["Credential"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'ErrorMessage'")
+		verr.add(ValidationLocationBody, pathCredential, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valErrorMessageTyped, ok := valErrorMessage.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'ErrorMessage' has incorrect type")
-		}
+		if valCredentialTyped, ok := valCredential.(string); !ok {
+			verr.add(ValidationLocationBody, pathCredential, ValidationCodeInvalidType, "must be of type string")
+		} else {
 
-		valErrorMessageTyped = strings.TrimSpace(valErrorMessageTyped)
+			valCredentialTyped = strings.TrimSpace(valCredentialTyped)
 
-		valErrorMessageTyped = strings.TrimSpace(valErrorMessageTyped)
-		if len(valErrorMessageTyped) == 0 {
-			return body, fmt.Errorf("field 'ErrorMessage' must be non-empty")
-		}
+			if len(valCredentialTyped) == 0 {
+				verr.add(ValidationLocationBody, pathCredential, ValidationCodeNonEmpty, "must be non-empty")
+			}
 
-		body.ErrorMessage = valErrorMessageTyped
+			body.Credential = valCredentialTyped
+		}
 
 	}
 
-	return body, nil
+	return body
 }
 
 type HealthCheckResponseBody struct {
@@ -460,33 +1917,44 @@ func NewHealthCheckResponseBody(
 	}
 }
 
+// ParseHealthCheckResponseBody creates a new instance of HealthCheckResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
 func ParseHealthCheckResponseBody(data map[string]any) (*HealthCheckResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseHealthCheckResponseBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseHealthCheckResponseBody parses data into a new HealthCheckResponseBody, recording issues in verr with paths relative to path.
+func parseHealthCheckResponseBody(data map[string]any, path string, verr *ValidationError) *HealthCheckResponseBody {
 	body := new(HealthCheckResponseBody)
 
+	pathStatus := joinValidationPath(path, "Status")
+
 	valStatus, ok := data["Status"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'Status'")
+		verr.add(ValidationLocationBody, pathStatus, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valStatusTyped, ok := valStatus.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'Status' has incorrect type")
-		}
+		if valStatusTyped, ok := valStatus.(string); !ok {
+			verr.add(ValidationLocationBody, pathStatus, ValidationCodeInvalidType, "must be of type string")
+		} else {
 
-		valStatusTyped = strings.TrimSpace(valStatusTyped)
+			valStatusTyped = strings.TrimSpace(valStatusTyped)
 
-		valStatusTyped = strings.TrimSpace(valStatusTyped)
-		if len(valStatusTyped) == 0 {
-			return body, fmt.Errorf("field 'Status' must be non-empty")
-		}
+			if len(valStatusTyped) == 0 {
+				verr.add(ValidationLocationBody, pathStatus, ValidationCodeNonEmpty, "must be non-empty")
+			}
 
-		body.Status = valStatusTyped
+			body.Status = valStatusTyped
+		}
 
 	}
 
-	return body, nil
+	return body
 }
 
 type ListUsersResponseBody struct {
@@ -528,176 +1996,514 @@ func NewListUsersResponseBody(
 ) *ListUsersResponseBody {
 	return &ListUsersResponseBody{
 
-		PageNumber: PageNumber,
+		PageNumber: PageNumber,
+
+		PageSize: PageSize,
+
+		TotalCount: TotalCount,
+
+		Users: Users,
+	}
+}
+
+// ParseListUsersResponseBody creates a new instance of ListUsersResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseListUsersResponseBody(data map[string]any) (*ListUsersResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseListUsersResponseBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseListUsersResponseBody parses data into a new ListUsersResponseBody, recording issues in verr with paths relative to path.
+func parseListUsersResponseBody(data map[string]any, path string, verr *ValidationError) *ListUsersResponseBody {
+	body := new(ListUsersResponseBody)
+
+	pathPageNumber := joinValidationPath(path, "PageNumber")
+
+	valPageNumber, ok := data["PageNumber"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathPageNumber, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		// JSON numbers are float64 by default, so we need to handle that case
+		switch v := valPageNumber.(type) {
+		case float64:
+			valPageNumberTyped := int64(v)
+			body.PageNumber = valPageNumberTyped
+		case int64:
+			body.PageNumber = v
+		default:
+			verr.add(ValidationLocationBody, pathPageNumber, ValidationCodeInvalidType, "must be an integer")
+		}
+
+	}
+
+	pathPageSize := joinValidationPath(path, "PageSize")
+
+	valPageSize, ok := data["PageSize"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathPageSize, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		// JSON numbers are float64 by default, so we need to handle that case
+		switch v := valPageSize.(type) {
+		case float64:
+			valPageSizeTyped := int64(v)
+			body.PageSize = valPageSizeTyped
+		case int64:
+			body.PageSize = v
+		default:
+			verr.add(ValidationLocationBody, pathPageSize, ValidationCodeInvalidType, "must be an integer")
+		}
+
+	}
+
+	pathTotalCount := joinValidationPath(path, "TotalCount")
+
+	valTotalCount, ok := data["TotalCount"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		// JSON numbers are float64 by default, so we need to handle that case
+		switch v := valTotalCount.(type) {
+		case float64:
+			valTotalCountTyped := int64(v)
+			body.TotalCount = valTotalCountTyped
+		case int64:
+			body.TotalCount = v
+		default:
+			verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeInvalidType, "must be an integer")
+		}
+
+	}
+
+	pathUsers := joinValidationPath(path, "Users")
+
+	valUsers, ok := data["Users"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathUsers, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		valUsersSlice, ok := valUsers.([]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathUsers, ValidationCodeInvalidType, "must be an array")
+
+		} else if len(valUsersSlice) == 0 {
+			verr.add(ValidationLocationBody, pathUsers, ValidationCodeNonEmpty, "must be non-empty")
+
+		} else {
+			valUsersTyped := make([]User, 0, len(valUsersSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valUsersSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathUsers, idx)
+
+				itemMap, ok := item.(map[string]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an object")
+					continue
+				}
+				itemTyped := *parseUser(itemMap, itemPath, verr)
+
+				valUsersTyped = append(valUsersTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.Users = valUsersTyped
+			}
+		}
+
+	}
+
+	return body
+}
+
+type LogoutUserResponseBody struct {
+
+	// A message confirming successful logout.
+	//
+	// Required
+	//
+	// Must be non-empty
+	Message string `json:"Message"`
+}
+
+// NewLogoutUserResponseBody creates a new instance of LogoutUserResponseBody with required fields as parameters
+func NewLogoutUserResponseBody(
+
+	Message string,
+
+) *LogoutUserResponseBody {
+	return &LogoutUserResponseBody{
+
+		Message: Message,
+	}
+}
+
+// ParseLogoutUserResponseBody creates a new instance of LogoutUserResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseLogoutUserResponseBody(data map[string]any) (*LogoutUserResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseLogoutUserResponseBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseLogoutUserResponseBody parses data into a new LogoutUserResponseBody, recording issues in verr with paths relative to path.
+func parseLogoutUserResponseBody(data map[string]any, path string, verr *ValidationError) *LogoutUserResponseBody {
+	body := new(LogoutUserResponseBody)
+
+	pathMessage := joinValidationPath(path, "Message")
+
+	valMessage, ok := data["Message"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathMessage, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valMessageTyped, ok := valMessage.(string); !ok {
+			verr.add(ValidationLocationBody, pathMessage, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valMessageTyped = strings.TrimSpace(valMessageTyped)
+
+			if len(valMessageTyped) == 0 {
+				verr.add(ValidationLocationBody, pathMessage, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Message = valMessageTyped
+		}
+
+	}
+
+	return body
+}
+
+// A notification sent to a user, by email or SMS.
+//
+// Notification is one of *EmailNotification, *SmsNotification,
+// told apart in JSON by their Channel property.
+type Notification interface {
+	isNotification()
+}
+
+func (*EmailNotification) isNotification() {}
+
+// MarshalJSON encodes v with its Channel, "email", so that it can be decoded as a Notification.
+func (v EmailNotification) MarshalJSON() ([]byte, error) {
+	// fields has the fields of EmailNotification, without its MarshalJSON method
+	type fields EmailNotification
+	return marshalWithDiscriminator(fields(v), "Channel", "email")
+}
+
+func (*SmsNotification) isNotification() {}
+
+// MarshalJSON encodes v with its Channel, "sms", so that it can be decoded as a Notification.
+func (v SmsNotification) MarshalJSON() ([]byte, error) {
+	// fields has the fields of SmsNotification, without its MarshalJSON method
+	type fields SmsNotification
+	return marshalWithDiscriminator(fields(v), "Channel", "sms")
+}
+
+// ParseNotification creates a new Notification from decoded JSON data, as the variant named by its Channel,
+// validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseNotification(data map[string]any) (Notification, error) {
+	verr := &ValidationError{}
+	value := parseNotification(data, "", verr)
+	return value, verr.errOrNil()
+}
+
+// parseNotification parses data into the variant of Notification named by its Channel, recording issues in verr
+// with paths relative to path. It returns nil if the Channel is missing or unknown.
+func parseNotification(data map[string]any, path string, verr *ValidationError) Notification {
+	pathDiscriminator := joinValidationPath(path, "Channel")
+	rawDiscriminator, ok := data["Channel"]
+	if !ok {
+		verr.add(ValidationLocationBody, pathDiscriminator, ValidationCodeRequired, "missing required field")
+		return nil
+	}
+	discriminator, ok := rawDiscriminator.(string)
+	if !ok {
+		verr.add(ValidationLocationBody, pathDiscriminator, ValidationCodeInvalidType, "must be a string")
+		return nil
+	}
+	switch discriminator {
+	case "email":
+		return parseEmailNotification(data, path, verr)
+	case "sms":
+		return parseSmsNotification(data, path, verr)
+	default:
+		verr.add(ValidationLocationBody, pathDiscriminator, ValidationCodeInvalidValue, "must be one of email, sms")
+		return nil
+	}
+}
+
+// unmarshalNotification decodes data into the variant of Notification named by its Channel.
+func unmarshalNotification(data []byte) (Notification, error) {
+	var raw struct {
+		Channel *string `json:"Channel"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if raw.Channel == nil {
+		return nil, fmt.Errorf("missing Channel of Notification")
+	}
+	var value Notification
+	switch *raw.Channel {
+	case "email":
+		value = new(EmailNotification)
+	case "sms":
+		value = new(SmsNotification)
+	default:
+		return nil, fmt.Errorf("unknown Channel %q of Notification", *raw.Channel)
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+type ReceiveWebhookRequestBody struct {
+
+	// The name of the delivered event.
+	//
+	// Required
+	//
+	// Must be non-empty
+	Event string `json:"Event"`
+}
+
+// NewReceiveWebhookRequestBody creates a new instance of ReceiveWebhookRequestBody with required fields as parameters
+func NewReceiveWebhookRequestBody(
 
-		PageSize: PageSize,
+	Event string,
 
-		TotalCount: TotalCount,
+) *ReceiveWebhookRequestBody {
+	return &ReceiveWebhookRequestBody{
 
-		Users: Users,
+		Event: Event,
 	}
 }
 
-func ParseListUsersResponseBody(data map[string]any) (*ListUsersResponseBody, error) {
-	body := new(ListUsersResponseBody)
+// ParseReceiveWebhookRequestBody creates a new instance of ReceiveWebhookRequestBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseReceiveWebhookRequestBody(data map[string]any) (*ReceiveWebhookRequestBody, error) {
+	verr := &ValidationError{}
+	body := parseReceiveWebhookRequestBody(data, "", verr)
+	return body, verr.errOrNil()
+}
 
-	valPageNumber, ok := data["PageNumber"]
+// parseReceiveWebhookRequestBody parses data into a new ReceiveWebhookRequestBody, recording issues in verr with paths relative to path.
+func parseReceiveWebhookRequestBody(data map[string]any, path string, verr *ValidationError) *ReceiveWebhookRequestBody {
+	body := new(ReceiveWebhookRequestBody)
+
+	pathEvent := joinValidationPath(path, "Event")
+
+	valEvent, ok := data["Event"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'PageNumber'")
+		verr.add(ValidationLocationBody, pathEvent, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		var valPageNumberTyped int64
-		// JSON numbers are float64 by default, so we need to handle that case
-		switch v := valPageNumber.(type) {
-		case float64:
-			valPageNumberTyped = int64(v)
-		case int64:
-			valPageNumberTyped = v
-		default:
-			return body, fmt.Errorf("field 'PageNumber' has incorrect type")
-		}
+		if valEventTyped, ok := valEvent.(string); !ok {
+			verr.add(ValidationLocationBody, pathEvent, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valEventTyped = strings.TrimSpace(valEventTyped)
 
-		body.PageNumber = valPageNumberTyped
+			if len(valEventTyped) == 0 {
+				verr.add(ValidationLocationBody, pathEvent, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Event = valEventTyped
+		}
 
 	}
 
-	valPageSize, ok := data["PageSize"]
-	if !ok {
+	return body
+}
 
-		return body, fmt.Errorf("missing required field 'PageSize'")
+type ReceiveWebhookResponseBody struct {
 
-	} else {
+	// The name of the received event.
+	//
+	// Required
+	//
+	// Must be non-empty
+	Event string `json:"Event"`
 
-		var valPageSizeTyped int64
-		// JSON numbers are float64 by default, so we need to handle that case
-		switch v := valPageSize.(type) {
-		case float64:
-			valPageSizeTyped = int64(v)
-		case int64:
-			valPageSizeTyped = v
-		default:
-			return body, fmt.Errorf("field 'PageSize' has incorrect type")
-		}
+	// The ID of the key the delivery was signed with.
+	//
+	// Required
+	//
+	// Must be non-empty
+	KeyId string `json:"KeyId"`
+}
+
+// NewReceiveWebhookResponseBody creates a new instance of ReceiveWebhookResponseBody with required fields as parameters
+func NewReceiveWebhookResponseBody(
+
+	Event string,
 
-		body.PageSize = valPageSizeTyped
+	KeyId string,
 
+) *ReceiveWebhookResponseBody {
+	return &ReceiveWebhookResponseBody{
+
+		Event: Event,
+
+		KeyId: KeyId,
 	}
+}
 
-	valTotalCount, ok := data["TotalCount"]
+// ParseReceiveWebhookResponseBody creates a new instance of ReceiveWebhookResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseReceiveWebhookResponseBody(data map[string]any) (*ReceiveWebhookResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseReceiveWebhookResponseBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseReceiveWebhookResponseBody parses data into a new ReceiveWebhookResponseBody, recording issues in verr with paths relative to path.
+func parseReceiveWebhookResponseBody(data map[string]any, path string, verr *ValidationError) *ReceiveWebhookResponseBody {
+	body := new(ReceiveWebhookResponseBody)
+
+	pathEvent := joinValidationPath(path, "Event")
+
+	valEvent, ok := data["Event"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'TotalCount'")
+		verr.add(ValidationLocationBody, pathEvent, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		var valTotalCountTyped int64
-		// JSON numbers are float64 by default, so we need to handle that case
-		switch v := valTotalCount.(type) {
-		case float64:
-			valTotalCountTyped = int64(v)
-		case int64:
-			valTotalCountTyped = v
-		default:
-			return body, fmt.Errorf("field 'TotalCount' has incorrect type")
-		}
+		if valEventTyped, ok := valEvent.(string); !ok {
+			verr.add(ValidationLocationBody, pathEvent, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valEventTyped = strings.TrimSpace(valEventTyped)
+
+			if len(valEventTyped) == 0 {
+				verr.add(ValidationLocationBody, pathEvent, ValidationCodeNonEmpty, "must be non-empty")
+			}
 
-		body.TotalCount = valTotalCountTyped
+			body.Event = valEventTyped
+		}
 
 	}
 
-	valUsers, ok := data["Users"]
+	pathKeyId := joinValidationPath(path, "KeyId")
+
+	valKeyId, ok := data["KeyId"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'Users'")
+		verr.add(ValidationLocationBody, pathKeyId, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valUsersSlice, ok := valUsers.([]any)
-		if !ok {
-			return body, fmt.Errorf("field 'Users' has incorrect type")
-		}
-
-		if len(valUsersSlice) == 0 {
-			return body, fmt.Errorf("field 'Users' must be non-empty")
-		}
-
-		valUsersTyped := make([]User, 0, len(valUsersSlice))
+		if valKeyIdTyped, ok := valKeyId.(string); !ok {
+			verr.add(ValidationLocationBody, pathKeyId, ValidationCodeInvalidType, "must be of type string")
+		} else {
 
-		for idx, item := range valUsersSlice {
+			valKeyIdTyped = strings.TrimSpace(valKeyIdTyped)
 
-			itemMap, ok := item.(map[string]any)
-			if !ok {
-				return body, fmt.Errorf("element %d of field 'Users' has incorrect type", idx)
-			}
-			validatedItem, err := ParseUser(itemMap)
-			if err != nil {
-				return body, fmt.Errorf("element %d of field 'Users' is invalid: %w", idx, err)
+			if len(valKeyIdTyped) == 0 {
+				verr.add(ValidationLocationBody, pathKeyId, ValidationCodeNonEmpty, "must be non-empty")
 			}
 
-			valUsersTyped = append(valUsersTyped, *validatedItem)
+			body.KeyId = valKeyIdTyped
 		}
 
-		body.Users = valUsersTyped
-
 	}
 
-	return body, nil
+	return body
 }
 
-type LogoutUserResponseBody struct {
+type SmsNotification struct {
 
-	// A message confirming successful logout.
+	// The phone number the notification is sent to.
 	//
 	// Required
 	//
 	// Must be non-empty
-	Message string `json:"Message"`
+	PhoneNumber string `json:"PhoneNumber"`
 }
 
-// NewLogoutUserResponseBody creates a new instance of LogoutUserResponseBody with required fields as parameters
-func NewLogoutUserResponseBody(
+// NewSmsNotification creates a new instance of SmsNotification with required fields as parameters
+func NewSmsNotification(
 
-	Message string,
+	PhoneNumber string,
 
-) *LogoutUserResponseBody {
-	return &LogoutUserResponseBody{
+) *SmsNotification {
+	return &SmsNotification{
 
-		Message: Message,
+		PhoneNumber: PhoneNumber,
 	}
 }
 
-func ParseLogoutUserResponseBody(data map[string]any) (*LogoutUserResponseBody, error) {
-	body := new(LogoutUserResponseBody)
+// ParseSmsNotification creates a new instance of SmsNotification from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseSmsNotification(data map[string]any) (*SmsNotification, error) {
+	verr := &ValidationError{}
+	body := parseSmsNotification(data, "", verr)
+	return body, verr.errOrNil()
+}
 
-	valMessage, ok := data["Message"]
+// parseSmsNotification parses data into a new SmsNotification, recording issues in verr with paths relative to path.
+func parseSmsNotification(data map[string]any, path string, verr *ValidationError) *SmsNotification {
+	body := new(SmsNotification)
+
+	pathPhoneNumber := joinValidationPath(path, "PhoneNumber")
+
+	valPhoneNumber, ok := data["PhoneNumber"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'Message'")
+		verr.add(ValidationLocationBody, pathPhoneNumber, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valMessageTyped, ok := valMessage.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'Message' has incorrect type")
-		}
+		if valPhoneNumberTyped, ok := valPhoneNumber.(string); !ok {
+			verr.add(ValidationLocationBody, pathPhoneNumber, ValidationCodeInvalidType, "must be of type string")
+		} else {
 
-		valMessageTyped = strings.TrimSpace(valMessageTyped)
+			valPhoneNumberTyped = strings.TrimSpace(valPhoneNumberTyped)
 
-		valMessageTyped = strings.TrimSpace(valMessageTyped)
-		if len(valMessageTyped) == 0 {
-			return body, fmt.Errorf("field 'Message' must be non-empty")
-		}
+			if len(valPhoneNumberTyped) == 0 {
+				verr.add(ValidationLocationBody, pathPhoneNumber, ValidationCodeNonEmpty, "must be non-empty")
+			}
 
-		body.Message = valMessageTyped
+			body.PhoneNumber = valPhoneNumberTyped
+		}
 
 	}
 
-	return body, nil
+	return body
 }
 
 type User struct {
+	AuditedEntity
+
+	// The postal address of the user, if known. Just for testing inline object support in the generator.
+	//
+	// Optional
+	//
+	Address *UserAddress `json:"Address,omitempty"`
 
 	// The age of the user.
 	//
@@ -736,6 +2542,8 @@ type User struct {
 // NewUser creates a new instance of User with required fields as parameters
 func NewUser(
 
+	CreatedAt string,
+
 	Email string,
 
 	IsActive bool,
@@ -746,6 +2554,7 @@ func NewUser(
 
 ) *User {
 	return &User{
+		AuditedEntity: *NewAuditedEntity(CreatedAt),
 
 		Email: Email,
 
@@ -757,14 +2566,56 @@ func NewUser(
 	}
 }
 
+// WithAddress sets the optional field Address and returns the modified User instance
+func (o *User) WithAddress(value UserAddress) *User {
+	o.Address = &value
+	return o
+}
+
 // WithAge sets the optional field Age and returns the modified User instance
 func (o *User) WithAge(value int64) *User {
 	o.Age = &value
 	return o
 }
 
+// WithUpdatedBy sets the optional field UpdatedBy and returns the modified User instance
+func (o *User) WithUpdatedBy(value string) *User {
+	o.UpdatedBy = &value
+	return o
+}
+
+// ParseUser creates a new instance of User from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
 func ParseUser(data map[string]any) (*User, error) {
+	verr := &ValidationError{}
+	body := parseUser(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseUser parses data into a new User, recording issues in verr with paths relative to path.
+func parseUser(data map[string]any, path string, verr *ValidationError) *User {
 	body := new(User)
+	body.AuditedEntity = *parseAuditedEntity(data, path, verr)
+
+	pathAddress := joinValidationPath(path, "Address")
+
+	valAddress, ok := data["Address"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valAddressMap, ok := valAddress.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, pathAddress, ValidationCodeInvalidType, "must be an object")
+		} else {
+			body.Address = parseUserAddress(valAddressMap, pathAddress, verr)
+		}
+
+	}
+
+	pathAge := joinValidationPath(path, "Age")
 
 	valAge, ok := data["Age"]
 	if !ok {
@@ -773,107 +2624,319 @@ func ParseUser(data map[string]any) (*User, error) {
 
 	} else {
 
-		var valAgeTyped int64
 		// JSON numbers are float64 by default, so we need to handle that case
 		switch v := valAge.(type) {
 		case float64:
-			valAgeTyped = int64(v)
+			valAgeTyped := int64(v)
+			body.Age = &valAgeTyped
 		case int64:
-			valAgeTyped = v
+			body.Age = &v
 		default:
-			return body, fmt.Errorf("field 'Age' has incorrect type")
+			verr.add(ValidationLocationBody, pathAge, ValidationCodeInvalidType, "must be an integer")
 		}
 
-		body.Age = &valAgeTyped
-
 	}
 
+	pathEmail := joinValidationPath(path, "Email")
+
 	valEmail, ok := data["Email"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'Email'")
+		verr.add(ValidationLocationBody, pathEmail, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valEmailTyped, ok := valEmail.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'Email' has incorrect type")
-		}
+		if valEmailTyped, ok := valEmail.(string); !ok {
+			verr.add(ValidationLocationBody, pathEmail, ValidationCodeInvalidType, "must be of type string")
+		} else {
 
-		valEmailTyped = strings.TrimSpace(valEmailTyped)
+			valEmailTyped = strings.TrimSpace(valEmailTyped)
 
-		valEmailTyped = strings.TrimSpace(valEmailTyped)
-		if len(valEmailTyped) == 0 {
-			return body, fmt.Errorf("field 'Email' must be non-empty")
-		}
+			if len(valEmailTyped) == 0 {
+				verr.add(ValidationLocationBody, pathEmail, ValidationCodeNonEmpty, "must be non-empty")
+			}
 
-		body.Email = valEmailTyped
+			body.Email = valEmailTyped
+		}
 
 	}
 
+	pathIsActive := joinValidationPath(path, "IsActive")
+
 	valIsActive, ok := data["IsActive"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'IsActive'")
+		verr.add(ValidationLocationBody, pathIsActive, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valIsActiveTyped, ok := valIsActive.(bool)
-		if !ok {
-			return body, fmt.Errorf("field 'IsActive' has incorrect type")
-		}
+		if valIsActiveTyped, ok := valIsActive.(bool); !ok {
+			verr.add(ValidationLocationBody, pathIsActive, ValidationCodeInvalidType, "must be of type bool")
+		} else {
 
-		body.IsActive = valIsActiveTyped
+			body.IsActive = valIsActiveTyped
+		}
 
 	}
 
+	pathUserId := joinValidationPath(path, "UserId")
+
 	valUserId, ok := data["UserId"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'UserId'")
+		verr.add(ValidationLocationBody, pathUserId, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valUserIdTyped, ok := valUserId.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'UserId' has incorrect type")
+		if valUserIdTyped, ok := valUserId.(string); !ok {
+			verr.add(ValidationLocationBody, pathUserId, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valUserIdTyped = strings.TrimSpace(valUserIdTyped)
+
+			if len(valUserIdTyped) == 0 {
+				verr.add(ValidationLocationBody, pathUserId, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.UserId = valUserIdTyped
 		}
 
-		valUserIdTyped = strings.TrimSpace(valUserIdTyped)
+	}
+
+	pathUserName := joinValidationPath(path, "UserName")
+
+	valUserName, ok := data["UserName"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathUserName, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valUserNameTyped, ok := valUserName.(string); !ok {
+			verr.add(ValidationLocationBody, pathUserName, ValidationCodeInvalidType, "must be of type string")
+		} else {
 
-		valUserIdTyped = strings.TrimSpace(valUserIdTyped)
-		if len(valUserIdTyped) == 0 {
-			return body, fmt.Errorf("field 'UserId' must be non-empty")
+			valUserNameTyped = strings.TrimSpace(valUserNameTyped)
+
+			if len(valUserNameTyped) == 0 {
+				verr.add(ValidationLocationBody, pathUserName, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.UserName = valUserNameTyped
 		}
 
-		body.UserId = valUserIdTyped
+	}
+
+	return body
+}
+
+type UserAddress struct {
+
+	// The city.
+	//
+	// Required
+	//
+	// Must be non-empty
+	City string `json:"City"`
+
+	// The coordinates of the address, if known.
+	//
+	// Optional
+	//
+	Geo *UserAddressGeo `json:"Geo,omitempty"`
+
+	// The street and number.
+	//
+	// Required
+	//
+	// Must be non-empty
+	Street string `json:"Street"`
+}
+
+// NewUserAddress creates a new instance of UserAddress with required fields as parameters
+func NewUserAddress(
+
+	City string,
 
+	Street string,
+
+) *UserAddress {
+	return &UserAddress{
+
+		City: City,
+
+		Street: Street,
 	}
+}
 
-	valUserName, ok := data["UserName"]
+// WithGeo sets the optional field Geo and returns the modified UserAddress instance
+func (o *UserAddress) WithGeo(value UserAddressGeo) *UserAddress {
+	o.Geo = &value
+	return o
+}
+
+// ParseUserAddress creates a new instance of UserAddress from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseUserAddress(data map[string]any) (*UserAddress, error) {
+	verr := &ValidationError{}
+	body := parseUserAddress(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseUserAddress parses data into a new UserAddress, recording issues in verr with paths relative to path.
+func parseUserAddress(data map[string]any, path string, verr *ValidationError) *UserAddress {
+	body := new(UserAddress)
+
+	pathCity := joinValidationPath(path, "City")
+
+	valCity, ok := data["City"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'UserName'")
+		verr.add(ValidationLocationBody, pathCity, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valUserNameTyped, ok := valUserName.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'UserName' has incorrect type")
+		if valCityTyped, ok := valCity.(string); !ok {
+			verr.add(ValidationLocationBody, pathCity, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valCityTyped = strings.TrimSpace(valCityTyped)
+
+			if len(valCityTyped) == 0 {
+				verr.add(ValidationLocationBody, pathCity, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.City = valCityTyped
+		}
+
+	}
+
+	pathGeo := joinValidationPath(path, "Geo")
+
+	valGeo, ok := data["Geo"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valGeoMap, ok := valGeo.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, pathGeo, ValidationCodeInvalidType, "must be an object")
+		} else {
+			body.Geo = parseUserAddressGeo(valGeoMap, pathGeo, verr)
+		}
+
+	}
+
+	pathStreet := joinValidationPath(path, "Street")
+
+	valStreet, ok := data["Street"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathStreet, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valStreetTyped, ok := valStreet.(string); !ok {
+			verr.add(ValidationLocationBody, pathStreet, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valStreetTyped = strings.TrimSpace(valStreetTyped)
+
+			if len(valStreetTyped) == 0 {
+				verr.add(ValidationLocationBody, pathStreet, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Street = valStreetTyped
 		}
 
-		valUserNameTyped = strings.TrimSpace(valUserNameTyped)
+	}
+
+	return body
+}
+
+type UserAddressGeo struct {
+
+	// The latitude, in degrees.
+	//
+	// Required
+	//
+	Latitude float64 `json:"Latitude"`
+
+	// The longitude, in degrees.
+	//
+	// Required
+	//
+	Longitude float64 `json:"Longitude"`
+}
+
+// NewUserAddressGeo creates a new instance of UserAddressGeo with required fields as parameters
+func NewUserAddressGeo(
 
-		valUserNameTyped = strings.TrimSpace(valUserNameTyped)
-		if len(valUserNameTyped) == 0 {
-			return body, fmt.Errorf("field 'UserName' must be non-empty")
+	Latitude float64,
+
+	Longitude float64,
+
+) *UserAddressGeo {
+	return &UserAddressGeo{
+
+		Latitude: Latitude,
+
+		Longitude: Longitude,
+	}
+}
+
+// ParseUserAddressGeo creates a new instance of UserAddressGeo from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseUserAddressGeo(data map[string]any) (*UserAddressGeo, error) {
+	verr := &ValidationError{}
+	body := parseUserAddressGeo(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseUserAddressGeo parses data into a new UserAddressGeo, recording issues in verr with paths relative to path.
+func parseUserAddressGeo(data map[string]any, path string, verr *ValidationError) *UserAddressGeo {
+	body := new(UserAddressGeo)
+
+	pathLatitude := joinValidationPath(path, "Latitude")
+
+	valLatitude, ok := data["Latitude"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathLatitude, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valLatitudeTyped, ok := valLatitude.(float64); !ok {
+			verr.add(ValidationLocationBody, pathLatitude, ValidationCodeInvalidType, "must be of type float64")
+		} else {
+
+			body.Latitude = valLatitudeTyped
 		}
 
-		body.UserName = valUserNameTyped
+	}
+
+	pathLongitude := joinValidationPath(path, "Longitude")
+
+	valLongitude, ok := data["Longitude"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathLongitude, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valLongitudeTyped, ok := valLongitude.(float64); !ok {
+			verr.add(ValidationLocationBody, pathLongitude, ValidationCodeInvalidType, "must be of type float64")
+		} else {
+
+			body.Longitude = valLongitudeTyped
+		}
 
 	}
 
-	return body, nil
+	return body
 }
 
 // Enum representing the status of a user.
@@ -903,3 +2966,25 @@ func ParseUserStatus(data string) (*UserStatus, error) {
 		return nil, fmt.Errorf("invalid value for UserStatus: %s", data)
 	}
 }
+
+// marshalWithDiscriminator encodes fields, the fields of a variant of a oneOf schema, as a JSON object
+// whose first property is its discriminator, set to value.
+func marshalWithDiscriminator(fields any, property, value string) ([]byte, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(`{"` + property + `":`)
+	buf.Write(encodedValue)
+	if len(data) > 2 {
+		buf.WriteByte(',')
+	}
+	// data is a JSON object, whose opening brace is replaced
+	buf.Write(data[1:])
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,185 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	CreateFlakyReqHTTPMethod = "POST"
+	CreateFlakyReqRoutePath  = "/flaky"
+)
+
+// Same as GetFlaky, but not idempotent, so the SDKs do not retry it.
+type CreateFlakyReq struct {
+
+	// Source: query parameter "failures"
+	//
+
+	// The number of requests with the key that fail.
+	//
+	// Required
+	Failures int64
+
+	// Source: query parameter "key"
+	//
+
+	// The key the requests are counted by.
+	//
+	// Required
+	Key string
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type CreateFlaky200 struct {
+
+	// Response body
+	Body *FlakyResponseBody
+}
+
+// Service Unavailable
+type CreateFlaky503 struct {
+
+	// Source: header parameter "Retry-After"
+	//
+
+	// The number of seconds to wait before retrying.
+	//
+	// Required
+	RetryAfter int64
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// ParseCreateFlakyReq creates a new instance of CreateFlakyReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
+func ParseCreateFlakyReq(w http.ResponseWriter, r *http.Request) (*CreateFlakyReq, error) {
+	req := CreateFlakyReq{}
+	verr := &ValidationError{}
+
+	// Parse path parameters, if any
+
+	// Parse query parameters, if any
+
+	valFailures, err := parseint64Param(r.URL.Query().Get("failures"), "failures", true)
+	if err != nil {
+		verr.addParamError(ValidationLocationQuery, "failures", err)
+	} else if valFailures != nil {
+		req.Failures = *valFailures
+	}
+
+	valKey, err := parsestringParam(r.URL.Query().Get("key"), "key", true)
+	if err != nil {
+		verr.addParamError(ValidationLocationQuery, "key", err)
+	} else if valKey != nil {
+		req.Key = *valKey
+	}
+
+	// Parse header parameters, if any
+
+	// Required auth, if any
+
+	// Atleast one auth, if any
+
+	// All auth of one of the alternatives, if any
+
+	if len(verr.Issues) > 0 {
+		return &CreateFlakyReq{}, newParseError(verr)
+	}
+	return &req, nil
+}
+
+// CreateFlakyResponse is one of the responses defined for the CreateFlaky endpoint:
+//   - 200: CreateFlaky200
+//   - 503: CreateFlaky503
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type CreateFlakyResponse interface {
+	// writeCreateFlakyResponse writes the headers, status code and body of the response to w.
+	writeCreateFlakyResponse(w http.ResponseWriter) error
+}
+
+// WriteCreateFlakyResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteCreateFlakyResponse(w http.ResponseWriter, resp CreateFlakyResponse) error {
+	return resp.writeCreateFlakyResponse(w)
+}
+
+func NewCreateFlaky200(
+
+	body *FlakyResponseBody,
+
+) *CreateFlaky200 {
+	return &CreateFlaky200{
+
+		Body: body,
+	}
+}
+
+func (resp *CreateFlaky200) writeCreateFlakyResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(200)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write200 writes the CreateFlaky200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *CreateFlakyReq) Write200(w http.ResponseWriter, resp *CreateFlaky200) error {
+	return resp.writeCreateFlakyResponse(w)
+}
+
+func NewCreateFlaky503(
+
+	RetryAfter int64,
+
+	body *ErrorResponse,
+
+) *CreateFlaky503 {
+	return &CreateFlaky503{
+
+		RetryAfter: RetryAfter,
+
+		Body: body,
+	}
+}
+
+func (resp *CreateFlaky503) writeCreateFlakyResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	w.Header().Set("Retry-After", fmt.Sprintf("%v", resp.RetryAfter))
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(503)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write503 writes the CreateFlaky503 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *CreateFlakyReq) Write503(w http.ResponseWriter, resp *CreateFlaky503) error {
+	return resp.writeCreateFlakyResponse(w)
+}
@@ -2,9 +2,11 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
-	"strings"
 )
 
 const (
@@ -22,7 +24,7 @@ type CreateUserReq struct {
 	//
 	// Authentication method that denotes an admin token passed in the request header.
 	//
-	// Format (NOT ENFORCED): admin_token
+	// Format (enforced by the server, applied by the SDK to a bare token): Admin {token}
 	//
 	AdminTokenAuth string
 
@@ -31,7 +33,7 @@ type CreateUserReq struct {
 	//
 	// Authentication method that denotes an API key passed in the request header.
 	//
-	// Format (NOT ENFORCED): api_key
+	// Format (documentation only): api_key
 	//
 	APIKeyAuth string
 
@@ -62,8 +64,9 @@ type CreateUser400 struct {
 // Payload Too Large - the request body exceeds the maximum allowed size
 type CreateUser413Response struct {
 
-	// Raw response body. The HTTP response will be returned directly for this response, and it will be the responsibility of the caller to read/close the response body.
-	RawBody *http.Response
+	// Raw response body. If set, it is copied to the http.ResponseWriter after the headers and status code are written.
+	// If nil, only the headers and status code are written, and writing the body is the responsibility of the caller.
+	RawBody io.Reader
 }
 
 // Internal Server Error
@@ -74,11 +77,12 @@ type CreateUser500 struct {
 }
 
 // ParseCreateUserReq creates a new instance of CreateUserReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
 func ParseCreateUserReq(w http.ResponseWriter, r *http.Request) (*CreateUserReq, error) {
 	req := CreateUserReq{}
-	var err error
-	// to silence unused variable error in case there are no parameters to parse
-	_ = err
+	verr := &ValidationError{}
 
 	// Parse path parameters, if any
 
@@ -86,45 +90,116 @@ func ParseCreateUserReq(w http.ResponseWriter, r *http.Request) (*CreateUserReq,
 
 	// Parse header parameters, if any
 
+	// Limit the request body before anything reads it: the hmac auth, if any, hashes it before it is parsed
+
+	maxBodyBytes := int64(256 << 10) // Default max body bytes: 256KB
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+
 	// Required auth, if any
 
-	valAdminToken := r.Header.Get("X-App-Admin-Token")
-	valAdminToken = strings.TrimSpace(valAdminToken)
-	if valAdminToken == "" {
-		return &CreateUserReq{}, fmt.Errorf("missing required authentication: header X-App-Admin-Token")
+	if valAdminToken, ok, err := extractAdminToken(r); !ok {
+		verr.add(ValidationLocationAuth, "X-App-Admin-Token", ValidationCodeRequired, "missing required authentication: header X-App-Admin-Token")
+	} else if err != nil {
+		verr.add(ValidationLocationAuth, "X-App-Admin-Token", ValidationCodeInvalidValue, err.Error())
 	} else {
 		req.AdminTokenAuth = valAdminToken
 	}
 
-	valAPIKey := r.Header.Get("X-App-API-Key")
-	valAPIKey = strings.TrimSpace(valAPIKey)
-	if valAPIKey == "" {
-		return &CreateUserReq{}, fmt.Errorf("missing required authentication: header X-App-API-Key")
+	if valAPIKey, ok, err := extractAPIKey(r); !ok {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeRequired, "missing required authentication: header X-App-API-Key")
+	} else if err != nil {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeInvalidValue, err.Error())
 	} else {
 		req.APIKeyAuth = valAPIKey
 	}
 
 	// Atleast one auth, if any
 
+	// All auth of one of the alternatives, if any
+
 	// Parse request body
 	defer r.Body.Close()
-	bodyData := make(map[string]any)
+	// A missing Content-Type is accepted, any other value must match the endpoint's content type.
+	supportedContentType := true
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		if mediaType, _, err := mime.ParseMediaType(contentType); err != nil || mediaType != "application/json" {
+			supportedContentType = false
+			verr.add(ValidationLocationHeader, "Content-Type", ValidationCodeUnsupportedContentType, fmt.Sprintf("unsupported content type '%s', expected 'application/json'", contentType))
+		}
+	}
+	if !supportedContentType {
+		// don't try to decode a body in a format we don't understand
+	} else if bodyData, err := io.ReadAll(r.Body); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			verr.add(ValidationLocationBody, "", ValidationCodeBodyTooLarge, fmt.Sprintf("request body exceeds the maximum allowed size of %d bytes", maxBytesErr.Limit))
+		} else {
+			verr.add(ValidationLocationBody, "", ValidationCodeMalformed, fmt.Sprintf("error reading request body: %v", err))
+		}
+	} else {
+		// Decoded straight into the struct, see CreateUserRequestBody.UnmarshalJSON
+		req.Body = new(CreateUserRequestBody)
+		req.Body.decodeJSON(bodyData, "", verr)
+	}
 
-	maxBodyBytes := int64(256 << 10) // Default max body bytes: 256KB
+	if len(verr.Issues) > 0 {
+		return &CreateUserReq{}, newParseError(verr)
+	}
+	return &req, nil
+}
 
-	r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
-	err = json.NewDecoder(r.Body).Decode(&bodyData)
-	if err != nil {
-		return &CreateUserReq{}, fmt.Errorf("error parsing request body: %w", err)
+// authenticateCreateUserReq verifies the credentials in req with the Authenticators of impl, and returns r
+// with the resulting Principals in its context.
+//
+// All of the required credentials must be valid, and at least one of the optional ones, if any.
+// With alternatives, the first one whose credentials are all present and valid is set as req.AuthAlternative.
+func authenticateCreateUserReq(r *http.Request, impl Handler, req *CreateUserReq) (*http.Request, error) {
+	ctx := r.Context()
+	verr := &ValidationError{}
+	var verifyErrs []error
+
+	if principal, err := impl.VerifyAdminToken(ctx, req.AdminTokenAuth); err != nil {
+		verr.add(ValidationLocationAuth, "X-App-Admin-Token", ValidationCodeInvalidValue, "invalid credentials: X-App-Admin-Token")
+		verifyErrs = append(verifyErrs, fmt.Errorf("AdminToken: %w", err))
+	} else {
+		ctx = withPrincipal(ctx, "adminTokenAuth", principal)
 	}
-	var body *CreateUserRequestBody
-	body, err = ParseCreateUserRequestBody(bodyData)
-	if err != nil {
-		return &CreateUserReq{}, fmt.Errorf("error parsing request body: %w", err)
+
+	if principal, err := impl.VerifyAPIKey(ctx, req.APIKeyAuth); err != nil {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeInvalidValue, "invalid credentials: X-App-API-Key")
+		verifyErrs = append(verifyErrs, fmt.Errorf("APIKey: %w", err))
+	} else {
+		ctx = withPrincipal(ctx, "apiKeyAuth", principal)
 	}
-	req.Body = body
 
-	return &req, nil
+	if len(verr.Issues) > 0 {
+		return r, &ParseError{
+			Kind:       ParseErrorKindUnauthenticated,
+			Validation: verr,
+			Err:        errors.Join(verifyErrs...),
+		}
+	}
+	return r.WithContext(ctx), nil
+}
+
+// CreateUserResponse is one of the responses defined for the CreateUser endpoint:
+//   - 201: CreateUser201
+//   - 400: CreateUser400
+//   - 413: CreateUser413Response
+//   - 500: CreateUser500
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type CreateUserResponse interface {
+	// writeCreateUserResponse writes the headers, status code and body of the response to w.
+	writeCreateUserResponse(w http.ResponseWriter) error
+}
+
+// WriteCreateUserResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteCreateUserResponse(w http.ResponseWriter, resp CreateUserResponse) error {
+	return resp.writeCreateUserResponse(w)
 }
 
 func NewCreateUser201(
@@ -138,10 +213,7 @@ func NewCreateUser201(
 	}
 }
 
-// Write201 writes the CreateUser201 response to the http.ResponseWriter
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *CreateUserReq) Write201(w http.ResponseWriter, resp *CreateUser201) error {
+func (resp *CreateUser201) writeCreateUserResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
 	// Set Content-Type
@@ -155,6 +227,13 @@ func (r *CreateUserReq) Write201(w http.ResponseWriter, resp *CreateUser201) err
 
 }
 
+// Write201 writes the CreateUser201 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *CreateUserReq) Write201(w http.ResponseWriter, resp *CreateUser201) error {
+	return resp.writeCreateUserResponse(w)
+}
+
 func NewCreateUser400(
 
 	body *ErrorResponse,
@@ -166,10 +245,7 @@ func NewCreateUser400(
 	}
 }
 
-// Write400 writes the CreateUser400 response to the http.ResponseWriter
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *CreateUserReq) Write400(w http.ResponseWriter, resp *CreateUser400) error {
+func (resp *CreateUser400) writeCreateUserResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
 	// Set Content-Type
@@ -183,24 +259,41 @@ func (r *CreateUserReq) Write400(w http.ResponseWriter, resp *CreateUser400) err
 
 }
 
+// Write400 writes the CreateUser400 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *CreateUserReq) Write400(w http.ResponseWriter, resp *CreateUser400) error {
+	return resp.writeCreateUserResponse(w)
+}
+
 func NewCreateUser413Response() *CreateUser413Response {
 	return &CreateUser413Response{}
 }
 
-// Write413 writes the CreateUser413Response response to the http.ResponseWriter
-//
-// RawBody is true, hence this function will only set the headers and write the status code, rest is to be done by the caller.
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *CreateUserReq) Write413(w http.ResponseWriter, resp *CreateUser413Response) error {
+func (resp *CreateUser413Response) writeCreateUserResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
-	// Set status code and write the header as there are no body to write
+	// Set status code and write the header
 	w.WriteHeader(413)
+
+	// Copy the raw body, if any, otherwise writing it is left to the caller
+	if resp.RawBody != nil {
+		_, err := io.Copy(w, resp.RawBody)
+		return err
+	}
 	return nil
 
 }
 
+// Write413 writes the CreateUser413Response response to the http.ResponseWriter
+//
+// RawBody is true, hence unless resp.RawBody is set, this function will only set the headers and write the status code, rest is to be done by the caller.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *CreateUserReq) Write413(w http.ResponseWriter, resp *CreateUser413Response) error {
+	return resp.writeCreateUserResponse(w)
+}
+
 func NewCreateUser500(
 
 	body *ErrorResponse,
@@ -212,10 +305,7 @@ func NewCreateUser500(
 	}
 }
 
-// Write500 writes the CreateUser500 response to the http.ResponseWriter
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *CreateUserReq) Write500(w http.ResponseWriter, resp *CreateUser500) error {
+func (resp *CreateUser500) writeCreateUserResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
 	// Set Content-Type
@@ -228,3 +318,10 @@ func (r *CreateUserReq) Write500(w http.ResponseWriter, resp *CreateUser500) err
 	return json.NewEncoder(w).Encode(resp.Body)
 
 }
+
+// Write500 writes the CreateUser500 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *CreateUserReq) Write500(w http.ResponseWriter, resp *CreateUser500) error {
+	return resp.writeCreateUserResponse(w)
+}
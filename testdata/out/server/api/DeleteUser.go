@@ -0,0 +1,253 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	DeleteUserReqHTTPMethod = "DELETE"
+	DeleteUserReqRoutePath  = "/users/{userId}"
+)
+
+// Delete a user, either as the user (API key and session token) or as an admin (admin token alone).
+type DeleteUserReq struct {
+
+	// Source: path parameter "{userId}"
+	//
+
+	// The unique identifier of the user.
+	//
+	// Required
+	UserId string
+
+	// All of the authentication methods of at least one of the following alternatives (upto AUTH-ALTERNATIVES-END) are required:
+	//   - APIKeyAuth + SessionTokenAuth
+	//   - AdminTokenAuth
+
+	// Source: header "X-App-Admin-Token"
+	//
+	// Authentication method that denotes an admin token passed in the request header.
+	//
+	// Format (enforced by the server, applied by the SDK to a bare token): Admin {token}
+	//
+	AdminTokenAuth *string
+
+	// Source: header "X-App-API-Key"
+	//
+	// Authentication method that denotes an API key passed in the request header.
+	//
+	// Format (documentation only): api_key
+	//
+	APIKeyAuth *string
+
+	// Source: header "X-App-Session-Token"
+	//
+	// Authentication method that denotes a session token passed in the request header.
+	//
+	// Format (documentation only): session_token
+	//
+	SessionTokenAuth *string
+
+	// The alternative satisfied by the request. Only the credentials of this alternative are set.
+	AuthAlternative DeleteUserAuthAlternative
+
+	// AUTH-ALTERNATIVES-END
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// DeleteUserAuthAlternative identifies one of the sets of authentication methods accepted by the DeleteUser endpoint.
+type DeleteUserAuthAlternative string
+
+const (
+	DeleteUserAuthAlternativeAPIKeyAndSessionToken DeleteUserAuthAlternative = "apiKeyAuth+sessionTokenAuth"
+	DeleteUserAuthAlternativeAdminToken            DeleteUserAuthAlternative = "adminTokenAuth"
+)
+
+// OK
+type DeleteUser200 struct {
+
+	// Response body
+	Body *DeleteUserResponseBody
+}
+
+// ParseDeleteUserReq creates a new instance of DeleteUserReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
+func ParseDeleteUserReq(w http.ResponseWriter, r *http.Request) (*DeleteUserReq, error) {
+	req := DeleteUserReq{}
+	verr := &ValidationError{}
+
+	// Parse path parameters, if any
+
+	valUserId, err := parsestringParam(r.PathValue("userId"), "userId", true)
+	if err != nil {
+		verr.addParamError(ValidationLocationPath, "userId", err)
+	} else if valUserId != nil {
+		req.UserId = *valUserId
+	}
+
+	// Parse query parameters, if any
+
+	// Parse header parameters, if any
+
+	// Required auth, if any
+
+	// Atleast one auth, if any
+
+	// All auth of one of the alternatives, if any
+
+	valAdminToken, presentAdminToken, errAdminToken := extractAdminToken(r)
+	valAPIKey, presentAPIKey, errAPIKey := extractAPIKey(r)
+	valSessionToken, presentSessionToken, errSessionToken := extractSessionToken(r)
+	// Malformed credentials are only reported if no alternative has all of its credentials present and well-formed,
+	// so that junk in the parameters of an unused alternative does not reject the request
+	anyAlternativeSatisfied := (presentAPIKey && errAPIKey == nil && presentSessionToken && errSessionToken == nil) || (presentAdminToken && errAdminToken == nil)
+
+	if presentAdminToken && errAdminToken == nil {
+		req.AdminTokenAuth = &valAdminToken
+	} else if presentAdminToken && !anyAlternativeSatisfied {
+		verr.add(ValidationLocationAuth, "X-App-Admin-Token", ValidationCodeInvalidValue, errAdminToken.Error())
+	}
+
+	if presentAPIKey && errAPIKey == nil {
+		req.APIKeyAuth = &valAPIKey
+	} else if presentAPIKey && !anyAlternativeSatisfied {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeInvalidValue, errAPIKey.Error())
+	}
+
+	if presentSessionToken && errSessionToken == nil {
+		req.SessionTokenAuth = &valSessionToken
+	} else if presentSessionToken && !anyAlternativeSatisfied {
+		verr.add(ValidationLocationAuth, "X-App-Session-Token", ValidationCodeInvalidValue, errSessionToken.Error())
+	}
+
+	if !((presentAPIKey && presentSessionToken) || (presentAdminToken)) {
+		verr.add(ValidationLocationAuth, "", ValidationCodeRequired, "all of the authentication parameters of one of the following sets are required: [X-App-API-Key, X-App-Session-Token] or [X-App-Admin-Token]")
+	}
+
+	if len(verr.Issues) > 0 {
+		return &DeleteUserReq{}, newParseError(verr)
+	}
+	return &req, nil
+}
+
+// authenticateDeleteUserReq verifies the credentials in req with the Authenticators of impl, and returns r
+// with the resulting Principals in its context.
+//
+// All of the required credentials must be valid, and at least one of the optional ones, if any.
+// With alternatives, the first one whose credentials are all present and valid is set as req.AuthAlternative.
+func authenticateDeleteUserReq(r *http.Request, impl Handler, req *DeleteUserReq) (*http.Request, error) {
+	ctx := r.Context()
+	verr := &ValidationError{}
+	var verifyErrs []error
+
+	if req.AuthAlternative == "" && req.APIKeyAuth != nil && req.SessionTokenAuth != nil {
+		altCtx, altVerified := ctx, true
+
+		if principal, err := impl.VerifyAPIKey(altCtx, *req.APIKeyAuth); err != nil {
+			altVerified = false
+			verifyErrs = append(verifyErrs, fmt.Errorf("APIKey: %w", err))
+		} else {
+			altCtx = withPrincipal(altCtx, "apiKeyAuth", principal)
+		}
+
+		if principal, err := impl.VerifySessionToken(altCtx, *req.SessionTokenAuth); err != nil {
+			altVerified = false
+			verifyErrs = append(verifyErrs, fmt.Errorf("SessionToken: %w", err))
+		} else {
+			altCtx = withPrincipal(altCtx, "sessionTokenAuth", principal)
+		}
+
+		if altVerified {
+			ctx = altCtx
+			req.AuthAlternative = DeleteUserAuthAlternativeAPIKeyAndSessionToken
+			req.AdminTokenAuth = nil
+		}
+	}
+
+	if req.AuthAlternative == "" && req.AdminTokenAuth != nil {
+		altCtx, altVerified := ctx, true
+
+		if principal, err := impl.VerifyAdminToken(altCtx, *req.AdminTokenAuth); err != nil {
+			altVerified = false
+			verifyErrs = append(verifyErrs, fmt.Errorf("AdminToken: %w", err))
+		} else {
+			altCtx = withPrincipal(altCtx, "adminTokenAuth", principal)
+		}
+
+		if altVerified {
+			ctx = altCtx
+			req.AuthAlternative = DeleteUserAuthAlternativeAdminToken
+			req.APIKeyAuth = nil
+			req.SessionTokenAuth = nil
+		}
+	}
+
+	if req.AuthAlternative == "" {
+		verr.add(ValidationLocationAuth, "", ValidationCodeInvalidValue, "none of the provided sets of credentials are valid: [X-App-API-Key, X-App-Session-Token] or [X-App-Admin-Token]")
+	}
+
+	if len(verr.Issues) > 0 {
+		return r, &ParseError{
+			Kind:       ParseErrorKindUnauthenticated,
+			Validation: verr,
+			Err:        errors.Join(verifyErrs...),
+		}
+	}
+	return r.WithContext(ctx), nil
+}
+
+// DeleteUserResponse is one of the responses defined for the DeleteUser endpoint:
+//   - 200: DeleteUser200
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type DeleteUserResponse interface {
+	// writeDeleteUserResponse writes the headers, status code and body of the response to w.
+	writeDeleteUserResponse(w http.ResponseWriter) error
+}
+
+// WriteDeleteUserResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteDeleteUserResponse(w http.ResponseWriter, resp DeleteUserResponse) error {
+	return resp.writeDeleteUserResponse(w)
+}
+
+func NewDeleteUser200(
+
+	body *DeleteUserResponseBody,
+
+) *DeleteUser200 {
+	return &DeleteUser200{
+
+		Body: body,
+	}
+}
+
+func (resp *DeleteUser200) writeDeleteUserResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(200)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write200 writes the DeleteUser200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *DeleteUserReq) Write200(w http.ResponseWriter, resp *DeleteUser200) error {
+	return resp.writeDeleteUserResponse(w)
+}
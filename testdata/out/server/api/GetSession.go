@@ -0,0 +1,264 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	GetSessionReqHTTPMethod = "GET"
+	GetSessionReqRoutePath  = "/session"
+)
+
+// Get the credential the request was authenticated with.
+type GetSessionReq struct {
+
+	// At least one of the following (upto AUTH-ANY-END) authentication methods is required
+
+	// Source: basic "Authorization"
+	//
+	// Authentication method that denotes a username and password passed in the Authorization header.
+	//
+	BasicAuth *BasicAuthCredentials
+
+	// Source: bearer "Authorization"
+	//
+	// Authentication method that denotes a bearer token passed in the Authorization header.
+	//
+	// Format (enforced by the server): ^[A-Za-z0-9._~+/-]+=*$
+	//
+	BearerTokenAuth *string
+
+	// Source: query "api_key"
+	//
+	// Authentication method that denotes an API key passed in the query string.
+	//
+	// Format (enforced by the server, applied by the SDK to a bare token): qk_{token}
+	//
+	QueryKeyAuth *string
+
+	// Source: oauth2ClientCredentials "X-App-Service-Token"
+	//
+	// Authentication method that denotes an OAuth2 access token of a backend service, obtained with the client credentials grant.
+	//
+	ServiceAuth *string
+
+	// Source: cookie "app_session"
+	//
+	// Authentication method that denotes a session passed in a cookie.
+	//
+	// Format (enforced by the server): ^v1\.(?P<token>[A-Za-z0-9-]+)$
+	//
+	SessionCookieAuth *string
+
+	// AUTH-ANY-END
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type GetSession200 struct {
+
+	// Response body
+	Body *GetSessionResponseBody
+}
+
+// ParseGetSessionReq creates a new instance of GetSessionReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
+func ParseGetSessionReq(w http.ResponseWriter, r *http.Request) (*GetSessionReq, error) {
+	req := GetSessionReq{}
+	verr := &ValidationError{}
+
+	// Parse path parameters, if any
+
+	// Parse query parameters, if any
+
+	// Parse header parameters, if any
+
+	// Required auth, if any
+
+	// Atleast one auth, if any
+
+	anyAuthParamsPresent := false
+
+	if valBasic, ok, err := extractBasic(r); ok {
+		anyAuthParamsPresent = true
+		if err != nil {
+			verr.add(ValidationLocationAuth, "Authorization", ValidationCodeInvalidValue, err.Error())
+		} else {
+			req.BasicAuth = &valBasic
+		}
+	}
+
+	if valBearerToken, ok, err := extractBearerToken(r); ok {
+		anyAuthParamsPresent = true
+		if err != nil {
+			verr.add(ValidationLocationAuth, "Authorization", ValidationCodeInvalidValue, err.Error())
+		} else {
+			req.BearerTokenAuth = &valBearerToken
+		}
+	}
+
+	if valQueryKey, ok, err := extractQueryKey(r); ok {
+		anyAuthParamsPresent = true
+		if err != nil {
+			verr.add(ValidationLocationAuth, "api_key", ValidationCodeInvalidValue, err.Error())
+		} else {
+			req.QueryKeyAuth = &valQueryKey
+		}
+	}
+
+	if valService, ok, err := extractService(r); ok {
+		anyAuthParamsPresent = true
+		if err != nil {
+			verr.add(ValidationLocationAuth, "X-App-Service-Token", ValidationCodeInvalidValue, err.Error())
+		} else {
+			req.ServiceAuth = &valService
+		}
+	}
+
+	if valSessionCookie, ok, err := extractSessionCookie(r); ok {
+		anyAuthParamsPresent = true
+		if err != nil {
+			verr.add(ValidationLocationAuth, "app_session", ValidationCodeInvalidValue, err.Error())
+		} else {
+			req.SessionCookieAuth = &valSessionCookie
+		}
+	}
+
+	if !anyAuthParamsPresent {
+		verr.add(ValidationLocationAuth, "", ValidationCodeRequired, "at least one of the following authentication parameters is required: Authorization, api_key, X-App-Service-Token, app_session")
+	}
+
+	// All auth of one of the alternatives, if any
+
+	if len(verr.Issues) > 0 {
+		return &GetSessionReq{}, newParseError(verr)
+	}
+	return &req, nil
+}
+
+// authenticateGetSessionReq verifies the credentials in req with the Authenticators of impl, and returns r
+// with the resulting Principals in its context.
+//
+// All of the required credentials must be valid, and at least one of the optional ones, if any.
+// With alternatives, the first one whose credentials are all present and valid is set as req.AuthAlternative.
+func authenticateGetSessionReq(r *http.Request, impl Handler, req *GetSessionReq) (*http.Request, error) {
+	ctx := r.Context()
+	verr := &ValidationError{}
+	var verifyErrs []error
+
+	anyAuthVerified := false
+
+	if req.BasicAuth != nil {
+		if principal, err := impl.VerifyBasic(ctx, *req.BasicAuth); err != nil {
+			verifyErrs = append(verifyErrs, fmt.Errorf("Basic: %w", err))
+		} else {
+			anyAuthVerified = true
+			ctx = withPrincipal(ctx, "basicAuth", principal)
+		}
+	}
+
+	if req.BearerTokenAuth != nil {
+		if principal, err := impl.VerifyBearerToken(ctx, *req.BearerTokenAuth); err != nil {
+			verifyErrs = append(verifyErrs, fmt.Errorf("BearerToken: %w", err))
+		} else {
+			anyAuthVerified = true
+			ctx = withPrincipal(ctx, "bearerAuth", principal)
+		}
+	}
+
+	if req.QueryKeyAuth != nil {
+		if principal, err := impl.VerifyQueryKey(ctx, *req.QueryKeyAuth); err != nil {
+			verifyErrs = append(verifyErrs, fmt.Errorf("QueryKey: %w", err))
+		} else {
+			anyAuthVerified = true
+			ctx = withPrincipal(ctx, "queryKeyAuth", principal)
+		}
+	}
+
+	if req.ServiceAuth != nil {
+		if principal, err := impl.VerifyService(ctx, *req.ServiceAuth); err != nil {
+			verifyErrs = append(verifyErrs, fmt.Errorf("Service: %w", err))
+		} else {
+			anyAuthVerified = true
+			ctx = withPrincipal(ctx, "serviceAuth", principal)
+		}
+	}
+
+	if req.SessionCookieAuth != nil {
+		if principal, err := impl.VerifySessionCookie(ctx, *req.SessionCookieAuth); err != nil {
+			verifyErrs = append(verifyErrs, fmt.Errorf("SessionCookie: %w", err))
+		} else {
+			anyAuthVerified = true
+			ctx = withPrincipal(ctx, "sessionCookieAuth", principal)
+		}
+	}
+
+	if !anyAuthVerified {
+		verr.add(ValidationLocationAuth, "", ValidationCodeInvalidValue, "none of the provided credentials are valid: Authorization, api_key, X-App-Service-Token, app_session")
+	}
+
+	if len(verr.Issues) > 0 {
+		return r, &ParseError{
+			Kind:       ParseErrorKindUnauthenticated,
+			Validation: verr,
+			Err:        errors.Join(verifyErrs...),
+		}
+	}
+	return r.WithContext(ctx), nil
+}
+
+// GetSessionResponse is one of the responses defined for the GetSession endpoint:
+//   - 200: GetSession200
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type GetSessionResponse interface {
+	// writeGetSessionResponse writes the headers, status code and body of the response to w.
+	writeGetSessionResponse(w http.ResponseWriter) error
+}
+
+// WriteGetSessionResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteGetSessionResponse(w http.ResponseWriter, resp GetSessionResponse) error {
+	return resp.writeGetSessionResponse(w)
+}
+
+func NewGetSession200(
+
+	body *GetSessionResponseBody,
+
+) *GetSession200 {
+	return &GetSession200{
+
+		Body: body,
+	}
+}
+
+func (resp *GetSession200) writeGetSessionResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(200)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write200 writes the GetSession200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *GetSessionReq) Write200(w http.ResponseWriter, resp *GetSession200) error {
+	return resp.writeGetSessionResponse(w)
+}
@@ -2,9 +2,9 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 )
 
 const (
@@ -30,7 +30,7 @@ type GetUserReq struct {
 	//
 	// Authentication method that denotes an API key passed in the request header.
 	//
-	// Format (NOT ENFORCED): api_key
+	// Format (documentation only): api_key
 	//
 	APIKeyAuth string
 
@@ -39,7 +39,7 @@ type GetUserReq struct {
 	//
 	// Authentication method that denotes a session token passed in the request header.
 	//
-	// Format (NOT ENFORCED): session_token
+	// Format (documentation only): session_token
 	//
 	SessionTokenAuth string
 
@@ -79,49 +79,107 @@ type GetUser500 struct {
 }
 
 // ParseGetUserReq creates a new instance of GetUserReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
 func ParseGetUserReq(w http.ResponseWriter, r *http.Request) (*GetUserReq, error) {
 	req := GetUserReq{}
-	var err error
-	// to silence unused variable error in case there are no parameters to parse
-	_ = err
+	verr := &ValidationError{}
 
 	// Parse path parameters, if any
 
-	var valUserId *string
-	valUserId, err = parsestringParam(r.PathValue("userId"), "path: userId", true)
+	valUserId, err := parsestringParam(r.PathValue("userId"), "userId", true)
 	if err != nil {
-		return &GetUserReq{}, err
+		verr.addParamError(ValidationLocationPath, "userId", err)
+	} else if valUserId != nil {
+		req.UserId = *valUserId
 	}
 
-	req.UserId = *valUserId
-
 	// Parse query parameters, if any
 
 	// Parse header parameters, if any
 
 	// Required auth, if any
 
-	valAPIKey := r.Header.Get("X-App-API-Key")
-	valAPIKey = strings.TrimSpace(valAPIKey)
-	if valAPIKey == "" {
-		return &GetUserReq{}, fmt.Errorf("missing required authentication: header X-App-API-Key")
+	if valAPIKey, ok, err := extractAPIKey(r); !ok {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeRequired, "missing required authentication: header X-App-API-Key")
+	} else if err != nil {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeInvalidValue, err.Error())
 	} else {
 		req.APIKeyAuth = valAPIKey
 	}
 
-	valSessionToken := r.Header.Get("X-App-Session-Token")
-	valSessionToken = strings.TrimSpace(valSessionToken)
-	if valSessionToken == "" {
-		return &GetUserReq{}, fmt.Errorf("missing required authentication: header X-App-Session-Token")
+	if valSessionToken, ok, err := extractSessionToken(r); !ok {
+		verr.add(ValidationLocationAuth, "X-App-Session-Token", ValidationCodeRequired, "missing required authentication: header X-App-Session-Token")
+	} else if err != nil {
+		verr.add(ValidationLocationAuth, "X-App-Session-Token", ValidationCodeInvalidValue, err.Error())
 	} else {
 		req.SessionTokenAuth = valSessionToken
 	}
 
 	// Atleast one auth, if any
 
+	// All auth of one of the alternatives, if any
+
+	if len(verr.Issues) > 0 {
+		return &GetUserReq{}, newParseError(verr)
+	}
 	return &req, nil
 }
 
+// authenticateGetUserReq verifies the credentials in req with the Authenticators of impl, and returns r
+// with the resulting Principals in its context.
+//
+// All of the required credentials must be valid, and at least one of the optional ones, if any.
+// With alternatives, the first one whose credentials are all present and valid is set as req.AuthAlternative.
+func authenticateGetUserReq(r *http.Request, impl Handler, req *GetUserReq) (*http.Request, error) {
+	ctx := r.Context()
+	verr := &ValidationError{}
+	var verifyErrs []error
+
+	if principal, err := impl.VerifyAPIKey(ctx, req.APIKeyAuth); err != nil {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeInvalidValue, "invalid credentials: X-App-API-Key")
+		verifyErrs = append(verifyErrs, fmt.Errorf("APIKey: %w", err))
+	} else {
+		ctx = withPrincipal(ctx, "apiKeyAuth", principal)
+	}
+
+	if principal, err := impl.VerifySessionToken(ctx, req.SessionTokenAuth); err != nil {
+		verr.add(ValidationLocationAuth, "X-App-Session-Token", ValidationCodeInvalidValue, "invalid credentials: X-App-Session-Token")
+		verifyErrs = append(verifyErrs, fmt.Errorf("SessionToken: %w", err))
+	} else {
+		ctx = withPrincipal(ctx, "sessionTokenAuth", principal)
+	}
+
+	if len(verr.Issues) > 0 {
+		return r, &ParseError{
+			Kind:       ParseErrorKindUnauthenticated,
+			Validation: verr,
+			Err:        errors.Join(verifyErrs...),
+		}
+	}
+	return r.WithContext(ctx), nil
+}
+
+// GetUserResponse is one of the responses defined for the GetUser endpoint:
+//   - 200: GetUser200
+//   - 400: GetUser400
+//   - 404: GetUser404
+//   - 500: GetUser500
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type GetUserResponse interface {
+	// writeGetUserResponse writes the headers, status code and body of the response to w.
+	writeGetUserResponse(w http.ResponseWriter) error
+}
+
+// WriteGetUserResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteGetUserResponse(w http.ResponseWriter, resp GetUserResponse) error {
+	return resp.writeGetUserResponse(w)
+}
+
 func NewGetUser200(
 
 	body *User,
@@ -133,10 +191,7 @@ func NewGetUser200(
 	}
 }
 
-// Write200 writes the GetUser200 response to the http.ResponseWriter
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *GetUserReq) Write200(w http.ResponseWriter, resp *GetUser200) error {
+func (resp *GetUser200) writeGetUserResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
 	// Set Content-Type
@@ -150,6 +205,13 @@ func (r *GetUserReq) Write200(w http.ResponseWriter, resp *GetUser200) error {
 
 }
 
+// Write200 writes the GetUser200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *GetUserReq) Write200(w http.ResponseWriter, resp *GetUser200) error {
+	return resp.writeGetUserResponse(w)
+}
+
 func NewGetUser400(
 
 	body *ErrorResponse,
@@ -161,10 +223,7 @@ func NewGetUser400(
 	}
 }
 
-// Write400 writes the GetUser400 response to the http.ResponseWriter
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *GetUserReq) Write400(w http.ResponseWriter, resp *GetUser400) error {
+func (resp *GetUser400) writeGetUserResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
 	// Set Content-Type
@@ -178,6 +237,13 @@ func (r *GetUserReq) Write400(w http.ResponseWriter, resp *GetUser400) error {
 
 }
 
+// Write400 writes the GetUser400 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *GetUserReq) Write400(w http.ResponseWriter, resp *GetUser400) error {
+	return resp.writeGetUserResponse(w)
+}
+
 func NewGetUser404(
 
 	body *ErrorResponse,
@@ -189,10 +255,7 @@ func NewGetUser404(
 	}
 }
 
-// Write404 writes the GetUser404 response to the http.ResponseWriter
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *GetUserReq) Write404(w http.ResponseWriter, resp *GetUser404) error {
+func (resp *GetUser404) writeGetUserResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
 	// Set Content-Type
@@ -206,6 +269,13 @@ func (r *GetUserReq) Write404(w http.ResponseWriter, resp *GetUser404) error {
 
 }
 
+// Write404 writes the GetUser404 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *GetUserReq) Write404(w http.ResponseWriter, resp *GetUser404) error {
+	return resp.writeGetUserResponse(w)
+}
+
 func NewGetUser500(
 
 	body *ErrorResponse,
@@ -217,10 +287,7 @@ func NewGetUser500(
 	}
 }
 
-// Write500 writes the GetUser500 response to the http.ResponseWriter
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *GetUserReq) Write500(w http.ResponseWriter, resp *GetUser500) error {
+func (resp *GetUser500) writeGetUserResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
 	// Set Content-Type
@@ -233,3 +300,10 @@ func (r *GetUserReq) Write500(w http.ResponseWriter, resp *GetUser500) error {
 	return json.NewEncoder(w).Encode(resp.Body)
 
 }
+
+// Write500 writes the GetUser500 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *GetUserReq) Write500(w http.ResponseWriter, resp *GetUser500) error {
+	return resp.writeGetUserResponse(w)
+}
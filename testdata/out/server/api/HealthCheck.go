@@ -25,11 +25,12 @@ type HealthCheck200 struct {
 }
 
 // ParseHealthCheckReq creates a new instance of HealthCheckReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
 func ParseHealthCheckReq(w http.ResponseWriter, r *http.Request) (*HealthCheckReq, error) {
 	req := HealthCheckReq{}
-	var err error
-	// to silence unused variable error in case there are no parameters to parse
-	_ = err
+	verr := &ValidationError{}
 
 	// Parse path parameters, if any
 
@@ -41,9 +42,30 @@ func ParseHealthCheckReq(w http.ResponseWriter, r *http.Request) (*HealthCheckRe
 
 	// Atleast one auth, if any
 
+	// All auth of one of the alternatives, if any
+
+	if len(verr.Issues) > 0 {
+		return &HealthCheckReq{}, newParseError(verr)
+	}
 	return &req, nil
 }
 
+// HealthCheckResponse is one of the responses defined for the HealthCheck endpoint:
+//   - 200: HealthCheck200
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type HealthCheckResponse interface {
+	// writeHealthCheckResponse writes the headers, status code and body of the response to w.
+	writeHealthCheckResponse(w http.ResponseWriter) error
+}
+
+// WriteHealthCheckResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteHealthCheckResponse(w http.ResponseWriter, resp HealthCheckResponse) error {
+	return resp.writeHealthCheckResponse(w)
+}
+
 func NewHealthCheck200(
 
 	body *HealthCheckResponseBody,
@@ -55,10 +77,7 @@ func NewHealthCheck200(
 	}
 }
 
-// Write200 writes the HealthCheck200 response to the http.ResponseWriter
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *HealthCheckReq) Write200(w http.ResponseWriter, resp *HealthCheck200) error {
+func (resp *HealthCheck200) writeHealthCheckResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
 	// Set Content-Type
@@ -71,3 +90,10 @@ func (r *HealthCheckReq) Write200(w http.ResponseWriter, resp *HealthCheck200) e
 	return json.NewEncoder(w).Encode(resp.Body)
 
 }
+
+// Write200 writes the HealthCheck200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *HealthCheckReq) Write200(w http.ResponseWriter, resp *HealthCheck200) error {
+	return resp.writeHealthCheckResponse(w)
+}
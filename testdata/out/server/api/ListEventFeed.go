@@ -0,0 +1,130 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	ListEventFeedReqHTTPMethod = "GET"
+	ListEventFeedReqRoutePath  = "/events/feed"
+)
+
+// List events, with the cursor returned by the previous page.
+type ListEventFeedReq struct {
+
+	// Source: query parameter "cursor"
+	//
+
+	// The cursor of the page, from the NextCursor of the previous one. Default = the first page.
+	//
+	// Optional
+	Cursor *string
+
+	// Source: query parameter "limit"
+	//
+
+	// The maximum number of events of the page. Default = 10.
+	//
+	// Optional
+	Limit *int64
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type ListEventFeed200 struct {
+
+	// Response body
+	Body *EventFeed
+}
+
+// ParseListEventFeedReq creates a new instance of ListEventFeedReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
+func ParseListEventFeedReq(w http.ResponseWriter, r *http.Request) (*ListEventFeedReq, error) {
+	req := ListEventFeedReq{}
+	verr := &ValidationError{}
+
+	// Parse path parameters, if any
+
+	// Parse query parameters, if any
+
+	valCursor, err := parsestringParam(r.URL.Query().Get("cursor"), "cursor", false)
+	if err != nil {
+		verr.addParamError(ValidationLocationQuery, "cursor", err)
+	} else if valCursor != nil {
+		req.Cursor = valCursor
+	}
+
+	valLimit, err := parseint64Param(r.URL.Query().Get("limit"), "limit", false)
+	if err != nil {
+		verr.addParamError(ValidationLocationQuery, "limit", err)
+	} else if valLimit != nil {
+		req.Limit = valLimit
+	}
+
+	// Parse header parameters, if any
+
+	// Required auth, if any
+
+	// Atleast one auth, if any
+
+	// All auth of one of the alternatives, if any
+
+	if len(verr.Issues) > 0 {
+		return &ListEventFeedReq{}, newParseError(verr)
+	}
+	return &req, nil
+}
+
+// ListEventFeedResponse is one of the responses defined for the ListEventFeed endpoint:
+//   - 200: ListEventFeed200
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type ListEventFeedResponse interface {
+	// writeListEventFeedResponse writes the headers, status code and body of the response to w.
+	writeListEventFeedResponse(w http.ResponseWriter) error
+}
+
+// WriteListEventFeedResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteListEventFeedResponse(w http.ResponseWriter, resp ListEventFeedResponse) error {
+	return resp.writeListEventFeedResponse(w)
+}
+
+func NewListEventFeed200(
+
+	body *EventFeed,
+
+) *ListEventFeed200 {
+	return &ListEventFeed200{
+
+		Body: body,
+	}
+}
+
+func (resp *ListEventFeed200) writeListEventFeedResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(200)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write200 writes the ListEventFeed200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *ListEventFeedReq) Write200(w http.ResponseWriter, resp *ListEventFeed200) error {
+	return resp.writeListEventFeedResponse(w)
+}
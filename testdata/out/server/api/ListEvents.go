@@ -0,0 +1,130 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	ListEventsReqHTTPMethod = "GET"
+	ListEventsReqRoutePath  = "/events"
+)
+
+// List events, by the offset of the first one.
+type ListEventsReq struct {
+
+	// Source: query parameter "limit"
+	//
+
+	// The maximum number of events of the page. Default = 10.
+	//
+	// Optional
+	Limit *int64
+
+	// Source: query parameter "offset"
+	//
+
+	// The offset of the first event of the page. Default = 0.
+	//
+	// Optional
+	Offset *int64
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type ListEvents200 struct {
+
+	// Response body
+	Body *EventPage
+}
+
+// ParseListEventsReq creates a new instance of ListEventsReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
+func ParseListEventsReq(w http.ResponseWriter, r *http.Request) (*ListEventsReq, error) {
+	req := ListEventsReq{}
+	verr := &ValidationError{}
+
+	// Parse path parameters, if any
+
+	// Parse query parameters, if any
+
+	valLimit, err := parseint64Param(r.URL.Query().Get("limit"), "limit", false)
+	if err != nil {
+		verr.addParamError(ValidationLocationQuery, "limit", err)
+	} else if valLimit != nil {
+		req.Limit = valLimit
+	}
+
+	valOffset, err := parseint64Param(r.URL.Query().Get("offset"), "offset", false)
+	if err != nil {
+		verr.addParamError(ValidationLocationQuery, "offset", err)
+	} else if valOffset != nil {
+		req.Offset = valOffset
+	}
+
+	// Parse header parameters, if any
+
+	// Required auth, if any
+
+	// Atleast one auth, if any
+
+	// All auth of one of the alternatives, if any
+
+	if len(verr.Issues) > 0 {
+		return &ListEventsReq{}, newParseError(verr)
+	}
+	return &req, nil
+}
+
+// ListEventsResponse is one of the responses defined for the ListEvents endpoint:
+//   - 200: ListEvents200
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type ListEventsResponse interface {
+	// writeListEventsResponse writes the headers, status code and body of the response to w.
+	writeListEventsResponse(w http.ResponseWriter) error
+}
+
+// WriteListEventsResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteListEventsResponse(w http.ResponseWriter, resp ListEventsResponse) error {
+	return resp.writeListEventsResponse(w)
+}
+
+func NewListEvents200(
+
+	body *EventPage,
+
+) *ListEvents200 {
+	return &ListEvents200{
+
+		Body: body,
+	}
+}
+
+func (resp *ListEvents200) writeListEventsResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(200)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write200 writes the ListEvents200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *ListEventsReq) Write200(w http.ResponseWriter, resp *ListEvents200) error {
+	return resp.writeListEventsResponse(w)
+}
@@ -2,9 +2,9 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 )
 
 const (
@@ -38,7 +38,7 @@ type ListUsersReq struct {
 	//
 	// Authentication method that denotes an admin token passed in the request header.
 	//
-	// Format (NOT ENFORCED): admin_token
+	// Format (enforced by the server, applied by the SDK to a bare token): Admin {token}
 	//
 	AdminTokenAuth string
 
@@ -47,7 +47,7 @@ type ListUsersReq struct {
 	//
 	// Authentication method that denotes an API key passed in the request header.
 	//
-	// Format (NOT ENFORCED): api_key
+	// Format (documentation only): api_key
 	//
 	APIKeyAuth string
 
@@ -88,57 +88,113 @@ type ListUsers500 struct {
 }
 
 // ParseListUsersReq creates a new instance of ListUsersReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
 func ParseListUsersReq(w http.ResponseWriter, r *http.Request) (*ListUsersReq, error) {
 	req := ListUsersReq{}
-	var err error
-	// to silence unused variable error in case there are no parameters to parse
-	_ = err
+	verr := &ValidationError{}
 
 	// Parse path parameters, if any
 
 	// Parse query parameters, if any
 
-	var valPageNumber *int64
-	valPageNumber, err = parseint64Param(r.URL.Query().Get("page"), "query: page", false)
+	valPageNumber, err := parseint64Param(r.URL.Query().Get("page"), "page", false)
 	if err != nil {
-		return &ListUsersReq{}, err
+		verr.addParamError(ValidationLocationQuery, "page", err)
+	} else if valPageNumber != nil {
+		req.PageNumber = valPageNumber
 	}
 
-	req.PageNumber = valPageNumber
-
-	var valPageSize *int64
-	valPageSize, err = parseint64Param(r.URL.Query().Get("pageSize"), "query: pageSize", false)
+	valPageSize, err := parseint64Param(r.URL.Query().Get("pageSize"), "pageSize", false)
 	if err != nil {
-		return &ListUsersReq{}, err
+		verr.addParamError(ValidationLocationQuery, "pageSize", err)
+	} else if valPageSize != nil {
+		req.PageSize = valPageSize
 	}
 
-	req.PageSize = valPageSize
-
 	// Parse header parameters, if any
 
 	// Required auth, if any
 
-	valAdminToken := r.Header.Get("X-App-Admin-Token")
-	valAdminToken = strings.TrimSpace(valAdminToken)
-	if valAdminToken == "" {
-		return &ListUsersReq{}, fmt.Errorf("missing required authentication: header X-App-Admin-Token")
+	if valAdminToken, ok, err := extractAdminToken(r); !ok {
+		verr.add(ValidationLocationAuth, "X-App-Admin-Token", ValidationCodeRequired, "missing required authentication: header X-App-Admin-Token")
+	} else if err != nil {
+		verr.add(ValidationLocationAuth, "X-App-Admin-Token", ValidationCodeInvalidValue, err.Error())
 	} else {
 		req.AdminTokenAuth = valAdminToken
 	}
 
-	valAPIKey := r.Header.Get("X-App-API-Key")
-	valAPIKey = strings.TrimSpace(valAPIKey)
-	if valAPIKey == "" {
-		return &ListUsersReq{}, fmt.Errorf("missing required authentication: header X-App-API-Key")
+	if valAPIKey, ok, err := extractAPIKey(r); !ok {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeRequired, "missing required authentication: header X-App-API-Key")
+	} else if err != nil {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeInvalidValue, err.Error())
 	} else {
 		req.APIKeyAuth = valAPIKey
 	}
 
 	// Atleast one auth, if any
 
+	// All auth of one of the alternatives, if any
+
+	if len(verr.Issues) > 0 {
+		return &ListUsersReq{}, newParseError(verr)
+	}
 	return &req, nil
 }
 
+// authenticateListUsersReq verifies the credentials in req with the Authenticators of impl, and returns r
+// with the resulting Principals in its context.
+//
+// All of the required credentials must be valid, and at least one of the optional ones, if any.
+// With alternatives, the first one whose credentials are all present and valid is set as req.AuthAlternative.
+func authenticateListUsersReq(r *http.Request, impl Handler, req *ListUsersReq) (*http.Request, error) {
+	ctx := r.Context()
+	verr := &ValidationError{}
+	var verifyErrs []error
+
+	if principal, err := impl.VerifyAdminToken(ctx, req.AdminTokenAuth); err != nil {
+		verr.add(ValidationLocationAuth, "X-App-Admin-Token", ValidationCodeInvalidValue, "invalid credentials: X-App-Admin-Token")
+		verifyErrs = append(verifyErrs, fmt.Errorf("AdminToken: %w", err))
+	} else {
+		ctx = withPrincipal(ctx, "adminTokenAuth", principal)
+	}
+
+	if principal, err := impl.VerifyAPIKey(ctx, req.APIKeyAuth); err != nil {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeInvalidValue, "invalid credentials: X-App-API-Key")
+		verifyErrs = append(verifyErrs, fmt.Errorf("APIKey: %w", err))
+	} else {
+		ctx = withPrincipal(ctx, "apiKeyAuth", principal)
+	}
+
+	if len(verr.Issues) > 0 {
+		return r, &ParseError{
+			Kind:       ParseErrorKindUnauthenticated,
+			Validation: verr,
+			Err:        errors.Join(verifyErrs...),
+		}
+	}
+	return r.WithContext(ctx), nil
+}
+
+// ListUsersResponse is one of the responses defined for the ListUsers endpoint:
+//   - 200: ListUsers200
+//   - 400: ListUsers400
+//   - 500: ListUsers500
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type ListUsersResponse interface {
+	// writeListUsersResponse writes the headers, status code and body of the response to w.
+	writeListUsersResponse(w http.ResponseWriter) error
+}
+
+// WriteListUsersResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteListUsersResponse(w http.ResponseWriter, resp ListUsersResponse) error {
+	return resp.writeListUsersResponse(w)
+}
+
 func NewListUsers200(
 
 	RateLimitRemaining int64,
@@ -154,10 +210,7 @@ func NewListUsers200(
 	}
 }
 
-// Write200 writes the ListUsers200 response to the http.ResponseWriter
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *ListUsersReq) Write200(w http.ResponseWriter, resp *ListUsers200) error {
+func (resp *ListUsers200) writeListUsersResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
 	w.Header().Set("X-RateLimit-Remaining", fmt.Sprintf("%v", resp.RateLimitRemaining))
@@ -173,6 +226,13 @@ func (r *ListUsersReq) Write200(w http.ResponseWriter, resp *ListUsers200) error
 
 }
 
+// Write200 writes the ListUsers200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *ListUsersReq) Write200(w http.ResponseWriter, resp *ListUsers200) error {
+	return resp.writeListUsersResponse(w)
+}
+
 func NewListUsers400(
 
 	body *ErrorResponse,
@@ -184,10 +244,7 @@ func NewListUsers400(
 	}
 }
 
-// Write400 writes the ListUsers400 response to the http.ResponseWriter
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *ListUsersReq) Write400(w http.ResponseWriter, resp *ListUsers400) error {
+func (resp *ListUsers400) writeListUsersResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
 	// Set Content-Type
@@ -201,6 +258,13 @@ func (r *ListUsersReq) Write400(w http.ResponseWriter, resp *ListUsers400) error
 
 }
 
+// Write400 writes the ListUsers400 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *ListUsersReq) Write400(w http.ResponseWriter, resp *ListUsers400) error {
+	return resp.writeListUsersResponse(w)
+}
+
 func NewListUsers500(
 
 	body *ErrorResponse,
@@ -212,10 +276,7 @@ func NewListUsers500(
 	}
 }
 
-// Write500 writes the ListUsers500 response to the http.ResponseWriter
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *ListUsersReq) Write500(w http.ResponseWriter, resp *ListUsers500) error {
+func (resp *ListUsers500) writeListUsersResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
 	// Set Content-Type
@@ -228,3 +289,10 @@ func (r *ListUsersReq) Write500(w http.ResponseWriter, resp *ListUsers500) error
 	return json.NewEncoder(w).Encode(resp.Body)
 
 }
+
+// Write500 writes the ListUsers500 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *ListUsersReq) Write500(w http.ResponseWriter, resp *ListUsers500) error {
+	return resp.writeListUsersResponse(w)
+}
@@ -2,9 +2,9 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 )
 
 const (
@@ -22,7 +22,7 @@ type LogoutUserReq struct {
 	//
 	// Authentication method that denotes an API key passed in the request header.
 	//
-	// Format (NOT ENFORCED): api_key
+	// Format (documentation only): api_key
 	//
 	APIKeyAuth string
 
@@ -34,7 +34,7 @@ type LogoutUserReq struct {
 	//
 	// Authentication method that denotes a refresh token passed in the request header.
 	//
-	// Format (NOT ENFORCED): refresh_token
+	// Format (documentation only): refresh_token
 	//
 	RefreshTokenAuth *string
 
@@ -42,7 +42,7 @@ type LogoutUserReq struct {
 	//
 	// Authentication method that denotes a session token passed in the request header.
 	//
-	// Format (NOT ENFORCED): session_token
+	// Format (documentation only): session_token
 	//
 	SessionTokenAuth *string
 
@@ -75,11 +75,12 @@ type LogoutUser500 struct {
 }
 
 // ParseLogoutUserReq creates a new instance of LogoutUserReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
 func ParseLogoutUserReq(w http.ResponseWriter, r *http.Request) (*LogoutUserReq, error) {
 	req := LogoutUserReq{}
-	var err error
-	// to silence unused variable error in case there are no parameters to parse
-	_ = err
+	verr := &ValidationError{}
 
 	// Parse path parameters, if any
 
@@ -89,10 +90,10 @@ func ParseLogoutUserReq(w http.ResponseWriter, r *http.Request) (*LogoutUserReq,
 
 	// Required auth, if any
 
-	valAPIKey := r.Header.Get("X-App-API-Key")
-	valAPIKey = strings.TrimSpace(valAPIKey)
-	if valAPIKey == "" {
-		return &LogoutUserReq{}, fmt.Errorf("missing required authentication: header X-App-API-Key")
+	if valAPIKey, ok, err := extractAPIKey(r); !ok {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeRequired, "missing required authentication: header X-App-API-Key")
+	} else if err != nil {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeInvalidValue, err.Error())
 	} else {
 		req.APIKeyAuth = valAPIKey
 	}
@@ -100,29 +101,106 @@ func ParseLogoutUserReq(w http.ResponseWriter, r *http.Request) (*LogoutUserReq,
 	// Atleast one auth, if any
 
 	anyAuthParamsPresent := false
-	authParamsList := "X-App-Refresh-Token, X-App-Session-Token, "
 
-	valRefreshToken := r.Header.Get("X-App-Refresh-Token")
-	valRefreshToken = strings.TrimSpace(valRefreshToken)
-	if valRefreshToken != "" {
+	if valRefreshToken, ok, err := extractRefreshToken(r); ok {
 		anyAuthParamsPresent = true
-		req.RefreshTokenAuth = &valRefreshToken
+		if err != nil {
+			verr.add(ValidationLocationAuth, "X-App-Refresh-Token", ValidationCodeInvalidValue, err.Error())
+		} else {
+			req.RefreshTokenAuth = &valRefreshToken
+		}
 	}
 
-	valSessionToken := r.Header.Get("X-App-Session-Token")
-	valSessionToken = strings.TrimSpace(valSessionToken)
-	if valSessionToken != "" {
+	if valSessionToken, ok, err := extractSessionToken(r); ok {
 		anyAuthParamsPresent = true
-		req.SessionTokenAuth = &valSessionToken
+		if err != nil {
+			verr.add(ValidationLocationAuth, "X-App-Session-Token", ValidationCodeInvalidValue, err.Error())
+		} else {
+			req.SessionTokenAuth = &valSessionToken
+		}
 	}
 
 	if !anyAuthParamsPresent {
-		return &LogoutUserReq{}, fmt.Errorf("at least one of the following authentication parameters is required: %s", authParamsList)
+		verr.add(ValidationLocationAuth, "", ValidationCodeRequired, "at least one of the following authentication parameters is required: X-App-Refresh-Token, X-App-Session-Token")
 	}
 
+	// All auth of one of the alternatives, if any
+
+	if len(verr.Issues) > 0 {
+		return &LogoutUserReq{}, newParseError(verr)
+	}
 	return &req, nil
 }
 
+// authenticateLogoutUserReq verifies the credentials in req with the Authenticators of impl, and returns r
+// with the resulting Principals in its context.
+//
+// All of the required credentials must be valid, and at least one of the optional ones, if any.
+// With alternatives, the first one whose credentials are all present and valid is set as req.AuthAlternative.
+func authenticateLogoutUserReq(r *http.Request, impl Handler, req *LogoutUserReq) (*http.Request, error) {
+	ctx := r.Context()
+	verr := &ValidationError{}
+	var verifyErrs []error
+
+	if principal, err := impl.VerifyAPIKey(ctx, req.APIKeyAuth); err != nil {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeInvalidValue, "invalid credentials: X-App-API-Key")
+		verifyErrs = append(verifyErrs, fmt.Errorf("APIKey: %w", err))
+	} else {
+		ctx = withPrincipal(ctx, "apiKeyAuth", principal)
+	}
+
+	anyAuthVerified := false
+
+	if req.RefreshTokenAuth != nil {
+		if principal, err := impl.VerifyRefreshToken(ctx, *req.RefreshTokenAuth); err != nil {
+			verifyErrs = append(verifyErrs, fmt.Errorf("RefreshToken: %w", err))
+		} else {
+			anyAuthVerified = true
+			ctx = withPrincipal(ctx, "refreshTokenAuth", principal)
+		}
+	}
+
+	if req.SessionTokenAuth != nil {
+		if principal, err := impl.VerifySessionToken(ctx, *req.SessionTokenAuth); err != nil {
+			verifyErrs = append(verifyErrs, fmt.Errorf("SessionToken: %w", err))
+		} else {
+			anyAuthVerified = true
+			ctx = withPrincipal(ctx, "sessionTokenAuth", principal)
+		}
+	}
+
+	if !anyAuthVerified {
+		verr.add(ValidationLocationAuth, "", ValidationCodeInvalidValue, "none of the provided credentials are valid: X-App-Refresh-Token, X-App-Session-Token")
+	}
+
+	if len(verr.Issues) > 0 {
+		return r, &ParseError{
+			Kind:       ParseErrorKindUnauthenticated,
+			Validation: verr,
+			Err:        errors.Join(verifyErrs...),
+		}
+	}
+	return r.WithContext(ctx), nil
+}
+
+// LogoutUserResponse is one of the responses defined for the LogoutUser endpoint:
+//   - 200: LogoutUser200
+//   - 400: LogoutUser400
+//   - 500: LogoutUser500
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type LogoutUserResponse interface {
+	// writeLogoutUserResponse writes the headers, status code and body of the response to w.
+	writeLogoutUserResponse(w http.ResponseWriter) error
+}
+
+// WriteLogoutUserResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteLogoutUserResponse(w http.ResponseWriter, resp LogoutUserResponse) error {
+	return resp.writeLogoutUserResponse(w)
+}
+
 func NewLogoutUser200(
 
 	body *LogoutUserResponseBody,
@@ -134,10 +212,7 @@ func NewLogoutUser200(
 	}
 }
 
-// Write200 writes the LogoutUser200 response to the http.ResponseWriter
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *LogoutUserReq) Write200(w http.ResponseWriter, resp *LogoutUser200) error {
+func (resp *LogoutUser200) writeLogoutUserResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
 	// Set Content-Type
@@ -151,6 +226,13 @@ func (r *LogoutUserReq) Write200(w http.ResponseWriter, resp *LogoutUser200) err
 
 }
 
+// Write200 writes the LogoutUser200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *LogoutUserReq) Write200(w http.ResponseWriter, resp *LogoutUser200) error {
+	return resp.writeLogoutUserResponse(w)
+}
+
 func NewLogoutUser400(
 
 	body *ErrorResponse,
@@ -162,10 +244,7 @@ func NewLogoutUser400(
 	}
 }
 
-// Write400 writes the LogoutUser400 response to the http.ResponseWriter
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *LogoutUserReq) Write400(w http.ResponseWriter, resp *LogoutUser400) error {
+func (resp *LogoutUser400) writeLogoutUserResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
 	// Set Content-Type
@@ -179,6 +258,13 @@ func (r *LogoutUserReq) Write400(w http.ResponseWriter, resp *LogoutUser400) err
 
 }
 
+// Write400 writes the LogoutUser400 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *LogoutUserReq) Write400(w http.ResponseWriter, resp *LogoutUser400) error {
+	return resp.writeLogoutUserResponse(w)
+}
+
 func NewLogoutUser500(
 
 	body *ErrorResponse,
@@ -190,10 +276,7 @@ func NewLogoutUser500(
 	}
 }
 
-// Write500 writes the LogoutUser500 response to the http.ResponseWriter
-//
-// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
-func (r *LogoutUserReq) Write500(w http.ResponseWriter, resp *LogoutUser500) error {
+func (resp *LogoutUser500) writeLogoutUserResponse(w http.ResponseWriter) error {
 	// Set headers, if any
 
 	// Set Content-Type
@@ -206,3 +289,10 @@ func (r *LogoutUserReq) Write500(w http.ResponseWriter, resp *LogoutUser500) err
 	return json.NewEncoder(w).Encode(resp.Body)
 
 }
+
+// Write500 writes the LogoutUser500 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *LogoutUserReq) Write500(w http.ResponseWriter, resp *LogoutUser500) error {
+	return resp.writeLogoutUserResponse(w)
+}
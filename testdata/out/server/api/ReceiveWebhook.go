@@ -0,0 +1,221 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+)
+
+const (
+	ReceiveWebhookReqHTTPMethod = "POST"
+	ReceiveWebhookReqRoutePath  = "/webhooks"
+)
+
+// Receive a webhook delivery signed with the webhook secret.
+type ReceiveWebhookReq struct {
+
+	// All of the below (upto AUTH-ALL-END comment) are required for authentication
+
+	// Required Authentication Method
+	// Source: hmac "X-Signature"
+	//
+	// Authentication method that denotes a webhook delivery signed with a shared secret.
+	//
+	WebhookSignatureAuth HMACSignature
+
+	// AUTH-ALL-END
+
+	// Request body
+	Body *ReceiveWebhookRequestBody
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type ReceiveWebhook200 struct {
+
+	// Response body
+	Body *ReceiveWebhookResponseBody
+}
+
+// Payload Too Large - the request body exceeds the maximum allowed size
+type ReceiveWebhook413Response struct {
+
+	// Raw response body. If set, it is copied to the http.ResponseWriter after the headers and status code are written.
+	// If nil, only the headers and status code are written, and writing the body is the responsibility of the caller.
+	RawBody io.Reader
+}
+
+// ParseReceiveWebhookReq creates a new instance of ReceiveWebhookReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
+func ParseReceiveWebhookReq(w http.ResponseWriter, r *http.Request) (*ReceiveWebhookReq, error) {
+	req := ReceiveWebhookReq{}
+	verr := &ValidationError{}
+
+	// Parse path parameters, if any
+
+	// Parse query parameters, if any
+
+	// Parse header parameters, if any
+
+	// Limit the request body before anything reads it: the hmac auth, if any, hashes it before it is parsed
+
+	maxBodyBytes := int64(256 << 10) // Default max body bytes: 256KB
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+
+	// Required auth, if any
+
+	if valWebhookSignature, ok, err := extractWebhookSignature(r); !ok {
+		verr.add(ValidationLocationAuth, "X-Signature", ValidationCodeRequired, "missing required authentication: hmac X-Signature")
+	} else if err != nil {
+		verr.add(ValidationLocationAuth, "X-Signature", ValidationCodeInvalidValue, err.Error())
+	} else {
+		req.WebhookSignatureAuth = valWebhookSignature
+	}
+
+	// Atleast one auth, if any
+
+	// All auth of one of the alternatives, if any
+
+	// Parse request body
+	defer r.Body.Close()
+	// A missing Content-Type is accepted, any other value must match the endpoint's content type.
+	supportedContentType := true
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		if mediaType, _, err := mime.ParseMediaType(contentType); err != nil || mediaType != "application/json" {
+			supportedContentType = false
+			verr.add(ValidationLocationHeader, "Content-Type", ValidationCodeUnsupportedContentType, fmt.Sprintf("unsupported content type '%s', expected 'application/json'", contentType))
+		}
+	}
+	if !supportedContentType {
+		// don't try to decode a body in a format we don't understand
+	} else if bodyData, err := io.ReadAll(r.Body); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			verr.add(ValidationLocationBody, "", ValidationCodeBodyTooLarge, fmt.Sprintf("request body exceeds the maximum allowed size of %d bytes", maxBytesErr.Limit))
+		} else {
+			verr.add(ValidationLocationBody, "", ValidationCodeMalformed, fmt.Sprintf("error reading request body: %v", err))
+		}
+	} else {
+		// Decoded straight into the struct, see ReceiveWebhookRequestBody.UnmarshalJSON
+		req.Body = new(ReceiveWebhookRequestBody)
+		req.Body.decodeJSON(bodyData, "", verr)
+	}
+
+	if len(verr.Issues) > 0 {
+		return &ReceiveWebhookReq{}, newParseError(verr)
+	}
+	return &req, nil
+}
+
+// authenticateReceiveWebhookReq verifies the credentials in req with the Authenticators of impl, and returns r
+// with the resulting Principals in its context.
+//
+// All of the required credentials must be valid, and at least one of the optional ones, if any.
+// With alternatives, the first one whose credentials are all present and valid is set as req.AuthAlternative.
+func authenticateReceiveWebhookReq(r *http.Request, impl Handler, req *ReceiveWebhookReq) (*http.Request, error) {
+	ctx := r.Context()
+	verr := &ValidationError{}
+	var verifyErrs []error
+
+	if principal, err := verifyWebhookSignature(ctx, impl, req.WebhookSignatureAuth); err != nil {
+		verr.add(ValidationLocationAuth, "X-Signature", ValidationCodeInvalidValue, "invalid credentials: X-Signature")
+		verifyErrs = append(verifyErrs, fmt.Errorf("WebhookSignature: %w", err))
+	} else {
+		ctx = withPrincipal(ctx, "webhookAuth", principal)
+	}
+
+	if len(verr.Issues) > 0 {
+		return r, &ParseError{
+			Kind:       ParseErrorKindUnauthenticated,
+			Validation: verr,
+			Err:        errors.Join(verifyErrs...),
+		}
+	}
+	return r.WithContext(ctx), nil
+}
+
+// ReceiveWebhookResponse is one of the responses defined for the ReceiveWebhook endpoint:
+//   - 200: ReceiveWebhook200
+//   - 413: ReceiveWebhook413Response
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type ReceiveWebhookResponse interface {
+	// writeReceiveWebhookResponse writes the headers, status code and body of the response to w.
+	writeReceiveWebhookResponse(w http.ResponseWriter) error
+}
+
+// WriteReceiveWebhookResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteReceiveWebhookResponse(w http.ResponseWriter, resp ReceiveWebhookResponse) error {
+	return resp.writeReceiveWebhookResponse(w)
+}
+
+func NewReceiveWebhook200(
+
+	body *ReceiveWebhookResponseBody,
+
+) *ReceiveWebhook200 {
+	return &ReceiveWebhook200{
+
+		Body: body,
+	}
+}
+
+func (resp *ReceiveWebhook200) writeReceiveWebhookResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(200)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write200 writes the ReceiveWebhook200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *ReceiveWebhookReq) Write200(w http.ResponseWriter, resp *ReceiveWebhook200) error {
+	return resp.writeReceiveWebhookResponse(w)
+}
+
+func NewReceiveWebhook413Response() *ReceiveWebhook413Response {
+	return &ReceiveWebhook413Response{}
+}
+
+func (resp *ReceiveWebhook413Response) writeReceiveWebhookResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set status code and write the header
+	w.WriteHeader(413)
+
+	// Copy the raw body, if any, otherwise writing it is left to the caller
+	if resp.RawBody != nil {
+		_, err := io.Copy(w, resp.RawBody)
+		return err
+	}
+	return nil
+
+}
+
+// Write413 writes the ReceiveWebhook413Response response to the http.ResponseWriter
+//
+// RawBody is true, hence unless resp.RawBody is set, this function will only set the headers and write the status code, rest is to be done by the caller.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *ReceiveWebhookReq) Write413(w http.ResponseWriter, resp *ReceiveWebhook413Response) error {
+	return resp.writeReceiveWebhookResponse(w)
+}
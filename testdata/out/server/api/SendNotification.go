@@ -0,0 +1,214 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+)
+
+const (
+	SendNotificationReqHTTPMethod = "POST"
+	SendNotificationReqRoutePath  = "/notifications"
+)
+
+// Send a notification, by email or SMS. Just for testing oneOf request and response bodies in the generator.
+type SendNotificationReq struct {
+
+	// Request body
+	Body Notification
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// The notification as sent.
+type SendNotification200 struct {
+
+	// Response body
+	Body Notification
+}
+
+// Bad Request
+type SendNotification400 struct {
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// Payload Too Large - the request body exceeds the maximum allowed size
+type SendNotification413Response struct {
+
+	// Raw response body. If set, it is copied to the http.ResponseWriter after the headers and status code are written.
+	// If nil, only the headers and status code are written, and writing the body is the responsibility of the caller.
+	RawBody io.Reader
+}
+
+// ParseSendNotificationReq creates a new instance of SendNotificationReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
+func ParseSendNotificationReq(w http.ResponseWriter, r *http.Request) (*SendNotificationReq, error) {
+	req := SendNotificationReq{}
+	verr := &ValidationError{}
+
+	// Parse path parameters, if any
+
+	// Parse query parameters, if any
+
+	// Parse header parameters, if any
+
+	// Limit the request body before anything reads it: the hmac auth, if any, hashes it before it is parsed
+
+	maxBodyBytes := int64(256 << 10) // Default max body bytes: 256KB
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+
+	// Required auth, if any
+
+	// Atleast one auth, if any
+
+	// All auth of one of the alternatives, if any
+
+	// Parse request body
+	defer r.Body.Close()
+	// A missing Content-Type is accepted, any other value must match the endpoint's content type.
+	supportedContentType := true
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		if mediaType, _, err := mime.ParseMediaType(contentType); err != nil || mediaType != "application/json" {
+			supportedContentType = false
+			verr.add(ValidationLocationHeader, "Content-Type", ValidationCodeUnsupportedContentType, fmt.Sprintf("unsupported content type '%s', expected 'application/json'", contentType))
+		}
+	}
+	if !supportedContentType {
+		// don't try to decode a body in a format we don't understand
+	} else if bodyData, err := io.ReadAll(r.Body); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			verr.add(ValidationLocationBody, "", ValidationCodeBodyTooLarge, fmt.Sprintf("request body exceeds the maximum allowed size of %d bytes", maxBytesErr.Limit))
+		} else {
+			verr.add(ValidationLocationBody, "", ValidationCodeMalformed, fmt.Sprintf("error reading request body: %v", err))
+		}
+	} else {
+		// Decoded straight into the variant named by the discriminator, see decodeNotification
+		req.Body = decodeNotification(bodyData, "", verr)
+	}
+
+	if len(verr.Issues) > 0 {
+		return &SendNotificationReq{}, newParseError(verr)
+	}
+	return &req, nil
+}
+
+// SendNotificationResponse is one of the responses defined for the SendNotification endpoint:
+//   - 200: SendNotification200
+//   - 400: SendNotification400
+//   - 413: SendNotification413Response
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type SendNotificationResponse interface {
+	// writeSendNotificationResponse writes the headers, status code and body of the response to w.
+	writeSendNotificationResponse(w http.ResponseWriter) error
+}
+
+// WriteSendNotificationResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteSendNotificationResponse(w http.ResponseWriter, resp SendNotificationResponse) error {
+	return resp.writeSendNotificationResponse(w)
+}
+
+func NewSendNotification200(
+
+	body Notification,
+
+) *SendNotification200 {
+	return &SendNotification200{
+
+		Body: body,
+	}
+}
+
+func (resp *SendNotification200) writeSendNotificationResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(200)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write200 writes the SendNotification200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *SendNotificationReq) Write200(w http.ResponseWriter, resp *SendNotification200) error {
+	return resp.writeSendNotificationResponse(w)
+}
+
+func NewSendNotification400(
+
+	body *ErrorResponse,
+
+) *SendNotification400 {
+	return &SendNotification400{
+
+		Body: body,
+	}
+}
+
+func (resp *SendNotification400) writeSendNotificationResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(400)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write400 writes the SendNotification400 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *SendNotificationReq) Write400(w http.ResponseWriter, resp *SendNotification400) error {
+	return resp.writeSendNotificationResponse(w)
+}
+
+func NewSendNotification413Response() *SendNotification413Response {
+	return &SendNotification413Response{}
+}
+
+func (resp *SendNotification413Response) writeSendNotificationResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set status code and write the header
+	w.WriteHeader(413)
+
+	// Copy the raw body, if any, otherwise writing it is left to the caller
+	if resp.RawBody != nil {
+		_, err := io.Copy(w, resp.RawBody)
+		return err
+	}
+	return nil
+
+}
+
+// Write413 writes the SendNotification413Response response to the http.ResponseWriter
+//
+// RawBody is true, hence unless resp.RawBody is set, this function will only set the headers and write the status code, rest is to be done by the caller.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *SendNotificationReq) Write413(w http.ResponseWriter, resp *SendNotification413Response) error {
+	return resp.writeSendNotificationResponse(w)
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	SubmitFlakyReqHTTPMethod = "POST"
+	SubmitFlakyReqRoutePath  = "/flaky/submit"
+)
+
+// Same as CreateFlaky, but made idempotent with an Idempotency-Key, so the SDKs retry it.
+type SubmitFlakyReq struct {
+
+	// Source: query parameter "failures"
+	//
+
+	// The number of requests with the key that fail.
+	//
+	// Required
+	Failures int64
+
+	// Source: query parameter "key"
+	//
+
+	// The key the requests are counted by.
+	//
+	// Required
+	Key string
+
+	// Source: header "Idempotency-Key"
+	//
+	// Required. Requests repeating the key of a completed request are answered with its stored response, see IdempotencyStore.
+	IdempotencyKey string
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type SubmitFlaky200 struct {
+
+	// Response body
+	Body *FlakyResponseBody
+}
+
+// Service Unavailable
+type SubmitFlaky503 struct {
+
+	// Source: header parameter "Retry-After"
+	//
+
+	// The number of seconds to wait before retrying.
+	//
+	// Required
+	RetryAfter int64
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// ParseSubmitFlakyReq creates a new instance of SubmitFlakyReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
+func ParseSubmitFlakyReq(w http.ResponseWriter, r *http.Request) (*SubmitFlakyReq, error) {
+	req := SubmitFlakyReq{}
+	verr := &ValidationError{}
+
+	// Parse path parameters, if any
+
+	// Parse query parameters, if any
+
+	valFailures, err := parseint64Param(r.URL.Query().Get("failures"), "failures", true)
+	if err != nil {
+		verr.addParamError(ValidationLocationQuery, "failures", err)
+	} else if valFailures != nil {
+		req.Failures = *valFailures
+	}
+
+	valKey, err := parsestringParam(r.URL.Query().Get("key"), "key", true)
+	if err != nil {
+		verr.addParamError(ValidationLocationQuery, "key", err)
+	} else if valKey != nil {
+		req.Key = *valKey
+	}
+
+	// Parse header parameters, if any
+
+	// Parse the idempotency key
+	if req.IdempotencyKey = r.Header.Get("Idempotency-Key"); req.IdempotencyKey == "" {
+		verr.addParamError(ValidationLocationHeader, "Idempotency-Key", missingParamError("Idempotency-Key"))
+	} else if len(req.IdempotencyKey) > maxIdempotencyKeyLength {
+		verr.add(ValidationLocationHeader, "Idempotency-Key", ValidationCodeInvalidValue, fmt.Sprintf("must be at most %d characters", maxIdempotencyKeyLength))
+	}
+
+	// Required auth, if any
+
+	// Atleast one auth, if any
+
+	// All auth of one of the alternatives, if any
+
+	if len(verr.Issues) > 0 {
+		return &SubmitFlakyReq{}, newParseError(verr)
+	}
+	return &req, nil
+}
+
+// idempotencyFingerprint returns what the idempotency fingerprint of req covers: its parameters, idempotency key
+// and body, and in place of its credentials, the Principals they resolved to in ctx, so that a key is never replayed
+// to another caller, but a retry with rotated credentials of the same caller is.
+func (req *SubmitFlakyReq) idempotencyFingerprint(ctx context.Context) map[string]any {
+	fingerprint := map[string]any{
+		"Failures":       req.Failures,
+		"Key":            req.Key,
+		"IdempotencyKey": req.IdempotencyKey,
+	}
+	return fingerprint
+}
+
+// SubmitFlakyResponse is one of the responses defined for the SubmitFlaky endpoint:
+//   - 200: SubmitFlaky200
+//   - 503: SubmitFlaky503
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type SubmitFlakyResponse interface {
+	// writeSubmitFlakyResponse writes the headers, status code and body of the response to w.
+	writeSubmitFlakyResponse(w http.ResponseWriter) error
+}
+
+// WriteSubmitFlakyResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteSubmitFlakyResponse(w http.ResponseWriter, resp SubmitFlakyResponse) error {
+	return resp.writeSubmitFlakyResponse(w)
+}
+
+func NewSubmitFlaky200(
+
+	body *FlakyResponseBody,
+
+) *SubmitFlaky200 {
+	return &SubmitFlaky200{
+
+		Body: body,
+	}
+}
+
+func (resp *SubmitFlaky200) writeSubmitFlakyResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(200)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write200 writes the SubmitFlaky200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *SubmitFlakyReq) Write200(w http.ResponseWriter, resp *SubmitFlaky200) error {
+	return resp.writeSubmitFlakyResponse(w)
+}
+
+func NewSubmitFlaky503(
+
+	RetryAfter int64,
+
+	body *ErrorResponse,
+
+) *SubmitFlaky503 {
+	return &SubmitFlaky503{
+
+		RetryAfter: RetryAfter,
+
+		Body: body,
+	}
+}
+
+func (resp *SubmitFlaky503) writeSubmitFlakyResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	w.Header().Set("Retry-After", fmt.Sprintf("%v", resp.RetryAfter))
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(503)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write503 writes the SubmitFlaky503 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *SubmitFlakyReq) Write503(w http.ResponseWriter, resp *SubmitFlaky503) error {
+	return resp.writeSubmitFlakyResponse(w)
+}
@@ -1,9 +1,10 @@
 package api
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
-	"strings"
 )
 
 const (
@@ -21,7 +22,7 @@ type WhoAmIReq struct {
 	//
 	// Authentication method that denotes an API key passed in the request header.
 	//
-	// Format (NOT ENFORCED): api_key
+	// Format (documentation only): api_key
 	//
 	APIKeyAuth string
 
@@ -30,7 +31,7 @@ type WhoAmIReq struct {
 	//
 	// Authentication method that denotes a session token passed in the request header.
 	//
-	// Format (NOT ENFORCED): session_token
+	// Format (documentation only): session_token
 	//
 	SessionTokenAuth string
 
@@ -52,19 +53,21 @@ type WhoAmI200 struct {
 	// Required
 	RateLimitRemaining int64
 
-	// Raw response body. The HTTP response will be returned directly for this response, and it will be the responsibility of the caller to read/close the response body.
-	RawBody *http.Response
+	// Raw response body. If set, it is copied to the http.ResponseWriter after the headers and status code are written.
+	// If nil, only the headers and status code are written, and writing the body is the responsibility of the caller.
+	RawBody io.Reader
 }
 
 // WhoAmI400 represents a response with no headers and no response body
 // Invalid Request
 
 // ParseWhoAmIReq creates a new instance of WhoAmIReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
 func ParseWhoAmIReq(w http.ResponseWriter, r *http.Request) (*WhoAmIReq, error) {
 	req := WhoAmIReq{}
-	var err error
-	// to silence unused variable error in case there are no parameters to parse
-	_ = err
+	verr := &ValidationError{}
 
 	// Parse path parameters, if any
 
@@ -74,29 +77,85 @@ func ParseWhoAmIReq(w http.ResponseWriter, r *http.Request) (*WhoAmIReq, error)
 
 	// Required auth, if any
 
-	valAPIKey := r.Header.Get("X-App-API-Key")
-	valAPIKey = strings.TrimSpace(valAPIKey)
-	if valAPIKey == "" {
-		return &WhoAmIReq{}, fmt.Errorf("missing required authentication: header X-App-API-Key")
+	if valAPIKey, ok, err := extractAPIKey(r); !ok {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeRequired, "missing required authentication: header X-App-API-Key")
+	} else if err != nil {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeInvalidValue, err.Error())
 	} else {
 		req.APIKeyAuth = valAPIKey
 	}
 
-	valSessionToken := r.Header.Get("X-App-Session-Token")
-	valSessionToken = strings.TrimSpace(valSessionToken)
-	if valSessionToken == "" {
-		return &WhoAmIReq{}, fmt.Errorf("missing required authentication: header X-App-Session-Token")
+	if valSessionToken, ok, err := extractSessionToken(r); !ok {
+		verr.add(ValidationLocationAuth, "X-App-Session-Token", ValidationCodeRequired, "missing required authentication: header X-App-Session-Token")
+	} else if err != nil {
+		verr.add(ValidationLocationAuth, "X-App-Session-Token", ValidationCodeInvalidValue, err.Error())
 	} else {
 		req.SessionTokenAuth = valSessionToken
 	}
 
 	// Atleast one auth, if any
 
+	// All auth of one of the alternatives, if any
+
 	// NOTE: RawBody is true, so request body will not be handled.
 
+	if len(verr.Issues) > 0 {
+		return &WhoAmIReq{}, newParseError(verr)
+	}
 	return &req, nil
 }
 
+// authenticateWhoAmIReq verifies the credentials in req with the Authenticators of impl, and returns r
+// with the resulting Principals in its context.
+//
+// All of the required credentials must be valid, and at least one of the optional ones, if any.
+// With alternatives, the first one whose credentials are all present and valid is set as req.AuthAlternative.
+func authenticateWhoAmIReq(r *http.Request, impl Handler, req *WhoAmIReq) (*http.Request, error) {
+	ctx := r.Context()
+	verr := &ValidationError{}
+	var verifyErrs []error
+
+	if principal, err := impl.VerifyAPIKey(ctx, req.APIKeyAuth); err != nil {
+		verr.add(ValidationLocationAuth, "X-App-API-Key", ValidationCodeInvalidValue, "invalid credentials: X-App-API-Key")
+		verifyErrs = append(verifyErrs, fmt.Errorf("APIKey: %w", err))
+	} else {
+		ctx = withPrincipal(ctx, "apiKeyAuth", principal)
+	}
+
+	if principal, err := impl.VerifySessionToken(ctx, req.SessionTokenAuth); err != nil {
+		verr.add(ValidationLocationAuth, "X-App-Session-Token", ValidationCodeInvalidValue, "invalid credentials: X-App-Session-Token")
+		verifyErrs = append(verifyErrs, fmt.Errorf("SessionToken: %w", err))
+	} else {
+		ctx = withPrincipal(ctx, "sessionTokenAuth", principal)
+	}
+
+	if len(verr.Issues) > 0 {
+		return r, &ParseError{
+			Kind:       ParseErrorKindUnauthenticated,
+			Validation: verr,
+			Err:        errors.Join(verifyErrs...),
+		}
+	}
+	return r.WithContext(ctx), nil
+}
+
+// WhoAmIResponse is one of the responses defined for the WhoAmI endpoint:
+//   - 200: WhoAmI200
+//   - 400: WhoAmI400
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type WhoAmIResponse interface {
+	// writeWhoAmIResponse writes the headers, status code and body of the response to w.
+	writeWhoAmIResponse(w http.ResponseWriter) error
+}
+
+// WriteWhoAmIResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteWhoAmIResponse(w http.ResponseWriter, resp WhoAmIResponse) error {
+	return resp.writeWhoAmIResponse(w)
+}
+
 func NewWhoAmI200(
 
 	RateLimitRemaining int64,
@@ -108,20 +167,48 @@ func NewWhoAmI200(
 	}
 }
 
+func (resp *WhoAmI200) writeWhoAmIResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprintf("%v", resp.RateLimitRemaining))
+
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+
+	// Set status code and write the header
+	w.WriteHeader(200)
+
+	// Copy the raw body, if any, otherwise writing it is left to the caller
+	if resp.RawBody != nil {
+		_, err := io.Copy(w, resp.RawBody)
+		return err
+	}
+	return nil
+
+}
+
 // Write200 writes the WhoAmI200 response to the http.ResponseWriter
 //
-// RawBody is true, hence this function will only set the headers and write the status code, rest is to be done by the caller.
+// RawBody is true, hence unless resp.RawBody is set, this function will only set the headers and write the status code, rest is to be done by the caller.
 //
 // Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
 func (r *WhoAmIReq) Write200(w http.ResponseWriter, resp *WhoAmI200) error {
-	// Set headers, if any
+	return resp.writeWhoAmIResponse(w)
+}
 
-	w.Header().Set("X-RateLimit-Remaining", fmt.Sprintf("%v", resp.RateLimitRemaining))
+// WhoAmI400 is the status-code only 400 response of the WhoAmI endpoint.
+type WhoAmI400 struct{}
 
-	// Set status code and write the header as there are no body to write
-	w.WriteHeader(200)
-	return nil
+// NewWhoAmI400 creates a new instance of WhoAmI400
+func NewWhoAmI400() *WhoAmI400 {
+	return &WhoAmI400{}
+}
 
+func (resp *WhoAmI400) writeWhoAmIResponse(w http.ResponseWriter) error {
+	// Set status code and write the header as there are no headers or body to write
+	w.WriteHeader(400)
+	return nil
 }
 
 // Write400 writes the WhoAmI400 response to the http.ResponseWriter
@@ -132,7 +219,5 @@ func (r *WhoAmIReq) Write200(w http.ResponseWriter, resp *WhoAmI200) error {
 //
 // Since there are no headers or body to write, this function will only set the status code in the response header.
 func (r *WhoAmIReq) Write400(w http.ResponseWriter) error {
-	// Set status code and write the header as there are no headers or body to write
-	w.WriteHeader(400)
-	return nil
+	return (&WhoAmI400{}).writeWhoAmIResponse(w)
 }
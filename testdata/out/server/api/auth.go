@@ -0,0 +1,414 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Principal is the identity an Authenticator resolves a credential to, e.g. a user or an API client.
+//
+// Its concrete type is chosen by the server implementation.
+type Principal any
+
+// AdminTokenAuthenticator verifies the AdminToken authentication (header: "X-App-Admin-Token").
+//
+// Authentication method that denotes an admin token passed in the request header.
+type AdminTokenAuthenticator interface {
+	// VerifyAdminToken returns the Principal that token belongs to, or an error if token is not valid.
+	//
+	// Requests with a credential rejected by VerifyAdminToken are answered with 401 Unauthorized.
+	VerifyAdminToken(ctx context.Context, token string) (Principal, error)
+}
+
+// AdminTokenPrincipal returns the Principal resolved by VerifyAdminToken for the request ctx belongs to,
+// and false if the request was not authenticated with AdminToken.
+func AdminTokenPrincipal(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey("adminTokenAuth")).(principalValue)
+	return principal.Principal, ok
+}
+
+// APIKeyAuthenticator verifies the APIKey authentication (header: "X-App-API-Key").
+//
+// Authentication method that denotes an API key passed in the request header.
+type APIKeyAuthenticator interface {
+	// VerifyAPIKey returns the Principal that token belongs to, or an error if token is not valid.
+	//
+	// Requests with a credential rejected by VerifyAPIKey are answered with 401 Unauthorized.
+	VerifyAPIKey(ctx context.Context, token string) (Principal, error)
+}
+
+// APIKeyPrincipal returns the Principal resolved by VerifyAPIKey for the request ctx belongs to,
+// and false if the request was not authenticated with APIKey.
+func APIKeyPrincipal(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey("apiKeyAuth")).(principalValue)
+	return principal.Principal, ok
+}
+
+// BasicAuthenticator verifies the Basic authentication (basic: "Authorization").
+//
+// Authentication method that denotes a username and password passed in the Authorization header.
+type BasicAuthenticator interface {
+	// VerifyBasic returns the Principal that credentials belong to, or an error if they are not valid.
+	//
+	// Requests with a credential rejected by VerifyBasic are answered with 401 Unauthorized.
+	VerifyBasic(ctx context.Context, credentials BasicAuthCredentials) (Principal, error)
+}
+
+// BasicPrincipal returns the Principal resolved by VerifyBasic for the request ctx belongs to,
+// and false if the request was not authenticated with Basic.
+func BasicPrincipal(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey("basicAuth")).(principalValue)
+	return principal.Principal, ok
+}
+
+// BearerTokenAuthenticator verifies the BearerToken authentication (bearer: "Authorization").
+//
+// Authentication method that denotes a bearer token passed in the Authorization header.
+type BearerTokenAuthenticator interface {
+	// VerifyBearerToken returns the Principal that token belongs to, or an error if token is not valid.
+	//
+	// Requests with a credential rejected by VerifyBearerToken are answered with 401 Unauthorized.
+	VerifyBearerToken(ctx context.Context, token string) (Principal, error)
+}
+
+// BearerTokenPrincipal returns the Principal resolved by VerifyBearerToken for the request ctx belongs to,
+// and false if the request was not authenticated with BearerToken.
+func BearerTokenPrincipal(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey("bearerAuth")).(principalValue)
+	return principal.Principal, ok
+}
+
+// QueryKeyAuthenticator verifies the QueryKey authentication (query: "api_key").
+//
+// Authentication method that denotes an API key passed in the query string.
+type QueryKeyAuthenticator interface {
+	// VerifyQueryKey returns the Principal that token belongs to, or an error if token is not valid.
+	//
+	// Requests with a credential rejected by VerifyQueryKey are answered with 401 Unauthorized.
+	VerifyQueryKey(ctx context.Context, token string) (Principal, error)
+}
+
+// QueryKeyPrincipal returns the Principal resolved by VerifyQueryKey for the request ctx belongs to,
+// and false if the request was not authenticated with QueryKey.
+func QueryKeyPrincipal(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey("queryKeyAuth")).(principalValue)
+	return principal.Principal, ok
+}
+
+// RefreshTokenAuthenticator verifies the RefreshToken authentication (header: "X-App-Refresh-Token").
+//
+// Authentication method that denotes a refresh token passed in the request header.
+type RefreshTokenAuthenticator interface {
+	// VerifyRefreshToken returns the Principal that token belongs to, or an error if token is not valid.
+	//
+	// Requests with a credential rejected by VerifyRefreshToken are answered with 401 Unauthorized.
+	VerifyRefreshToken(ctx context.Context, token string) (Principal, error)
+}
+
+// RefreshTokenPrincipal returns the Principal resolved by VerifyRefreshToken for the request ctx belongs to,
+// and false if the request was not authenticated with RefreshToken.
+func RefreshTokenPrincipal(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey("refreshTokenAuth")).(principalValue)
+	return principal.Principal, ok
+}
+
+// ServiceAuthenticator verifies the Service authentication (oauth2ClientCredentials: "X-App-Service-Token").
+//
+// Authentication method that denotes an OAuth2 access token of a backend service, obtained with the client credentials grant.
+type ServiceAuthenticator interface {
+	// VerifyService returns the Principal that token belongs to, or an error if token is not valid.
+	//
+	// Requests with a credential rejected by VerifyService are answered with 401 Unauthorized.
+	VerifyService(ctx context.Context, token string) (Principal, error)
+}
+
+// ServicePrincipal returns the Principal resolved by VerifyService for the request ctx belongs to,
+// and false if the request was not authenticated with Service.
+func ServicePrincipal(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey("serviceAuth")).(principalValue)
+	return principal.Principal, ok
+}
+
+// SessionCookieAuthenticator verifies the SessionCookie authentication (cookie: "app_session").
+//
+// Authentication method that denotes a session passed in a cookie.
+type SessionCookieAuthenticator interface {
+	// VerifySessionCookie returns the Principal that token belongs to, or an error if token is not valid.
+	//
+	// Requests with a credential rejected by VerifySessionCookie are answered with 401 Unauthorized.
+	VerifySessionCookie(ctx context.Context, token string) (Principal, error)
+}
+
+// SessionCookiePrincipal returns the Principal resolved by VerifySessionCookie for the request ctx belongs to,
+// and false if the request was not authenticated with SessionCookie.
+func SessionCookiePrincipal(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey("sessionCookieAuth")).(principalValue)
+	return principal.Principal, ok
+}
+
+// SessionTokenAuthenticator verifies the SessionToken authentication (header: "X-App-Session-Token").
+//
+// Authentication method that denotes a session token passed in the request header.
+type SessionTokenAuthenticator interface {
+	// VerifySessionToken returns the Principal that token belongs to, or an error if token is not valid.
+	//
+	// Requests with a credential rejected by VerifySessionToken are answered with 401 Unauthorized.
+	VerifySessionToken(ctx context.Context, token string) (Principal, error)
+}
+
+// SessionTokenPrincipal returns the Principal resolved by VerifySessionToken for the request ctx belongs to,
+// and false if the request was not authenticated with SessionToken.
+func SessionTokenPrincipal(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey("sessionTokenAuth")).(principalValue)
+	return principal.Principal, ok
+}
+
+// WebhookSignatureAuthenticator verifies the WebhookSignature authentication (hmac: "X-Signature").
+//
+// Authentication method that denotes a webhook delivery signed with a shared secret.
+type WebhookSignatureAuthenticator interface {
+	// LookupWebhookSignatureKey returns the Principal that the signing key keyID belongs to and its secret,
+	// or an error if the key is not known.
+	//
+	// Requests signed with an unknown key, or whose signature does not match the secret, are answered with 401 Unauthorized.
+	LookupWebhookSignatureKey(ctx context.Context, keyID string) (Principal, []byte, error)
+}
+
+// verifyWebhookSignature verifies signature with the secret of its key, and returns the Principal of the key.
+func verifyWebhookSignature(ctx context.Context, impl WebhookSignatureAuthenticator, signature HMACSignature) (Principal, error) {
+	principal, secret, err := impl.LookupWebhookSignatureKey(ctx, signature.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	if !signature.Verify(secret) {
+		return nil, errors.New("signature does not match")
+	}
+	return principal, nil
+}
+
+// WebhookSignaturePrincipal returns the Principal resolved by LookupWebhookSignatureKey for the request ctx belongs to,
+// and false if the request was not authenticated with WebhookSignature.
+func WebhookSignaturePrincipal(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey("webhookAuth")).(principalValue)
+	return principal.Principal, ok
+}
+
+// ScopeChecker authorizes authenticated requests to the endpoints that declare scopes in the specification.
+type ScopeChecker interface {
+	// CheckScopes returns an error if the caller of r, whose Principals are in r.Context(), lacks any of route.Scopes.
+	//
+	// It is only called for routes with scopes, after authentication. Requests it rejects are answered with 403 Forbidden.
+	CheckScopes(r *http.Request, route Route) error
+}
+
+// newScopeError returns the *ParseError for a request to route rejected by ScopeChecker.CheckScopes with err.
+func newScopeError(route Route, err error) *ParseError {
+	verr := &ValidationError{}
+	verr.add(ValidationLocationAuth, "", ValidationCodeInsufficientScope, "insufficient scope, required: "+strings.Join(route.Scopes, ", "))
+	return &ParseError{
+		Kind:       ParseErrorKindForbidden,
+		Validation: verr,
+		Err:        err,
+	}
+}
+
+// principalKey is the context key of the Principal resolved for an auth method, by its ID in the specification.
+type principalKey string
+
+// principalValue wraps a Principal in the context, so that a nil Principal is still distinguishable from no Principal.
+type principalValue struct {
+	Principal Principal
+}
+
+func withPrincipal(ctx context.Context, authMethodID string, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey(authMethodID), principalValue{Principal: principal})
+}
+
+// The <type>Auth functions extract the credential of an auth method of that type from r.
+//
+// They return false if the credential is missing or empty, and an error if it is present but malformed.
+// A header with another scheme than the one expected by bearer, oauth2ClientCredentials and basic counts as missing,
+// since they can share the Authorization header.
+
+func headerAuth(r *http.Request, name string) (string, bool, error) {
+	value := strings.TrimSpace(r.Header.Get(name))
+	return value, value != "", nil
+}
+
+func queryAuth(r *http.Request, name string) (string, bool, error) {
+	value := strings.TrimSpace(r.URL.Query().Get(name))
+	return value, value != "", nil
+}
+
+func cookieAuth(r *http.Request, name string) (string, bool, error) {
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return "", false, nil
+	}
+	value := strings.TrimSpace(cookie.Value)
+	return value, value != "", nil
+}
+
+func bearerAuth(r *http.Request, name string) (string, bool, error) {
+	scheme, token, _ := strings.Cut(strings.TrimSpace(r.Header.Get(name)), " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", false, nil
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", true, errors.New("malformed authentication: " + name + " must be 'Bearer <token>'")
+	}
+	return token, true, nil
+}
+
+// oauth2ClientCredentialsAuth extracts an OAuth2 access token, which is sent as a bearer token.
+func oauth2ClientCredentialsAuth(r *http.Request, name string) (string, bool, error) {
+	return bearerAuth(r, name)
+}
+
+func basicAuth(r *http.Request, name string) (BasicAuthCredentials, bool, error) {
+	scheme, encoded, _ := strings.Cut(strings.TrimSpace(r.Header.Get(name)), " ")
+	if !strings.EqualFold(scheme, "Basic") {
+		return BasicAuthCredentials{}, false, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return BasicAuthCredentials{}, true, errors.New("malformed authentication: " + name + " must be 'Basic <base64(username:password)>'")
+	}
+	username, password, ok := strings.Cut(string(decoded), ":")
+	if !ok || username == "" {
+		return BasicAuthCredentials{}, true, errors.New("malformed authentication: " + name + " must contain a username and password")
+	}
+	return BasicAuthCredentials{Username: username, Password: password}, true, nil
+}
+
+// HMACSignature is the signature of a request to an endpoint with an hmac auth method.
+//
+// Parse<Endpoint>Req only accepts signatures made within the clock skew window of the auth method,
+// and RegisterRoutes verifies them with the secret returned by the Authenticator for KeyID.
+// Requests replayed within the window are not detected.
+type HMACSignature struct {
+	KeyID     string
+	Timestamp time.Time
+
+	// The decoded signature.
+	Signature []byte
+
+	// The hash algorithm, and the string that was signed: see hmacPayload.
+	algorithm string
+	payload   string
+}
+
+// Verify reports whether the request was signed with secret.
+func (s HMACSignature) Verify(secret []byte) bool {
+	mac := hmac.New(hmacHash(s.algorithm), secret)
+	mac.Write([]byte(s.payload))
+	return hmac.Equal(mac.Sum(nil), s.Signature)
+}
+
+// hmacAuth extracts the signature of an hmac auth method from r: the hex-encoded signature in the name header,
+// with the ID of the key in keyIDHeader and the signing time, in Unix seconds, in timestampHeader.
+//
+// The signature is rejected if it was made more than clockSkew away from the server's clock.
+// The body of r, limited by Parse<Endpoint>Req to the MaxBodyBytes of the endpoint, is read to be hashed, and restored.
+func hmacAuth(r *http.Request, name, keyIDHeader, timestampHeader, algorithm string, clockSkew time.Duration) (HMACSignature, bool, error) {
+	value := strings.TrimSpace(r.Header.Get(name))
+	if value == "" {
+		return HMACSignature{}, false, nil
+	}
+	signature, err := hex.DecodeString(value)
+	if err != nil {
+		return HMACSignature{}, true, errors.New("malformed authentication: " + name + " must be a hex-encoded signature")
+	}
+	keyID := strings.TrimSpace(r.Header.Get(keyIDHeader))
+	if keyID == "" {
+		return HMACSignature{}, true, errors.New("malformed authentication: " + keyIDHeader + " is required with " + name)
+	}
+	unix, err := strconv.ParseInt(strings.TrimSpace(r.Header.Get(timestampHeader)), 10, 64)
+	if err != nil {
+		return HMACSignature{}, true, errors.New("malformed authentication: " + timestampHeader + " must be a Unix timestamp in seconds")
+	}
+	timestamp := time.Unix(unix, 0)
+	if time.Since(timestamp).Abs() > clockSkew {
+		return HMACSignature{}, true, errors.New("expired authentication: " + timestampHeader + " must be within " + clockSkew.String() + " of the server time")
+	}
+
+	bodyHash := hmacHash(algorithm)()
+	body, err := io.ReadAll(r.Body)
+	// Restore the body, including any unread part, for the rest of the request handling
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return HMACSignature{}, true, fmt.Errorf("malformed authentication: the signed request body exceeds the maximum allowed size of %d bytes", maxBytesErr.Limit)
+	} else if err != nil {
+		return HMACSignature{}, true, errors.New("malformed authentication: error reading the signed request body")
+	}
+	bodyHash.Write(body)
+
+	return HMACSignature{
+		KeyID:     keyID,
+		Timestamp: timestamp,
+		Signature: signature,
+		algorithm: algorithm,
+		payload:   hmacPayload(r.Method, r.URL.EscapedPath(), r.URL.RawQuery, unix, bodyHash.Sum(nil)),
+	}, true, nil
+}
+
+// hmacPayload returns the string signed by hmac auth methods: the method, escaped path, raw query (as sent,
+// without the "?"), signing time and hex-encoded body hash of the request, separated by newlines.
+func hmacPayload(method, path, query string, timestamp int64, bodyHash []byte) string {
+	return method + "\n" + path + "\n" + query + "\n" + strconv.FormatInt(timestamp, 10) + "\n" + hex.EncodeToString(bodyHash)
+}
+
+// hmacHash returns the hash function of an hmac algorithm of the specification.
+func hmacHash(algorithm string) func() hash.Hash {
+	if algorithm == "sha512" {
+		return sha512.New
+	}
+	return sha256.New
+}
+
+// authFormat is the enforced Format of an auth method: either a "{token}" template, given by the text
+// around the placeholder, or a "^...$" pattern, whose "token" group (or whole match, without one) is the credential.
+type authFormat struct {
+	format         string
+	prefix, suffix string
+	pattern        *regexp.Regexp
+}
+
+// strip returns the credential held by value, or an error if value does not match the format.
+func (f authFormat) strip(name, value string) (string, error) {
+	var credential string
+	if f.pattern != nil {
+		if match := f.pattern.FindStringSubmatch(value); match != nil {
+			credential = match[0]
+			if idx := f.pattern.SubexpIndex("token"); idx > 0 {
+				credential = match[idx]
+			}
+		}
+	} else if len(value) > len(f.prefix)+len(f.suffix) && strings.HasPrefix(value, f.prefix) && strings.HasSuffix(value, f.suffix) {
+		credential = value[len(f.prefix) : len(value)-len(f.suffix)]
+	}
+	credential = strings.TrimSpace(credential)
+	if credential == "" {
+		return "", errors.New("malformed authentication: " + name + " must match the format '" + f.format + "'")
+	}
+	return credential, nil
+}
@@ -0,0 +1,227 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// maxIdempotencyKeyLength is the maximum length of an Idempotency-Key header.
+const maxIdempotencyKeyLength = 255
+
+var (
+	// ErrIdempotencyKeyInUse is returned by IdempotencyStore.BeginIdempotentRequest for a key whose request is in progress.
+	// The request is answered with 409 Conflict.
+	ErrIdempotencyKeyInUse = errors.New("idempotency key in use by a request in progress")
+
+	// ErrIdempotencyKeyReused is returned by IdempotencyStore.BeginIdempotentRequest for a key used by a request
+	// with another fingerprint. The request is answered with 422 Unprocessable Entity.
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused for a different request")
+)
+
+// IdempotentResponse is a response stored by an IdempotencyStore, written again for the requests repeating its key.
+type IdempotentResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// IdempotencyStore stores the responses of the endpoints with idempotency keys (idempotent: key in the specification).
+//
+// RegisterRoutes calls BeginIdempotentRequest once a request is parsed, authenticated and authorized, then either
+// CompleteIdempotentRequest with the response written for it, or AbandonIdempotentRequest if the request failed with
+// a 5xx status, so that it can be retried.
+//
+// Keys are those of the requests, prefixed with the endpoint name. Implementations must be safe for concurrent use,
+// and should expire keys after a while, e.g. 24 hours.
+//
+// The fingerprint covers the Principals of the request rather than its credentials, so that a key never replays
+// the response of one caller to another. Principals are encoded as JSON, so they should identify the caller
+// (e.g. a user ID) without data that changes with each credential.
+type IdempotencyStore interface {
+	// BeginIdempotentRequest claims key for a request with fingerprint, a hash of its parameters, body and Principals.
+	//
+	// It returns the stored response if a request with key completed, ErrIdempotencyKeyInUse if one is in progress,
+	// and ErrIdempotencyKeyReused if key was claimed with a different fingerprint. Other errors are answered
+	// with 500 Internal Server Error.
+	BeginIdempotentRequest(ctx context.Context, key, fingerprint string) (*IdempotentResponse, error)
+
+	// CompleteIdempotentRequest stores the response of the request that claimed key.
+	CompleteIdempotentRequest(ctx context.Context, key string, resp *IdempotentResponse) error
+
+	// AbandonIdempotentRequest releases key, without storing a response.
+	AbandonIdempotentRequest(ctx context.Context, key string) error
+}
+
+// MemoryIdempotencyStore is an IdempotencyStore keeping the responses in memory, for a single server instance.
+type MemoryIdempotencyStore struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	entries   map[string]*memoryIdempotencyEntry
+	nextSweep time.Time // when the expired entries are next deleted
+}
+
+type memoryIdempotencyEntry struct {
+	fingerprint string
+	resp        *IdempotentResponse // nil while the request is in progress
+	expiry      time.Time
+}
+
+// NewMemoryIdempotencyStore returns a MemoryIdempotencyStore that forgets keys ttl after they are claimed.
+// A ttl <= 0 defaults to 24 hours.
+func NewMemoryIdempotencyStore(ttl time.Duration) *MemoryIdempotencyStore {
+	if ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+	return &MemoryIdempotencyStore{
+		ttl:       ttl,
+		entries:   make(map[string]*memoryIdempotencyEntry),
+		nextSweep: time.Now().Add(ttl),
+	}
+}
+
+func (s *MemoryIdempotencyStore) BeginIdempotentRequest(ctx context.Context, key, fingerprint string) (*IdempotentResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Expired entries are deleted once per ttl, and ignored by the lookups in between
+	now := time.Now()
+	if now.After(s.nextSweep) {
+		for k, entry := range s.entries {
+			if now.After(entry.expiry) {
+				delete(s.entries, k)
+			}
+		}
+		s.nextSweep = now.Add(s.ttl)
+	}
+
+	entry, ok := s.entries[key]
+	switch {
+	case !ok || now.After(entry.expiry):
+		s.entries[key] = &memoryIdempotencyEntry{fingerprint: fingerprint, expiry: now.Add(s.ttl)}
+		return nil, nil
+	case entry.fingerprint != fingerprint:
+		return nil, ErrIdempotencyKeyReused
+	case entry.resp == nil:
+		return nil, ErrIdempotencyKeyInUse
+	default:
+		return entry.resp, nil
+	}
+}
+
+func (s *MemoryIdempotencyStore) CompleteIdempotentRequest(ctx context.Context, key string, resp *IdempotentResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if entry, ok := s.entries[key]; ok {
+		entry.resp = resp
+	}
+	return nil
+}
+
+func (s *MemoryIdempotencyStore) AbandonIdempotentRequest(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+	return nil
+}
+
+// beginIdempotent claims the idempotency key of a request to route, with the IdempotencyStore of impl.
+//
+// fingerprinted holds the parts of the request its fingerprint covers, see the idempotencyFingerprint methods.
+//
+// If the request is answered here, with the stored response or an error, it returns a nil finish function.
+// Otherwise, it returns a ResponseWriter recording the response, and finish, which stores or abandons it
+// and must be called once the response is written.
+func beginIdempotent(w http.ResponseWriter, r *http.Request, impl Handler, route Route, key string, fingerprinted map[string]any) (http.ResponseWriter, func()) {
+	storeKey := route.Name + " " + key
+	encoded, err := json.Marshal(fingerprinted)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return w, nil
+	}
+	sum := sha256.Sum256(encoded)
+	fingerprint := hex.EncodeToString(sum[:])
+
+	stored, err := impl.BeginIdempotentRequest(r.Context(), storeKey, fingerprint)
+	switch {
+	case errors.Is(err, ErrIdempotencyKeyInUse):
+		writeParseError(w, r, impl, newIdempotencyError(ParseErrorKindIdempotencyKeyInUse, ValidationCodeIdempotencyKeyInUse, err))
+		return w, nil
+	case errors.Is(err, ErrIdempotencyKeyReused):
+		writeParseError(w, r, impl, newIdempotencyError(ParseErrorKindIdempotencyKeyReused, ValidationCodeIdempotencyKeyReused, err))
+		return w, nil
+	case err != nil:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return w, nil
+	case stored != nil:
+		for name, values := range stored.Header {
+			w.Header()[name] = values
+		}
+		w.Header().Set("Idempotent-Replayed", "true")
+		w.WriteHeader(stored.StatusCode)
+		_, _ = w.Write(stored.Body)
+		return w, nil
+	}
+
+	recorder := &idempotentResponseRecorder{ResponseWriter: w}
+	return recorder, func() {
+		// A request without a response (e.g. a panic in the handler), or with a 5xx status, can be retried
+		if recorder.statusCode == 0 || recorder.statusCode >= 500 {
+			_ = impl.AbandonIdempotentRequest(context.WithoutCancel(r.Context()), storeKey)
+			return
+		}
+		_ = impl.CompleteIdempotentRequest(context.WithoutCancel(r.Context()), storeKey, &IdempotentResponse{
+			StatusCode: recorder.statusCode,
+			Header:     recorder.header,
+			Body:       recorder.body.Bytes(),
+		})
+	}
+}
+
+// newIdempotencyError returns the *ParseError for a request whose idempotency key was rejected by the IdempotencyStore.
+func newIdempotencyError(kind ParseErrorKind, code ValidationCode, err error) *ParseError {
+	verr := &ValidationError{}
+	verr.add(ValidationLocationHeader, "Idempotency-Key", code, err.Error())
+	return &ParseError{
+		Kind:       kind,
+		Validation: verr,
+		Err:        err,
+	}
+}
+
+// idempotentResponseRecorder is an http.ResponseWriter that records the response it writes, to store it.
+type idempotentResponseRecorder struct {
+	http.ResponseWriter
+
+	statusCode int
+	header     http.Header
+	body       bytes.Buffer
+}
+
+func (rec *idempotentResponseRecorder) WriteHeader(statusCode int) {
+	if rec.statusCode == 0 {
+		rec.statusCode = statusCode
+		rec.header = rec.ResponseWriter.Header().Clone()
+	}
+	rec.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (rec *idempotentResponseRecorder) Write(p []byte) (int, error) {
+	if rec.statusCode == 0 {
+		rec.WriteHeader(http.StatusOK)
+	}
+	rec.body.Write(p)
+	return rec.ResponseWriter.Write(p)
+}
+
+// Unwrap returns the underlying ResponseWriter, for http.ResponseController.
+func (rec *idempotentResponseRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
@@ -0,0 +1,645 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// Handler is implemented by the API server, with one method per endpoint in the specification.
+//
+// RegisterRoutes parses and validates every request before calling the corresponding method,
+// so implementations only receive requests that passed the generated Parse<Endpoint>Req checks.
+//
+// Each method returns exactly one of the responses defined for its endpoint, which RegisterRoutes writes.
+// The http.Request is passed for its context and, for rawBody endpoints, its body.
+//
+// It also embeds the Authenticator of every auth method used by the endpoints, which RegisterRoutes calls
+// to verify the credentials of a request before the endpoint method, and the ScopeChecker,
+// called after authentication for the endpoints with scopes.
+// The IdempotencyStore stores the responses of the endpoints with idempotency keys, e.g. a *MemoryIdempotencyStore.
+type Handler interface {
+	AdminTokenAuthenticator
+
+	APIKeyAuthenticator
+
+	BasicAuthenticator
+
+	BearerTokenAuthenticator
+
+	QueryKeyAuthenticator
+
+	RefreshTokenAuthenticator
+
+	ServiceAuthenticator
+
+	SessionCookieAuthenticator
+
+	SessionTokenAuthenticator
+
+	WebhookSignatureAuthenticator
+
+	ScopeChecker
+
+	IdempotencyStore
+
+	// CreateUser handles POST /users/new
+	//
+	// Create a new user in the system.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	CreateUser(r *http.Request, req *CreateUserReq) (CreateUserResponse, error)
+
+	// GetUser handles GET /users/{userId}
+	//
+	// Retrieve user information by user ID.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	GetUser(r *http.Request, req *GetUserReq) (GetUserResponse, error)
+
+	// ListUsers handles GET /users
+	//
+	// List users with optional pagination.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	ListUsers(r *http.Request, req *ListUsersReq) (ListUsersResponse, error)
+
+	// LogoutUser handles GET /users/logout
+	//
+	// Logout the current user.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	LogoutUser(r *http.Request, req *LogoutUserReq) (LogoutUserResponse, error)
+
+	// WhoAmI handles POST /users/whoami
+	//
+	// Get information about the currently authenticated user. Provide the request body as just a string which is set to the user id.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	WhoAmI(r *http.Request, req *WhoAmIReq) (WhoAmIResponse, error)
+
+	// GetSession handles GET /session
+	//
+	// Get the credential the request was authenticated with.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	GetSession(r *http.Request, req *GetSessionReq) (GetSessionResponse, error)
+
+	// ReceiveWebhook handles POST /webhooks
+	//
+	// Receive a webhook delivery signed with the webhook secret.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	ReceiveWebhook(r *http.Request, req *ReceiveWebhookReq) (ReceiveWebhookResponse, error)
+
+	// DeleteUser handles DELETE /users/{userId}
+	//
+	// Delete a user, either as the user (API key and session token) or as an admin (admin token alone).
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	DeleteUser(r *http.Request, req *DeleteUserReq) (DeleteUserResponse, error)
+
+	// GetFlaky handles GET /flaky
+	//
+	// Fails with 503 Service Unavailable for the first Failures requests with the same key.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	GetFlaky(r *http.Request, req *GetFlakyReq) (GetFlakyResponse, error)
+
+	// CreateFlaky handles POST /flaky
+	//
+	// Same as GetFlaky, but not idempotent, so the SDKs do not retry it.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	CreateFlaky(r *http.Request, req *CreateFlakyReq) (CreateFlakyResponse, error)
+
+	// SubmitFlaky handles POST /flaky/submit
+	//
+	// Same as CreateFlaky, but made idempotent with an Idempotency-Key, so the SDKs retry it.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	SubmitFlaky(r *http.Request, req *SubmitFlakyReq) (SubmitFlakyResponse, error)
+
+	// ListEvents handles GET /events
+	//
+	// List events, by the offset of the first one.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	ListEvents(r *http.Request, req *ListEventsReq) (ListEventsResponse, error)
+
+	// ListEventFeed handles GET /events/feed
+	//
+	// List events, with the cursor returned by the previous page.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	ListEventFeed(r *http.Request, req *ListEventFeedReq) (ListEventFeedResponse, error)
+
+	// SendNotification handles POST /notifications
+	//
+	// Send a notification, by email or SMS. Just for testing oneOf request and response bodies in the generator.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	SendNotification(r *http.Request, req *SendNotificationReq) (SendNotificationResponse, error)
+
+	// HealthCheck handles GET /health
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	HealthCheck(r *http.Request, req *HealthCheckReq) (HealthCheckResponse, error)
+
+	// RenderParseError returns the body written for a request that failed parsing, with status err.StatusCode().
+	//
+	// A nil return writes the response with an empty body.
+	RenderParseError(r *http.Request, err *ParseError) *ErrorResponse
+}
+
+// Route describes an endpoint of the specification, as registered by RegisterRoutes.
+type Route struct {
+	// Name of the endpoint, e.g. "CreateUser".
+	Name string
+
+	Method string
+
+	// Path pattern of the endpoint, e.g. "/users/{userId}".
+	Path string
+
+	// Authorization scopes required by the endpoint, if any.
+	Scopes []string
+}
+
+var (
+	// CreateUserRoute is the Route of the CreateUser endpoint.
+	CreateUserRoute = Route{Name: "CreateUser", Method: CreateUserReqHTTPMethod, Path: CreateUserReqRoutePath}
+
+	// GetUserRoute is the Route of the GetUser endpoint.
+	GetUserRoute = Route{Name: "GetUser", Method: GetUserReqHTTPMethod, Path: GetUserReqRoutePath}
+
+	// ListUsersRoute is the Route of the ListUsers endpoint.
+	ListUsersRoute = Route{Name: "ListUsers", Method: ListUsersReqHTTPMethod, Path: ListUsersReqRoutePath, Scopes: []string{"users:read"}}
+
+	// LogoutUserRoute is the Route of the LogoutUser endpoint.
+	LogoutUserRoute = Route{Name: "LogoutUser", Method: LogoutUserReqHTTPMethod, Path: LogoutUserReqRoutePath}
+
+	// WhoAmIRoute is the Route of the WhoAmI endpoint.
+	WhoAmIRoute = Route{Name: "WhoAmI", Method: WhoAmIReqHTTPMethod, Path: WhoAmIReqRoutePath}
+
+	// GetSessionRoute is the Route of the GetSession endpoint.
+	GetSessionRoute = Route{Name: "GetSession", Method: GetSessionReqHTTPMethod, Path: GetSessionReqRoutePath}
+
+	// ReceiveWebhookRoute is the Route of the ReceiveWebhook endpoint.
+	ReceiveWebhookRoute = Route{Name: "ReceiveWebhook", Method: ReceiveWebhookReqHTTPMethod, Path: ReceiveWebhookReqRoutePath}
+
+	// DeleteUserRoute is the Route of the DeleteUser endpoint.
+	DeleteUserRoute = Route{Name: "DeleteUser", Method: DeleteUserReqHTTPMethod, Path: DeleteUserReqRoutePath, Scopes: []string{"users:delete"}}
+
+	// GetFlakyRoute is the Route of the GetFlaky endpoint.
+	GetFlakyRoute = Route{Name: "GetFlaky", Method: GetFlakyReqHTTPMethod, Path: GetFlakyReqRoutePath}
+
+	// CreateFlakyRoute is the Route of the CreateFlaky endpoint.
+	CreateFlakyRoute = Route{Name: "CreateFlaky", Method: CreateFlakyReqHTTPMethod, Path: CreateFlakyReqRoutePath}
+
+	// SubmitFlakyRoute is the Route of the SubmitFlaky endpoint.
+	SubmitFlakyRoute = Route{Name: "SubmitFlaky", Method: SubmitFlakyReqHTTPMethod, Path: SubmitFlakyReqRoutePath}
+
+	// ListEventsRoute is the Route of the ListEvents endpoint.
+	ListEventsRoute = Route{Name: "ListEvents", Method: ListEventsReqHTTPMethod, Path: ListEventsReqRoutePath}
+
+	// ListEventFeedRoute is the Route of the ListEventFeed endpoint.
+	ListEventFeedRoute = Route{Name: "ListEventFeed", Method: ListEventFeedReqHTTPMethod, Path: ListEventFeedReqRoutePath}
+
+	// SendNotificationRoute is the Route of the SendNotification endpoint.
+	SendNotificationRoute = Route{Name: "SendNotification", Method: SendNotificationReqHTTPMethod, Path: SendNotificationReqRoutePath}
+
+	// HealthCheckRoute is the Route of the HealthCheck endpoint.
+	HealthCheckRoute = Route{Name: "HealthCheck", Method: HealthCheckReqHTTPMethod, Path: HealthCheckReqRoutePath}
+)
+
+// Routes lists the Route of every endpoint in the specification.
+var Routes = []Route{
+	CreateUserRoute,
+	GetUserRoute,
+	ListUsersRoute,
+	LogoutUserRoute,
+	WhoAmIRoute,
+	GetSessionRoute,
+	ReceiveWebhookRoute,
+	DeleteUserRoute,
+	GetFlakyRoute,
+	CreateFlakyRoute,
+	SubmitFlakyRoute,
+	ListEventsRoute,
+	ListEventFeedRoute,
+	SendNotificationRoute,
+	HealthCheckRoute,
+}
+
+// RegisterRoutes registers a route on mux for every endpoint in the specification.
+//
+// Routes use "METHOD /path/{param}" patterns, so http.ServeMux rejects requests with a wrong method
+// with 405 Method Not Allowed before they reach impl.
+//
+// Requests that fail parsing are rejected with the status code of the *ParseError (see ParseError.StatusCode),
+// and the ErrorResponse returned by impl.RenderParseError as the JSON body.
+//
+// Requests to the endpoints with idempotency keys that repeat the key of a completed request are answered with
+// its stored response, with an "Idempotent-Replayed: true" header, without calling impl.
+func RegisterRoutes(mux *http.ServeMux, impl Handler) {
+
+	mux.HandleFunc(CreateUserReqHTTPMethod+" "+CreateUserReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseCreateUserReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		r, err = authenticateCreateUserReq(r, impl, req)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.CreateUser(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteCreateUserResponse(w, resp)
+	})
+
+	mux.HandleFunc(GetUserReqHTTPMethod+" "+GetUserReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseGetUserReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		r, err = authenticateGetUserReq(r, impl, req)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.GetUser(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteGetUserResponse(w, resp)
+	})
+
+	mux.HandleFunc(ListUsersReqHTTPMethod+" "+ListUsersReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseListUsersReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		r, err = authenticateListUsersReq(r, impl, req)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		if err := impl.CheckScopes(r, ListUsersRoute); err != nil {
+			writeParseError(w, r, impl, newScopeError(ListUsersRoute, err))
+			return
+		}
+
+		resp, err := impl.ListUsers(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteListUsersResponse(w, resp)
+	})
+
+	mux.HandleFunc(LogoutUserReqHTTPMethod+" "+LogoutUserReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseLogoutUserReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		r, err = authenticateLogoutUserReq(r, impl, req)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.LogoutUser(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteLogoutUserResponse(w, resp)
+	})
+
+	mux.HandleFunc(WhoAmIReqHTTPMethod+" "+WhoAmIReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseWhoAmIReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		r, err = authenticateWhoAmIReq(r, impl, req)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.WhoAmI(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteWhoAmIResponse(w, resp)
+	})
+
+	mux.HandleFunc(GetSessionReqHTTPMethod+" "+GetSessionReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseGetSessionReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		r, err = authenticateGetSessionReq(r, impl, req)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.GetSession(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteGetSessionResponse(w, resp)
+	})
+
+	mux.HandleFunc(ReceiveWebhookReqHTTPMethod+" "+ReceiveWebhookReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseReceiveWebhookReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		r, err = authenticateReceiveWebhookReq(r, impl, req)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.ReceiveWebhook(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteReceiveWebhookResponse(w, resp)
+	})
+
+	mux.HandleFunc(DeleteUserReqHTTPMethod+" "+DeleteUserReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseDeleteUserReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		r, err = authenticateDeleteUserReq(r, impl, req)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		if err := impl.CheckScopes(r, DeleteUserRoute); err != nil {
+			writeParseError(w, r, impl, newScopeError(DeleteUserRoute, err))
+			return
+		}
+
+		resp, err := impl.DeleteUser(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteDeleteUserResponse(w, resp)
+	})
+
+	mux.HandleFunc(GetFlakyReqHTTPMethod+" "+GetFlakyReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseGetFlakyReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.GetFlaky(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteGetFlakyResponse(w, resp)
+	})
+
+	mux.HandleFunc(CreateFlakyReqHTTPMethod+" "+CreateFlakyReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseCreateFlakyReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.CreateFlaky(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteCreateFlakyResponse(w, resp)
+	})
+
+	mux.HandleFunc(SubmitFlakyReqHTTPMethod+" "+SubmitFlakyReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseSubmitFlakyReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		w, finish := beginIdempotent(w, r, impl, SubmitFlakyRoute, req.IdempotencyKey, req.idempotencyFingerprint(r.Context()))
+		if finish == nil {
+			return
+		}
+		defer finish()
+
+		resp, err := impl.SubmitFlaky(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteSubmitFlakyResponse(w, resp)
+	})
+
+	mux.HandleFunc(ListEventsReqHTTPMethod+" "+ListEventsReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseListEventsReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.ListEvents(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteListEventsResponse(w, resp)
+	})
+
+	mux.HandleFunc(ListEventFeedReqHTTPMethod+" "+ListEventFeedReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseListEventFeedReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.ListEventFeed(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteListEventFeedResponse(w, resp)
+	})
+
+	mux.HandleFunc(SendNotificationReqHTTPMethod+" "+SendNotificationReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseSendNotificationReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.SendNotification(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteSendNotificationResponse(w, resp)
+	})
+
+	mux.HandleFunc(HealthCheckReqHTTPMethod+" "+HealthCheckReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseHealthCheckReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.HealthCheck(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteHealthCheckResponse(w, resp)
+	})
+
+}
+
+// writeParseError writes the response for an error returned by a Parse<Endpoint>Req function.
+func writeParseError(w http.ResponseWriter, r *http.Request, impl Handler, err error) {
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	body := impl.RenderParseError(r, perr)
+	if body == nil {
+		w.WriteHeader(perr.StatusCode())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(perr.StatusCode())
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -1,61 +1,357 @@
 package api
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // GetAdminToken extracts the AdminToken Authentication (header: "X-App-Admin-Token") from the request and returns it as a string.
 func GetAdminToken(r *http.Request) (string, error) {
-	authHeader := r.Header.Get("X-App-Admin-Token")
-	if authHeader == "" {
-		return "", fmt.Errorf("missing auth header: %s", "AdminToken")
+	credential, ok, err := extractAdminToken(r)
+	if !ok {
+		return credential, fmt.Errorf("missing auth header: %s", "AdminToken")
 	}
-	authHeader = strings.TrimSpace(authHeader)
-	if authHeader == "" {
-		return "", fmt.Errorf("empty auth header: %s", "AdminToken")
+	return credential, err
+}
+
+// authFormatAdminToken is the format of the AdminToken credential, as defined in the specification.
+var authFormatAdminToken = authFormat{
+	format: "Admin {token}",
+	prefix: "Admin ",
+	suffix: "",
+}
+
+// extractAdminToken extracts the AdminToken credential from r, stripped of its format, see headerAuth.
+func extractAdminToken(r *http.Request) (string, bool, error) {
+	value, ok, err := headerAuth(r, "X-App-Admin-Token")
+	if !ok || err != nil {
+		return value, ok, err
 	}
-	return authHeader, nil
+	value, err = authFormatAdminToken.strip("X-App-Admin-Token", value)
+	return value, true, err
 }
 
 // GetAPIKey extracts the APIKey Authentication (header: "X-App-API-Key") from the request and returns it as a string.
 func GetAPIKey(r *http.Request) (string, error) {
-	authHeader := r.Header.Get("X-App-API-Key")
-	if authHeader == "" {
-		return "", fmt.Errorf("missing auth header: %s", "APIKey")
+	credential, ok, err := extractAPIKey(r)
+	if !ok {
+		return credential, fmt.Errorf("missing auth header: %s", "APIKey")
+	}
+	return credential, err
+}
+
+// extractAPIKey extracts the APIKey credential from r, see headerAuth.
+func extractAPIKey(r *http.Request) (string, bool, error) {
+	return headerAuth(r, "X-App-API-Key")
+}
+
+// GetBasic extracts the Basic Authentication (basic: "Authorization") from the request and returns it.
+func GetBasic(r *http.Request) (BasicAuthCredentials, error) {
+	credential, ok, err := extractBasic(r)
+	if !ok {
+		return credential, fmt.Errorf("missing auth basic: %s", "Basic")
+	}
+	return credential, err
+}
+
+// extractBasic extracts the Basic credential from r, see basicAuth.
+func extractBasic(r *http.Request) (BasicAuthCredentials, bool, error) {
+	return basicAuth(r, "Authorization")
+}
+
+// GetBearerToken extracts the BearerToken Authentication (bearer: "Authorization") from the request and returns it as a string.
+func GetBearerToken(r *http.Request) (string, error) {
+	credential, ok, err := extractBearerToken(r)
+	if !ok {
+		return credential, fmt.Errorf("missing auth bearer: %s", "BearerToken")
+	}
+	return credential, err
+}
+
+// authFormatBearerToken is the format of the BearerToken credential, as defined in the specification.
+var authFormatBearerToken = authFormat{
+	format:  "^[A-Za-z0-9._~+/-]+=*$",
+	pattern: regexp.MustCompile("^[A-Za-z0-9._~+/-]+=*$"),
+}
+
+// extractBearerToken extracts the BearerToken credential from r, stripped of its format, see bearerAuth.
+func extractBearerToken(r *http.Request) (string, bool, error) {
+	value, ok, err := bearerAuth(r, "Authorization")
+	if !ok || err != nil {
+		return value, ok, err
+	}
+	value, err = authFormatBearerToken.strip("Authorization", value)
+	return value, true, err
+}
+
+// GetQueryKey extracts the QueryKey Authentication (query: "api_key") from the request and returns it as a string.
+func GetQueryKey(r *http.Request) (string, error) {
+	credential, ok, err := extractQueryKey(r)
+	if !ok {
+		return credential, fmt.Errorf("missing auth query: %s", "QueryKey")
 	}
-	authHeader = strings.TrimSpace(authHeader)
-	if authHeader == "" {
-		return "", fmt.Errorf("empty auth header: %s", "APIKey")
+	return credential, err
+}
+
+// authFormatQueryKey is the format of the QueryKey credential, as defined in the specification.
+var authFormatQueryKey = authFormat{
+	format: "qk_{token}",
+	prefix: "qk_",
+	suffix: "",
+}
+
+// extractQueryKey extracts the QueryKey credential from r, stripped of its format, see queryAuth.
+func extractQueryKey(r *http.Request) (string, bool, error) {
+	value, ok, err := queryAuth(r, "api_key")
+	if !ok || err != nil {
+		return value, ok, err
 	}
-	return authHeader, nil
+	value, err = authFormatQueryKey.strip("api_key", value)
+	return value, true, err
 }
 
 // GetRefreshToken extracts the RefreshToken Authentication (header: "X-App-Refresh-Token") from the request and returns it as a string.
 func GetRefreshToken(r *http.Request) (string, error) {
-	authHeader := r.Header.Get("X-App-Refresh-Token")
-	if authHeader == "" {
-		return "", fmt.Errorf("missing auth header: %s", "RefreshToken")
+	credential, ok, err := extractRefreshToken(r)
+	if !ok {
+		return credential, fmt.Errorf("missing auth header: %s", "RefreshToken")
 	}
-	authHeader = strings.TrimSpace(authHeader)
-	if authHeader == "" {
-		return "", fmt.Errorf("empty auth header: %s", "RefreshToken")
+	return credential, err
+}
+
+// extractRefreshToken extracts the RefreshToken credential from r, see headerAuth.
+func extractRefreshToken(r *http.Request) (string, bool, error) {
+	return headerAuth(r, "X-App-Refresh-Token")
+}
+
+// GetService extracts the Service Authentication (oauth2ClientCredentials: "X-App-Service-Token") from the request and returns it as a string.
+func GetService(r *http.Request) (string, error) {
+	credential, ok, err := extractService(r)
+	if !ok {
+		return credential, fmt.Errorf("missing auth oauth2ClientCredentials: %s", "Service")
+	}
+	return credential, err
+}
+
+// extractService extracts the Service credential from r, see oauth2ClientCredentialsAuth.
+func extractService(r *http.Request) (string, bool, error) {
+	return oauth2ClientCredentialsAuth(r, "X-App-Service-Token")
+}
+
+// GetSessionCookie extracts the SessionCookie Authentication (cookie: "app_session") from the request and returns it as a string.
+func GetSessionCookie(r *http.Request) (string, error) {
+	credential, ok, err := extractSessionCookie(r)
+	if !ok {
+		return credential, fmt.Errorf("missing auth cookie: %s", "SessionCookie")
+	}
+	return credential, err
+}
+
+// authFormatSessionCookie is the format of the SessionCookie credential, as defined in the specification.
+var authFormatSessionCookie = authFormat{
+	format:  "^v1\\.(?P<token>[A-Za-z0-9-]+)$",
+	pattern: regexp.MustCompile("^v1\\.(?P<token>[A-Za-z0-9-]+)$"),
+}
+
+// extractSessionCookie extracts the SessionCookie credential from r, stripped of its format, see cookieAuth.
+func extractSessionCookie(r *http.Request) (string, bool, error) {
+	value, ok, err := cookieAuth(r, "app_session")
+	if !ok || err != nil {
+		return value, ok, err
 	}
-	return authHeader, nil
+	value, err = authFormatSessionCookie.strip("app_session", value)
+	return value, true, err
 }
 
 // GetSessionToken extracts the SessionToken Authentication (header: "X-App-Session-Token") from the request and returns it as a string.
 func GetSessionToken(r *http.Request) (string, error) {
-	authHeader := r.Header.Get("X-App-Session-Token")
-	if authHeader == "" {
-		return "", fmt.Errorf("missing auth header: %s", "SessionToken")
+	credential, ok, err := extractSessionToken(r)
+	if !ok {
+		return credential, fmt.Errorf("missing auth header: %s", "SessionToken")
+	}
+	return credential, err
+}
+
+// extractSessionToken extracts the SessionToken credential from r, see headerAuth.
+func extractSessionToken(r *http.Request) (string, bool, error) {
+	return headerAuth(r, "X-App-Session-Token")
+}
+
+// GetWebhookSignature extracts the WebhookSignature Authentication (hmac: "X-Signature") from the request and returns it.
+func GetWebhookSignature(r *http.Request) (HMACSignature, error) {
+	credential, ok, err := extractWebhookSignature(r)
+	if !ok {
+		return credential, fmt.Errorf("missing auth hmac: %s", "WebhookSignature")
+	}
+	return credential, err
+}
+
+// extractWebhookSignature extracts the WebhookSignature signature from r, see hmacAuth.
+func extractWebhookSignature(r *http.Request) (HMACSignature, bool, error) {
+	return hmacAuth(r, "X-Signature", "X-Signature-Key-Id", "X-Signature-Timestamp", "sha256", 300*time.Second)
+}
+
+type AuditedEntity struct {
+	Entity
+
+	// The user who last updated the entity, if any.
+	//
+	// Optional
+	//
+	UpdatedBy *string `json:"UpdatedBy,omitempty"`
+}
+
+// NewAuditedEntity creates a new instance of AuditedEntity with required fields as parameters
+func NewAuditedEntity(
+
+	CreatedAt string,
+
+) *AuditedEntity {
+	return &AuditedEntity{
+		Entity: *NewEntity(CreatedAt),
+	}
+}
+
+// WithUpdatedBy sets the optional field UpdatedBy and returns the modified AuditedEntity instance
+func (o *AuditedEntity) WithUpdatedBy(value string) *AuditedEntity {
+	o.UpdatedBy = &value
+	return o
+}
+
+// ParseAuditedEntity creates a new instance of AuditedEntity from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseAuditedEntity(data map[string]any) (*AuditedEntity, error) {
+	verr := &ValidationError{}
+	body := parseAuditedEntity(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseAuditedEntity parses data into a new AuditedEntity, recording issues in verr with paths relative to path.
+func parseAuditedEntity(data map[string]any, path string, verr *ValidationError) *AuditedEntity {
+	body := new(AuditedEntity)
+	body.Entity = *parseEntity(data, path, verr)
+
+	pathUpdatedBy := joinValidationPath(path, "UpdatedBy")
+
+	valUpdatedBy, ok := data["UpdatedBy"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valUpdatedByTyped, ok := valUpdatedBy.(string); !ok {
+			verr.add(ValidationLocationBody, pathUpdatedBy, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valUpdatedByTyped = strings.TrimSpace(valUpdatedByTyped)
+
+			body.UpdatedBy = &valUpdatedByTyped
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into AuditedEntity, with the same checks as ParseAuditedEntity.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *AuditedEntity) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonAuditedEntity holds the raw JSON of the fields of a AuditedEntity, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonAuditedEntity struct {
+	CreatedAt jsonValue `json:"CreatedAt"`
+	UpdatedBy jsonValue `json:"UpdatedBy"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *AuditedEntity) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonAuditedEntity
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseAuditedEntity instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseAuditedEntity(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *AuditedEntity) decodeRaw(raw *jsonAuditedEntity, path string, verr *ValidationError) {
+
+	pathCreatedAt := joinValidationPath(path, "CreatedAt")
+	if raw.CreatedAt.absent() {
+
+		verr.add(ValidationLocationBody, pathCreatedAt, ValidationCodeRequired, "missing required field")
+
+	} else if valCreatedAt, ok := decodeJSONValue[string](raw.CreatedAt, pathCreatedAt, "must be of type string", verr); ok {
+		valCreatedAt = strings.TrimSpace(valCreatedAt)
+
+		if len(valCreatedAt) == 0 {
+			verr.add(ValidationLocationBody, pathCreatedAt, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.CreatedAt = valCreatedAt
 	}
-	authHeader = strings.TrimSpace(authHeader)
-	if authHeader == "" {
-		return "", fmt.Errorf("empty auth header: %s", "SessionToken")
+
+	pathUpdatedBy := joinValidationPath(path, "UpdatedBy")
+	if raw.UpdatedBy.absent() {
+
+		// skip, leave as zero value
+
+	} else if valUpdatedBy, ok := decodeJSONValue[string](raw.UpdatedBy, pathUpdatedBy, "must be of type string", verr); ok {
+		valUpdatedBy = strings.TrimSpace(valUpdatedBy)
+
+		t.UpdatedBy = &valUpdatedBy
 	}
-	return authHeader, nil
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated AuditedEntity,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *AuditedEntity) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *AuditedEntity) validate(path string, verr *ValidationError) {
+	t.Entity.validate(path, verr)
+
 }
 
 type CreateUserRequestBody struct {
@@ -72,6 +368,18 @@ type CreateUserRequestBody struct {
 	//
 	ArbitraryData *map[string]any `json:"ArbitraryData,omitempty"`
 
+	// The email contacts of the created user, by name, returned by the server in the response body.
+	//
+	// Optional
+	//
+	Contacts map[string]EmailNotification `json:"Contacts,omitempty"`
+
+	// Pairs of latitude and longitude, returned by the server in the response body. Just for testing nested array support in the generator.
+	//
+	// Optional
+	//
+	Coordinates [][]float64 `json:"Coordinates,omitempty"`
+
 	// The email address of the user to be created.
 	//
 	// Required
@@ -79,6 +387,24 @@ type CreateUserRequestBody struct {
 	// Must be non-empty
 	Email string `json:"Email"`
 
+	// Labels of the created user, by name, returned by the server in the response body. Just for testing map support in the generator.
+	//
+	// Optional
+	//
+	Labels map[string]string `json:"Labels,omitempty"`
+
+	// Rounds of notifications of the created user, returned by the server in the response body. Just for testing nested arrays of oneOf schemas in the generator.
+	//
+	// Optional
+	//
+	NotificationRounds [][]Notification `json:"NotificationRounds,omitempty"`
+
+	// Further notifications of the created user, returned by the server in the response body.
+	//
+	// Optional
+	//
+	Notifications []Notification `json:"Notifications,omitempty"`
+
 	// An optional status of the user to be created. Just for testing optional enum support in the generator.
 	//
 	// Optional
@@ -97,6 +423,12 @@ type CreateUserRequestBody struct {
 	//
 	// Must be non-empty
 	UserName string `json:"UserName"`
+
+	// The notification sent to the created user, returned by the server in the response body. Just for testing oneOf support in the generator.
+	//
+	// Optional
+	//
+	WelcomeNotification Notification `json:"WelcomeNotification,omitempty"`
 }
 
 // NewCreateUserRequestBody creates a new instance of CreateUserRequestBody with required fields as parameters
@@ -131,15 +463,63 @@ func (o *CreateUserRequestBody) WithArbitraryData(value map[string]any) *CreateU
 	return o
 }
 
+// WithContacts sets the optional field Contacts and returns the modified CreateUserRequestBody instance
+func (o *CreateUserRequestBody) WithContacts(value map[string]EmailNotification) *CreateUserRequestBody {
+	o.Contacts = value
+	return o
+}
+
+// WithCoordinates sets the optional field Coordinates and returns the modified CreateUserRequestBody instance
+func (o *CreateUserRequestBody) WithCoordinates(value [][]float64) *CreateUserRequestBody {
+	o.Coordinates = value
+	return o
+}
+
+// WithLabels sets the optional field Labels and returns the modified CreateUserRequestBody instance
+func (o *CreateUserRequestBody) WithLabels(value map[string]string) *CreateUserRequestBody {
+	o.Labels = value
+	return o
+}
+
+// WithNotificationRounds sets the optional field NotificationRounds and returns the modified CreateUserRequestBody instance
+func (o *CreateUserRequestBody) WithNotificationRounds(value [][]Notification) *CreateUserRequestBody {
+	o.NotificationRounds = value
+	return o
+}
+
+// WithNotifications sets the optional field Notifications and returns the modified CreateUserRequestBody instance
+func (o *CreateUserRequestBody) WithNotifications(value []Notification) *CreateUserRequestBody {
+	o.Notifications = value
+	return o
+}
+
 // WithOptionalStatus sets the optional field OptionalStatus and returns the modified CreateUserRequestBody instance
 func (o *CreateUserRequestBody) WithOptionalStatus(value UserStatus) *CreateUserRequestBody {
 	o.OptionalStatus = &value
 	return o
 }
 
+// WithWelcomeNotification sets the optional field WelcomeNotification and returns the modified CreateUserRequestBody instance
+func (o *CreateUserRequestBody) WithWelcomeNotification(value Notification) *CreateUserRequestBody {
+	o.WelcomeNotification = value
+	return o
+}
+
+// ParseCreateUserRequestBody creates a new instance of CreateUserRequestBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
 func ParseCreateUserRequestBody(data map[string]any) (*CreateUserRequestBody, error) {
+	verr := &ValidationError{}
+	body := parseCreateUserRequestBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseCreateUserRequestBody parses data into a new CreateUserRequestBody, recording issues in verr with paths relative to path.
+func parseCreateUserRequestBody(data map[string]any, path string, verr *ValidationError) *CreateUserRequestBody {
 	body := new(CreateUserRequestBody)
 
+	pathAge := joinValidationPath(path, "Age")
+
 	valAge, ok := data["Age"]
 	if !ok {
 
@@ -147,776 +527,4945 @@ func ParseCreateUserRequestBody(data map[string]any) (*CreateUserRequestBody, er
 
 	} else {
 
-		var valAgeTyped int64
 		// JSON numbers are float64 by default, so we need to handle that case
 		switch v := valAge.(type) {
 		case float64:
-			valAgeTyped = int64(v)
+			valAgeTyped := int64(v)
+			body.Age = &valAgeTyped
 		case int64:
-			valAgeTyped = v
+			body.Age = &v
 		default:
-			return body, fmt.Errorf("field 'Age' has incorrect type")
+			verr.add(ValidationLocationBody, pathAge, ValidationCodeInvalidType, "must be an integer")
 		}
 
-		body.Age = &valAgeTyped
-
 	}
 
+	pathArbitraryData := joinValidationPath(path, "ArbitraryData")
+
 	valArbitraryData, ok := data["ArbitraryData"]
 	if !ok {
 
 		// skip, leave as zero value
 
+	} else if valArbitraryDataTyped, ok := valArbitraryData.(map[string]any); !ok {
+		verr.add(ValidationLocationBody, pathArbitraryData, ValidationCodeInvalidType, "must be an object")
+
+	} else {
+		body.ArbitraryData = &valArbitraryDataTyped
+	}
+
+	pathContacts := joinValidationPath(path, "Contacts")
+
+	valContacts, ok := data["Contacts"]
+	if !ok {
+
+		// skip, leave as zero value
+
 	} else {
-		valArbitraryDataTyped, ok := valArbitraryData.(map[string]any)
+
+		valContactsMap, ok := valContacts.(map[string]any)
 		if !ok {
-			return body, fmt.Errorf("field 'ArbitraryData' has incorrect type")
+			verr.add(ValidationLocationBody, pathContacts, ValidationCodeInvalidType, "must be an object")
+
+		} else {
+			valContactsTyped := make(map[string]EmailNotification, len(valContactsMap))
+			numIssues := len(verr.Issues)
+			// The keys are sorted, so that the issues are recorded in a stable order
+			for _, key := range slices.Sorted(maps.Keys(valContactsMap)) {
+				item := valContactsMap[key]
+				itemPath := joinValidationPath(pathContacts, key)
+
+				itemMap, ok := item.(map[string]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an object")
+					continue
+				}
+				itemTyped := *parseEmailNotification(itemMap, itemPath, verr)
+
+				valContactsTyped[key] = itemTyped
+			}
+			if len(verr.Issues) == numIssues {
+				body.Contacts = valContactsTyped
+			}
 		}
 
-		body.ArbitraryData = &valArbitraryDataTyped
 	}
 
-	valEmail, ok := data["Email"]
+	pathCoordinates := joinValidationPath(path, "Coordinates")
+
+	valCoordinates, ok := data["Coordinates"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'Email'")
+		// skip, leave as zero value
 
 	} else {
 
-		valEmailTyped, ok := valEmail.(string)
+		valCoordinatesSlice, ok := valCoordinates.([]any)
 		if !ok {
-			return body, fmt.Errorf("field 'Email' has incorrect type")
+			verr.add(ValidationLocationBody, pathCoordinates, ValidationCodeInvalidType, "must be an array")
+
+		} else {
+			valCoordinatesTyped := make([][]float64, 0, len(valCoordinatesSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valCoordinatesSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathCoordinates, idx)
+
+				itemSlice1, ok := item.([]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an array")
+					continue
+				}
+				items1 := make([]float64, 0, len(itemSlice1))
+				for idx, item := range itemSlice1 {
+					itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+
+					itemTyped, ok := item.(float64)
+					if !ok {
+						verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be of type float64")
+						continue
+					}
+
+					items1 = append(items1, itemTyped)
+				}
+				itemTyped := items1
+
+				valCoordinatesTyped = append(valCoordinatesTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.Coordinates = valCoordinatesTyped
+			}
 		}
 
-		valEmailTyped = strings.TrimSpace(valEmailTyped)
+	}
 
-		valEmailTyped = strings.TrimSpace(valEmailTyped)
-		if len(valEmailTyped) == 0 {
-			return body, fmt.Errorf("field 'Email' must be non-empty")
-		}
+	pathEmail := joinValidationPath(path, "Email")
+
+	valEmail, ok := data["Email"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathEmail, ValidationCodeRequired, "missing required field")
+
+	} else {
 
-		body.Email = valEmailTyped
+		if valEmailTyped, ok := valEmail.(string); !ok {
+			verr.add(ValidationLocationBody, pathEmail, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valEmailTyped = strings.TrimSpace(valEmailTyped)
+
+			if len(valEmailTyped) == 0 {
+				verr.add(ValidationLocationBody, pathEmail, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Email = valEmailTyped
+		}
 
 	}
 
-	valOptionalStatus, ok := data["OptionalStatus"]
+	pathLabels := joinValidationPath(path, "Labels")
+
+	valLabels, ok := data["Labels"]
 	if !ok {
 
 		// skip, leave as zero value
 
 	} else {
 
-		valOptionalStatusStr, ok := valOptionalStatus.(string)
+		valLabelsMap, ok := valLabels.(map[string]any)
 		if !ok {
-			return body, fmt.Errorf("field 'OptionalStatus' has incorrect type")
-		}
-		valOptionalStatusTyped, err := ParseUserStatus(valOptionalStatusStr)
-		if err != nil {
-			return body, fmt.Errorf("field 'OptionalStatus' is invalid: %w", err)
+			verr.add(ValidationLocationBody, pathLabels, ValidationCodeInvalidType, "must be an object")
+
+		} else {
+			valLabelsTyped := make(map[string]string, len(valLabelsMap))
+			numIssues := len(verr.Issues)
+			// The keys are sorted, so that the issues are recorded in a stable order
+			for _, key := range slices.Sorted(maps.Keys(valLabelsMap)) {
+				item := valLabelsMap[key]
+				itemPath := joinValidationPath(pathLabels, key)
+
+				itemTyped, ok := item.(string)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be a string")
+					continue
+				}
+				itemTyped = strings.TrimSpace(itemTyped)
+
+				valLabelsTyped[key] = itemTyped
+			}
+			if len(verr.Issues) == numIssues {
+				body.Labels = valLabelsTyped
+			}
 		}
 
-		body.OptionalStatus = valOptionalStatusTyped
-
 	}
 
-	valStatus, ok := data["Status"]
+	pathNotificationRounds := joinValidationPath(path, "NotificationRounds")
+
+	valNotificationRounds, ok := data["NotificationRounds"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'Status'")
+		// skip, leave as zero value
 
 	} else {
 
-		valStatusStr, ok := valStatus.(string)
+		valNotificationRoundsSlice, ok := valNotificationRounds.([]any)
 		if !ok {
-			return body, fmt.Errorf("field 'Status' has incorrect type")
-		}
-		valStatusTyped, err := ParseUserStatus(valStatusStr)
-		if err != nil {
-			return body, fmt.Errorf("field 'Status' is invalid: %w", err)
+			verr.add(ValidationLocationBody, pathNotificationRounds, ValidationCodeInvalidType, "must be an array")
+
+		} else {
+			valNotificationRoundsTyped := make([][]Notification, 0, len(valNotificationRoundsSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valNotificationRoundsSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathNotificationRounds, idx)
+
+				itemSlice1, ok := item.([]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an array")
+					continue
+				}
+				items1 := make([]Notification, 0, len(itemSlice1))
+				for idx, item := range itemSlice1 {
+					itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+
+					itemMap, ok := item.(map[string]any)
+					if !ok {
+						verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an object")
+						continue
+					}
+					itemTyped := parseNotification(itemMap, itemPath, verr)
+
+					items1 = append(items1, itemTyped)
+				}
+				itemTyped := items1
+
+				valNotificationRoundsTyped = append(valNotificationRoundsTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.NotificationRounds = valNotificationRoundsTyped
+			}
 		}
 
-		body.Status = *valStatusTyped
-
 	}
 
-	valUserName, ok := data["UserName"]
+	pathNotifications := joinValidationPath(path, "Notifications")
+
+	valNotifications, ok := data["Notifications"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'UserName'")
+		// skip, leave as zero value
 
 	} else {
 
-		valUserNameTyped, ok := valUserName.(string)
+		valNotificationsSlice, ok := valNotifications.([]any)
 		if !ok {
-			return body, fmt.Errorf("field 'UserName' has incorrect type")
+			verr.add(ValidationLocationBody, pathNotifications, ValidationCodeInvalidType, "must be an array")
+
+		} else {
+			valNotificationsTyped := make([]Notification, 0, len(valNotificationsSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valNotificationsSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathNotifications, idx)
+
+				itemMap, ok := item.(map[string]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an object")
+					continue
+				}
+				itemTyped := parseNotification(itemMap, itemPath, verr)
+
+				valNotificationsTyped = append(valNotificationsTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.Notifications = valNotificationsTyped
+			}
 		}
 
-		valUserNameTyped = strings.TrimSpace(valUserNameTyped)
+	}
 
-		valUserNameTyped = strings.TrimSpace(valUserNameTyped)
-		if len(valUserNameTyped) == 0 {
-			return body, fmt.Errorf("field 'UserName' must be non-empty")
-		}
+	pathOptionalStatus := joinValidationPath(path, "OptionalStatus")
 
-		body.UserName = valUserNameTyped
+	valOptionalStatus, ok := data["OptionalStatus"]
+	if !ok {
 
-	}
+		// skip, leave as zero value
 
-	return body, nil
-}
+	} else {
 
-type CreateUserResponseBody struct {
+		if valOptionalStatusStr, ok := valOptionalStatus.(string); !ok {
+			verr.add(ValidationLocationBody, pathOptionalStatus, ValidationCodeInvalidType, "must be a string")
+		} else if valOptionalStatusTyped, err := ParseUserStatus(valOptionalStatusStr); err != nil {
+			verr.add(ValidationLocationBody, pathOptionalStatus, ValidationCodeInvalidValue, err.Error())
+		} else {
+			body.OptionalStatus = valOptionalStatusTyped
+		}
 
-	// An object that can contain any arbitrary data related to the user list response. Just for testing freeForm object support in the generator.
-	//
-	// Optional
-	//
-	ArbitraryData *map[string]any `json:"ArbitraryData,omitempty"`
+	}
 
-	// An optional status of the user. Just for testing optional enum support in the generator.
-	//
-	// Optional
-	//
-	OptionalStatus *UserStatus `json:"OptionalStatus,omitempty"`
+	pathStatus := joinValidationPath(path, "Status")
 
-	// The status of the user.
-	//
-	// Required
-	//
-	Status UserStatus `json:"Status"`
+	valStatus, ok := data["Status"]
+	if !ok {
 
-	// The created user information.
-	//
-	// Required
-	//
-	User *User `json:"User"`
-}
+		verr.add(ValidationLocationBody, pathStatus, ValidationCodeRequired, "missing required field")
 
-// NewCreateUserResponseBody creates a new instance of CreateUserResponseBody with required fields as parameters
-func NewCreateUserResponseBody(
+	} else {
 
-	Status UserStatus,
+		if valStatusStr, ok := valStatus.(string); !ok {
+			verr.add(ValidationLocationBody, pathStatus, ValidationCodeInvalidType, "must be a string")
+		} else if valStatusTyped, err := ParseUserStatus(valStatusStr); err != nil {
+			verr.add(ValidationLocationBody, pathStatus, ValidationCodeInvalidValue, err.Error())
+		} else {
+			body.Status = *valStatusTyped
+		}
 
-	User *User,
+	}
+
+	pathUserName := joinValidationPath(path, "UserName")
+
+	valUserName, ok := data["UserName"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathUserName, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valUserNameTyped, ok := valUserName.(string); !ok {
+			verr.add(ValidationLocationBody, pathUserName, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valUserNameTyped = strings.TrimSpace(valUserNameTyped)
+
+			if len(valUserNameTyped) == 0 {
+				verr.add(ValidationLocationBody, pathUserName, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.UserName = valUserNameTyped
+		}
+
+	}
+
+	pathWelcomeNotification := joinValidationPath(path, "WelcomeNotification")
+
+	valWelcomeNotification, ok := data["WelcomeNotification"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valWelcomeNotificationMap, ok := valWelcomeNotification.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, pathWelcomeNotification, ValidationCodeInvalidType, "must be an object")
+		} else {
+			body.WelcomeNotification = parseNotification(valWelcomeNotificationMap, pathWelcomeNotification, verr)
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into CreateUserRequestBody, with the same checks as ParseCreateUserRequestBody.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *CreateUserRequestBody) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonCreateUserRequestBody holds the raw JSON of the fields of a CreateUserRequestBody, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonCreateUserRequestBody struct {
+	Age                 jsonValue                        `json:"Age"`
+	ArbitraryData       jsonValue                        `json:"ArbitraryData"`
+	Contacts            map[string]jsonEmailNotification `json:"Contacts"`
+	Coordinates         [][]jsonValue                    `json:"Coordinates"`
+	Email               jsonValue                        `json:"Email"`
+	Labels              map[string]jsonValue             `json:"Labels"`
+	NotificationRounds  [][]jsonValue                    `json:"NotificationRounds"`
+	Notifications       []jsonValue                      `json:"Notifications"`
+	OptionalStatus      jsonValue                        `json:"OptionalStatus"`
+	Status              jsonValue                        `json:"Status"`
+	UserName            jsonValue                        `json:"UserName"`
+	WelcomeNotification jsonValue                        `json:"WelcomeNotification"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *CreateUserRequestBody) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonCreateUserRequestBody
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseCreateUserRequestBody instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseCreateUserRequestBody(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *CreateUserRequestBody) decodeRaw(raw *jsonCreateUserRequestBody, path string, verr *ValidationError) {
+
+	pathAge := joinValidationPath(path, "Age")
+	if raw.Age.absent() {
+
+		// skip, leave as zero value
+
+	} else if valAge, ok := decodeJSONValue[int64](raw.Age, pathAge, "must be an integer", verr); ok {
+		t.Age = &valAge
+	}
+
+	pathArbitraryData := joinValidationPath(path, "ArbitraryData")
+	if raw.ArbitraryData.absent() {
+
+		// skip, leave as zero value
+
+	} else if valArbitraryData, ok := decodeJSONValue[map[string]any](raw.ArbitraryData, pathArbitraryData, "must be an object", verr); !ok {
+		// issue already recorded
+
+	} else {
+		t.ArbitraryData = &valArbitraryData
+	}
+
+	pathContacts := joinValidationPath(path, "Contacts")
+	if raw.Contacts == nil {
+
+		// skip, leave as zero value
+
+	} else {
+		valContacts := make(map[string]EmailNotification, len(raw.Contacts))
+		numIssues := len(verr.Issues)
+		// The keys are sorted, so that the issues are recorded in a stable order
+		for _, key := range slices.Sorted(maps.Keys(raw.Contacts)) {
+			rawItem := raw.Contacts[key]
+			itemPath := joinValidationPath(pathContacts, key)
+
+			var item EmailNotification
+			item.decodeRaw(&rawItem, itemPath, verr)
+
+			valContacts[key] = item
+		}
+		if len(verr.Issues) == numIssues {
+			t.Contacts = valContacts
+		}
+	}
+
+	pathCoordinates := joinValidationPath(path, "Coordinates")
+	if raw.Coordinates == nil {
+
+		// skip, leave as zero value
+
+	} else {
+		valCoordinates := make([][]float64, 0, len(raw.Coordinates))
+		numIssues := len(verr.Issues)
+		for idx, rawItem := range raw.Coordinates {
+			itemPath := fmt.Sprintf("%s[%d]", pathCoordinates, idx)
+
+			items1 := make([]float64, 0, len(rawItem))
+			for idx, rawItem := range rawItem {
+				itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+
+				item, ok := decodeJSONValue[float64](rawItem, itemPath, "must be of type float64", verr)
+				if !ok {
+					continue
+				}
+
+				items1 = append(items1, item)
+			}
+			item := items1
+
+			valCoordinates = append(valCoordinates, item)
+		}
+		if len(verr.Issues) == numIssues {
+			t.Coordinates = valCoordinates
+		}
+	}
+
+	pathEmail := joinValidationPath(path, "Email")
+	if raw.Email.absent() {
+
+		verr.add(ValidationLocationBody, pathEmail, ValidationCodeRequired, "missing required field")
+
+	} else if valEmail, ok := decodeJSONValue[string](raw.Email, pathEmail, "must be of type string", verr); ok {
+		valEmail = strings.TrimSpace(valEmail)
+
+		if len(valEmail) == 0 {
+			verr.add(ValidationLocationBody, pathEmail, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.Email = valEmail
+	}
+
+	pathLabels := joinValidationPath(path, "Labels")
+	if raw.Labels == nil {
+
+		// skip, leave as zero value
+
+	} else {
+		valLabels := make(map[string]string, len(raw.Labels))
+		numIssues := len(verr.Issues)
+		// The keys are sorted, so that the issues are recorded in a stable order
+		for _, key := range slices.Sorted(maps.Keys(raw.Labels)) {
+			rawItem := raw.Labels[key]
+			itemPath := joinValidationPath(pathLabels, key)
+
+			item, ok := decodeJSONValue[string](rawItem, itemPath, "must be a string", verr)
+			if !ok {
+				continue
+			}
+			item = strings.TrimSpace(item)
+
+			valLabels[key] = item
+		}
+		if len(verr.Issues) == numIssues {
+			t.Labels = valLabels
+		}
+	}
+
+	pathNotificationRounds := joinValidationPath(path, "NotificationRounds")
+	if raw.NotificationRounds == nil {
+
+		// skip, leave as zero value
+
+	} else {
+		valNotificationRounds := make([][]Notification, 0, len(raw.NotificationRounds))
+		numIssues := len(verr.Issues)
+		for idx, rawItem := range raw.NotificationRounds {
+			itemPath := fmt.Sprintf("%s[%d]", pathNotificationRounds, idx)
+
+			items1 := make([]Notification, 0, len(rawItem))
+			for idx, rawItem := range rawItem {
+				itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+
+				item := decodeNotification(rawItem, itemPath, verr)
+
+				items1 = append(items1, item)
+			}
+			item := items1
+
+			valNotificationRounds = append(valNotificationRounds, item)
+		}
+		if len(verr.Issues) == numIssues {
+			t.NotificationRounds = valNotificationRounds
+		}
+	}
+
+	pathNotifications := joinValidationPath(path, "Notifications")
+	if raw.Notifications == nil {
+
+		// skip, leave as zero value
+
+	} else {
+		valNotifications := make([]Notification, 0, len(raw.Notifications))
+		numIssues := len(verr.Issues)
+		for idx, rawItem := range raw.Notifications {
+			itemPath := fmt.Sprintf("%s[%d]", pathNotifications, idx)
+
+			item := decodeNotification(rawItem, itemPath, verr)
+
+			valNotifications = append(valNotifications, item)
+		}
+		if len(verr.Issues) == numIssues {
+			t.Notifications = valNotifications
+		}
+	}
+
+	pathOptionalStatus := joinValidationPath(path, "OptionalStatus")
+	if raw.OptionalStatus.absent() {
+
+		// skip, leave as zero value
+
+	} else if valOptionalStatusStr, ok := decodeJSONValue[string](raw.OptionalStatus, pathOptionalStatus, "must be a string", verr); !ok {
+		// issue already recorded
+	} else if valOptionalStatus, err := ParseUserStatus(valOptionalStatusStr); err != nil {
+		verr.add(ValidationLocationBody, pathOptionalStatus, ValidationCodeInvalidValue, err.Error())
+	} else {
+		t.OptionalStatus = valOptionalStatus
+	}
+
+	pathStatus := joinValidationPath(path, "Status")
+	if raw.Status.absent() {
+
+		verr.add(ValidationLocationBody, pathStatus, ValidationCodeRequired, "missing required field")
+
+	} else if valStatusStr, ok := decodeJSONValue[string](raw.Status, pathStatus, "must be a string", verr); !ok {
+		// issue already recorded
+	} else if valStatus, err := ParseUserStatus(valStatusStr); err != nil {
+		verr.add(ValidationLocationBody, pathStatus, ValidationCodeInvalidValue, err.Error())
+	} else {
+		t.Status = *valStatus
+	}
+
+	pathUserName := joinValidationPath(path, "UserName")
+	if raw.UserName.absent() {
+
+		verr.add(ValidationLocationBody, pathUserName, ValidationCodeRequired, "missing required field")
+
+	} else if valUserName, ok := decodeJSONValue[string](raw.UserName, pathUserName, "must be of type string", verr); ok {
+		valUserName = strings.TrimSpace(valUserName)
+
+		if len(valUserName) == 0 {
+			verr.add(ValidationLocationBody, pathUserName, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.UserName = valUserName
+	}
+
+	pathWelcomeNotification := joinValidationPath(path, "WelcomeNotification")
+	if raw.WelcomeNotification.absent() {
+
+		// skip, leave as zero value
+
+	} else {
+		t.WelcomeNotification = decodeNotification(raw.WelcomeNotification, pathWelcomeNotification, verr)
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated CreateUserRequestBody,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *CreateUserRequestBody) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *CreateUserRequestBody) validate(path string, verr *ValidationError) {
+
+	// The keys are sorted, so that the issues are recorded in a stable order
+	for _, key := range slices.Sorted(maps.Keys(t.Contacts)) {
+		item := t.Contacts[key]
+		itemPath := joinValidationPath(joinValidationPath(path, "Contacts"), key)
+
+		item.validate(itemPath, verr)
+
+	}
+
+	if len(strings.TrimSpace(t.Email)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Email"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+	for idx, item := range t.NotificationRounds {
+		itemPath := fmt.Sprintf("%s[%d]", joinValidationPath(path, "NotificationRounds"), idx)
+
+		for idx, item := range item {
+			itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+
+			validateNotification(item, itemPath, verr)
+
+		}
+
+	}
+
+	for idx, item := range t.Notifications {
+		itemPath := fmt.Sprintf("%s[%d]", joinValidationPath(path, "Notifications"), idx)
+
+		validateNotification(item, itemPath, verr)
+
+	}
+
+	if t.OptionalStatus != nil {
+
+		if _, err := ParseUserStatus(string(*t.OptionalStatus)); err != nil {
+			verr.add(ValidationLocationBody, joinValidationPath(path, "OptionalStatus"), ValidationCodeInvalidValue, err.Error())
+		}
+
+	}
+
+	if _, err := ParseUserStatus(string(t.Status)); err != nil {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Status"), ValidationCodeInvalidValue, err.Error())
+	}
+
+	if len(strings.TrimSpace(t.UserName)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "UserName"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+	if t.WelcomeNotification != nil {
+		validateNotification(t.WelcomeNotification, joinValidationPath(path, "WelcomeNotification"), verr)
+	}
+
+}
+
+type CreateUserResponseBody struct {
+
+	// An object that can contain any arbitrary data related to the user list response. Just for testing freeForm object support in the generator.
+	//
+	// Optional
+	//
+	ArbitraryData *map[string]any `json:"ArbitraryData,omitempty"`
+
+	// The email contacts of the request, if any.
+	//
+	// Optional
+	//
+	Contacts map[string]EmailNotification `json:"Contacts,omitempty"`
+
+	// The pairs of latitude and longitude of the request, if any.
+	//
+	// Optional
+	//
+	Coordinates [][]float64 `json:"Coordinates,omitempty"`
+
+	// The labels of the request, if any.
+	//
+	// Optional
+	//
+	Labels map[string]string `json:"Labels,omitempty"`
+
+	// The rounds of notifications of the request, if any.
+	//
+	// Optional
+	//
+	NotificationRounds [][]Notification `json:"NotificationRounds,omitempty"`
+
+	// The notifications of the request, if any.
+	//
+	// Optional
+	//
+	Notifications []Notification `json:"Notifications,omitempty"`
+
+	// An optional status of the user. Just for testing optional enum support in the generator.
+	//
+	// Optional
+	//
+	OptionalStatus *UserStatus `json:"OptionalStatus,omitempty"`
+
+	// The status of the user.
+	//
+	// Required
+	//
+	Status UserStatus `json:"Status"`
+
+	// The created user information.
+	//
+	// Required
+	//
+	User *User `json:"User"`
+
+	// The welcome notification of the request, if any.
+	//
+	// Optional
+	//
+	WelcomeNotification Notification `json:"WelcomeNotification,omitempty"`
+}
+
+// NewCreateUserResponseBody creates a new instance of CreateUserResponseBody with required fields as parameters
+func NewCreateUserResponseBody(
+
+	Status UserStatus,
+
+	User *User,
 
 ) *CreateUserResponseBody {
 	return &CreateUserResponseBody{
 
-		Status: Status,
+		Status: Status,
+
+		User: User,
+	}
+}
+
+// WithArbitraryData sets the optional field ArbitraryData and returns the modified CreateUserResponseBody instance
+func (o *CreateUserResponseBody) WithArbitraryData(value map[string]any) *CreateUserResponseBody {
+	o.ArbitraryData = &value
+	return o
+}
+
+// WithContacts sets the optional field Contacts and returns the modified CreateUserResponseBody instance
+func (o *CreateUserResponseBody) WithContacts(value map[string]EmailNotification) *CreateUserResponseBody {
+	o.Contacts = value
+	return o
+}
+
+// WithCoordinates sets the optional field Coordinates and returns the modified CreateUserResponseBody instance
+func (o *CreateUserResponseBody) WithCoordinates(value [][]float64) *CreateUserResponseBody {
+	o.Coordinates = value
+	return o
+}
+
+// WithLabels sets the optional field Labels and returns the modified CreateUserResponseBody instance
+func (o *CreateUserResponseBody) WithLabels(value map[string]string) *CreateUserResponseBody {
+	o.Labels = value
+	return o
+}
+
+// WithNotificationRounds sets the optional field NotificationRounds and returns the modified CreateUserResponseBody instance
+func (o *CreateUserResponseBody) WithNotificationRounds(value [][]Notification) *CreateUserResponseBody {
+	o.NotificationRounds = value
+	return o
+}
+
+// WithNotifications sets the optional field Notifications and returns the modified CreateUserResponseBody instance
+func (o *CreateUserResponseBody) WithNotifications(value []Notification) *CreateUserResponseBody {
+	o.Notifications = value
+	return o
+}
+
+// WithOptionalStatus sets the optional field OptionalStatus and returns the modified CreateUserResponseBody instance
+func (o *CreateUserResponseBody) WithOptionalStatus(value UserStatus) *CreateUserResponseBody {
+	o.OptionalStatus = &value
+	return o
+}
+
+// WithWelcomeNotification sets the optional field WelcomeNotification and returns the modified CreateUserResponseBody instance
+func (o *CreateUserResponseBody) WithWelcomeNotification(value Notification) *CreateUserResponseBody {
+	o.WelcomeNotification = value
+	return o
+}
+
+// ParseCreateUserResponseBody creates a new instance of CreateUserResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseCreateUserResponseBody(data map[string]any) (*CreateUserResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseCreateUserResponseBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseCreateUserResponseBody parses data into a new CreateUserResponseBody, recording issues in verr with paths relative to path.
+func parseCreateUserResponseBody(data map[string]any, path string, verr *ValidationError) *CreateUserResponseBody {
+	body := new(CreateUserResponseBody)
+
+	pathArbitraryData := joinValidationPath(path, "ArbitraryData")
+
+	valArbitraryData, ok := data["ArbitraryData"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else if valArbitraryDataTyped, ok := valArbitraryData.(map[string]any); !ok {
+		verr.add(ValidationLocationBody, pathArbitraryData, ValidationCodeInvalidType, "must be an object")
+
+	} else {
+		body.ArbitraryData = &valArbitraryDataTyped
+	}
+
+	pathContacts := joinValidationPath(path, "Contacts")
+
+	valContacts, ok := data["Contacts"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		valContactsMap, ok := valContacts.(map[string]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathContacts, ValidationCodeInvalidType, "must be an object")
+
+		} else {
+			valContactsTyped := make(map[string]EmailNotification, len(valContactsMap))
+			numIssues := len(verr.Issues)
+			// The keys are sorted, so that the issues are recorded in a stable order
+			for _, key := range slices.Sorted(maps.Keys(valContactsMap)) {
+				item := valContactsMap[key]
+				itemPath := joinValidationPath(pathContacts, key)
+
+				itemMap, ok := item.(map[string]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an object")
+					continue
+				}
+				itemTyped := *parseEmailNotification(itemMap, itemPath, verr)
+
+				valContactsTyped[key] = itemTyped
+			}
+			if len(verr.Issues) == numIssues {
+				body.Contacts = valContactsTyped
+			}
+		}
+
+	}
+
+	pathCoordinates := joinValidationPath(path, "Coordinates")
+
+	valCoordinates, ok := data["Coordinates"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		valCoordinatesSlice, ok := valCoordinates.([]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathCoordinates, ValidationCodeInvalidType, "must be an array")
+
+		} else {
+			valCoordinatesTyped := make([][]float64, 0, len(valCoordinatesSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valCoordinatesSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathCoordinates, idx)
+
+				itemSlice1, ok := item.([]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an array")
+					continue
+				}
+				items1 := make([]float64, 0, len(itemSlice1))
+				for idx, item := range itemSlice1 {
+					itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+
+					itemTyped, ok := item.(float64)
+					if !ok {
+						verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be of type float64")
+						continue
+					}
+
+					items1 = append(items1, itemTyped)
+				}
+				itemTyped := items1
+
+				valCoordinatesTyped = append(valCoordinatesTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.Coordinates = valCoordinatesTyped
+			}
+		}
+
+	}
+
+	pathLabels := joinValidationPath(path, "Labels")
+
+	valLabels, ok := data["Labels"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		valLabelsMap, ok := valLabels.(map[string]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathLabels, ValidationCodeInvalidType, "must be an object")
+
+		} else {
+			valLabelsTyped := make(map[string]string, len(valLabelsMap))
+			numIssues := len(verr.Issues)
+			// The keys are sorted, so that the issues are recorded in a stable order
+			for _, key := range slices.Sorted(maps.Keys(valLabelsMap)) {
+				item := valLabelsMap[key]
+				itemPath := joinValidationPath(pathLabels, key)
+
+				itemTyped, ok := item.(string)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be a string")
+					continue
+				}
+				itemTyped = strings.TrimSpace(itemTyped)
+
+				valLabelsTyped[key] = itemTyped
+			}
+			if len(verr.Issues) == numIssues {
+				body.Labels = valLabelsTyped
+			}
+		}
+
+	}
+
+	pathNotificationRounds := joinValidationPath(path, "NotificationRounds")
+
+	valNotificationRounds, ok := data["NotificationRounds"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		valNotificationRoundsSlice, ok := valNotificationRounds.([]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathNotificationRounds, ValidationCodeInvalidType, "must be an array")
+
+		} else {
+			valNotificationRoundsTyped := make([][]Notification, 0, len(valNotificationRoundsSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valNotificationRoundsSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathNotificationRounds, idx)
+
+				itemSlice1, ok := item.([]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an array")
+					continue
+				}
+				items1 := make([]Notification, 0, len(itemSlice1))
+				for idx, item := range itemSlice1 {
+					itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+
+					itemMap, ok := item.(map[string]any)
+					if !ok {
+						verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an object")
+						continue
+					}
+					itemTyped := parseNotification(itemMap, itemPath, verr)
+
+					items1 = append(items1, itemTyped)
+				}
+				itemTyped := items1
+
+				valNotificationRoundsTyped = append(valNotificationRoundsTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.NotificationRounds = valNotificationRoundsTyped
+			}
+		}
+
+	}
+
+	pathNotifications := joinValidationPath(path, "Notifications")
+
+	valNotifications, ok := data["Notifications"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		valNotificationsSlice, ok := valNotifications.([]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathNotifications, ValidationCodeInvalidType, "must be an array")
+
+		} else {
+			valNotificationsTyped := make([]Notification, 0, len(valNotificationsSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valNotificationsSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathNotifications, idx)
+
+				itemMap, ok := item.(map[string]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an object")
+					continue
+				}
+				itemTyped := parseNotification(itemMap, itemPath, verr)
+
+				valNotificationsTyped = append(valNotificationsTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.Notifications = valNotificationsTyped
+			}
+		}
+
+	}
+
+	pathOptionalStatus := joinValidationPath(path, "OptionalStatus")
+
+	valOptionalStatus, ok := data["OptionalStatus"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valOptionalStatusStr, ok := valOptionalStatus.(string); !ok {
+			verr.add(ValidationLocationBody, pathOptionalStatus, ValidationCodeInvalidType, "must be a string")
+		} else if valOptionalStatusTyped, err := ParseUserStatus(valOptionalStatusStr); err != nil {
+			verr.add(ValidationLocationBody, pathOptionalStatus, ValidationCodeInvalidValue, err.Error())
+		} else {
+			body.OptionalStatus = valOptionalStatusTyped
+		}
+
+	}
+
+	pathStatus := joinValidationPath(path, "Status")
+
+	valStatus, ok := data["Status"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathStatus, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valStatusStr, ok := valStatus.(string); !ok {
+			verr.add(ValidationLocationBody, pathStatus, ValidationCodeInvalidType, "must be a string")
+		} else if valStatusTyped, err := ParseUserStatus(valStatusStr); err != nil {
+			verr.add(ValidationLocationBody, pathStatus, ValidationCodeInvalidValue, err.Error())
+		} else {
+			body.Status = *valStatusTyped
+		}
+
+	}
+
+	pathUser := joinValidationPath(path, "User")
+
+	valUser, ok := data["User"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathUser, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valUserMap, ok := valUser.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, pathUser, ValidationCodeInvalidType, "must be an object")
+		} else {
+			body.User = parseUser(valUserMap, pathUser, verr)
+		}
+
+	}
+
+	pathWelcomeNotification := joinValidationPath(path, "WelcomeNotification")
+
+	valWelcomeNotification, ok := data["WelcomeNotification"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valWelcomeNotificationMap, ok := valWelcomeNotification.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, pathWelcomeNotification, ValidationCodeInvalidType, "must be an object")
+		} else {
+			body.WelcomeNotification = parseNotification(valWelcomeNotificationMap, pathWelcomeNotification, verr)
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into CreateUserResponseBody, with the same checks as ParseCreateUserResponseBody.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *CreateUserResponseBody) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonCreateUserResponseBody holds the raw JSON of the fields of a CreateUserResponseBody, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonCreateUserResponseBody struct {
+	ArbitraryData       jsonValue                        `json:"ArbitraryData"`
+	Contacts            map[string]jsonEmailNotification `json:"Contacts"`
+	Coordinates         [][]jsonValue                    `json:"Coordinates"`
+	Labels              map[string]jsonValue             `json:"Labels"`
+	NotificationRounds  [][]jsonValue                    `json:"NotificationRounds"`
+	Notifications       []jsonValue                      `json:"Notifications"`
+	OptionalStatus      jsonValue                        `json:"OptionalStatus"`
+	Status              jsonValue                        `json:"Status"`
+	User                *jsonUser                        `json:"User"`
+	WelcomeNotification jsonValue                        `json:"WelcomeNotification"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *CreateUserResponseBody) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonCreateUserResponseBody
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseCreateUserResponseBody instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseCreateUserResponseBody(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *CreateUserResponseBody) decodeRaw(raw *jsonCreateUserResponseBody, path string, verr *ValidationError) {
+
+	pathArbitraryData := joinValidationPath(path, "ArbitraryData")
+	if raw.ArbitraryData.absent() {
+
+		// skip, leave as zero value
+
+	} else if valArbitraryData, ok := decodeJSONValue[map[string]any](raw.ArbitraryData, pathArbitraryData, "must be an object", verr); !ok {
+		// issue already recorded
+
+	} else {
+		t.ArbitraryData = &valArbitraryData
+	}
+
+	pathContacts := joinValidationPath(path, "Contacts")
+	if raw.Contacts == nil {
+
+		// skip, leave as zero value
+
+	} else {
+		valContacts := make(map[string]EmailNotification, len(raw.Contacts))
+		numIssues := len(verr.Issues)
+		// The keys are sorted, so that the issues are recorded in a stable order
+		for _, key := range slices.Sorted(maps.Keys(raw.Contacts)) {
+			rawItem := raw.Contacts[key]
+			itemPath := joinValidationPath(pathContacts, key)
+
+			var item EmailNotification
+			item.decodeRaw(&rawItem, itemPath, verr)
+
+			valContacts[key] = item
+		}
+		if len(verr.Issues) == numIssues {
+			t.Contacts = valContacts
+		}
+	}
+
+	pathCoordinates := joinValidationPath(path, "Coordinates")
+	if raw.Coordinates == nil {
+
+		// skip, leave as zero value
+
+	} else {
+		valCoordinates := make([][]float64, 0, len(raw.Coordinates))
+		numIssues := len(verr.Issues)
+		for idx, rawItem := range raw.Coordinates {
+			itemPath := fmt.Sprintf("%s[%d]", pathCoordinates, idx)
+
+			items1 := make([]float64, 0, len(rawItem))
+			for idx, rawItem := range rawItem {
+				itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+
+				item, ok := decodeJSONValue[float64](rawItem, itemPath, "must be of type float64", verr)
+				if !ok {
+					continue
+				}
+
+				items1 = append(items1, item)
+			}
+			item := items1
+
+			valCoordinates = append(valCoordinates, item)
+		}
+		if len(verr.Issues) == numIssues {
+			t.Coordinates = valCoordinates
+		}
+	}
+
+	pathLabels := joinValidationPath(path, "Labels")
+	if raw.Labels == nil {
+
+		// skip, leave as zero value
+
+	} else {
+		valLabels := make(map[string]string, len(raw.Labels))
+		numIssues := len(verr.Issues)
+		// The keys are sorted, so that the issues are recorded in a stable order
+		for _, key := range slices.Sorted(maps.Keys(raw.Labels)) {
+			rawItem := raw.Labels[key]
+			itemPath := joinValidationPath(pathLabels, key)
+
+			item, ok := decodeJSONValue[string](rawItem, itemPath, "must be a string", verr)
+			if !ok {
+				continue
+			}
+			item = strings.TrimSpace(item)
+
+			valLabels[key] = item
+		}
+		if len(verr.Issues) == numIssues {
+			t.Labels = valLabels
+		}
+	}
+
+	pathNotificationRounds := joinValidationPath(path, "NotificationRounds")
+	if raw.NotificationRounds == nil {
+
+		// skip, leave as zero value
+
+	} else {
+		valNotificationRounds := make([][]Notification, 0, len(raw.NotificationRounds))
+		numIssues := len(verr.Issues)
+		for idx, rawItem := range raw.NotificationRounds {
+			itemPath := fmt.Sprintf("%s[%d]", pathNotificationRounds, idx)
+
+			items1 := make([]Notification, 0, len(rawItem))
+			for idx, rawItem := range rawItem {
+				itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+
+				item := decodeNotification(rawItem, itemPath, verr)
+
+				items1 = append(items1, item)
+			}
+			item := items1
+
+			valNotificationRounds = append(valNotificationRounds, item)
+		}
+		if len(verr.Issues) == numIssues {
+			t.NotificationRounds = valNotificationRounds
+		}
+	}
+
+	pathNotifications := joinValidationPath(path, "Notifications")
+	if raw.Notifications == nil {
+
+		// skip, leave as zero value
+
+	} else {
+		valNotifications := make([]Notification, 0, len(raw.Notifications))
+		numIssues := len(verr.Issues)
+		for idx, rawItem := range raw.Notifications {
+			itemPath := fmt.Sprintf("%s[%d]", pathNotifications, idx)
+
+			item := decodeNotification(rawItem, itemPath, verr)
+
+			valNotifications = append(valNotifications, item)
+		}
+		if len(verr.Issues) == numIssues {
+			t.Notifications = valNotifications
+		}
+	}
+
+	pathOptionalStatus := joinValidationPath(path, "OptionalStatus")
+	if raw.OptionalStatus.absent() {
+
+		// skip, leave as zero value
+
+	} else if valOptionalStatusStr, ok := decodeJSONValue[string](raw.OptionalStatus, pathOptionalStatus, "must be a string", verr); !ok {
+		// issue already recorded
+	} else if valOptionalStatus, err := ParseUserStatus(valOptionalStatusStr); err != nil {
+		verr.add(ValidationLocationBody, pathOptionalStatus, ValidationCodeInvalidValue, err.Error())
+	} else {
+		t.OptionalStatus = valOptionalStatus
+	}
+
+	pathStatus := joinValidationPath(path, "Status")
+	if raw.Status.absent() {
+
+		verr.add(ValidationLocationBody, pathStatus, ValidationCodeRequired, "missing required field")
+
+	} else if valStatusStr, ok := decodeJSONValue[string](raw.Status, pathStatus, "must be a string", verr); !ok {
+		// issue already recorded
+	} else if valStatus, err := ParseUserStatus(valStatusStr); err != nil {
+		verr.add(ValidationLocationBody, pathStatus, ValidationCodeInvalidValue, err.Error())
+	} else {
+		t.Status = *valStatus
+	}
+
+	pathUser := joinValidationPath(path, "User")
+	if raw.User == nil {
+
+		verr.add(ValidationLocationBody, pathUser, ValidationCodeRequired, "missing required field")
+
+	} else {
+		valUser := new(User)
+		valUser.decodeRaw(raw.User, pathUser, verr)
+		t.User = valUser
+	}
+
+	pathWelcomeNotification := joinValidationPath(path, "WelcomeNotification")
+	if raw.WelcomeNotification.absent() {
+
+		// skip, leave as zero value
+
+	} else {
+		t.WelcomeNotification = decodeNotification(raw.WelcomeNotification, pathWelcomeNotification, verr)
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated CreateUserResponseBody,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *CreateUserResponseBody) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *CreateUserResponseBody) validate(path string, verr *ValidationError) {
+
+	// The keys are sorted, so that the issues are recorded in a stable order
+	for _, key := range slices.Sorted(maps.Keys(t.Contacts)) {
+		item := t.Contacts[key]
+		itemPath := joinValidationPath(joinValidationPath(path, "Contacts"), key)
+
+		item.validate(itemPath, verr)
+
+	}
+
+	for idx, item := range t.NotificationRounds {
+		itemPath := fmt.Sprintf("%s[%d]", joinValidationPath(path, "NotificationRounds"), idx)
+
+		for idx, item := range item {
+			itemPath := fmt.Sprintf("%s[%d]", itemPath, idx)
+
+			validateNotification(item, itemPath, verr)
+
+		}
+
+	}
+
+	for idx, item := range t.Notifications {
+		itemPath := fmt.Sprintf("%s[%d]", joinValidationPath(path, "Notifications"), idx)
+
+		validateNotification(item, itemPath, verr)
+
+	}
+
+	if t.OptionalStatus != nil {
+
+		if _, err := ParseUserStatus(string(*t.OptionalStatus)); err != nil {
+			verr.add(ValidationLocationBody, joinValidationPath(path, "OptionalStatus"), ValidationCodeInvalidValue, err.Error())
+		}
+
+	}
+
+	if _, err := ParseUserStatus(string(t.Status)); err != nil {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Status"), ValidationCodeInvalidValue, err.Error())
+	}
+
+	if t.User == nil {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "User"), ValidationCodeRequired, "missing required field")
+	} else {
+
+		t.User.validate(joinValidationPath(path, "User"), verr)
+
+	}
+
+	if t.WelcomeNotification != nil {
+		validateNotification(t.WelcomeNotification, joinValidationPath(path, "WelcomeNotification"), verr)
+	}
+
+}
+
+type DeleteUserResponseBody struct {
+
+	// The auth alternative the request was authorized with, e.g. "adminTokenAuth".
+	//
+	// Required
+	//
+	// Must be non-empty
+	AuthAlternative string `json:"AuthAlternative"`
+
+	// The unique identifier of the deleted user.
+	//
+	// Required
+	//
+	// Must be non-empty
+	UserId string `json:"UserId"`
+}
+
+// NewDeleteUserResponseBody creates a new instance of DeleteUserResponseBody with required fields as parameters
+func NewDeleteUserResponseBody(
+
+	AuthAlternative string,
+
+	UserId string,
+
+) *DeleteUserResponseBody {
+	return &DeleteUserResponseBody{
+
+		AuthAlternative: AuthAlternative,
+
+		UserId: UserId,
+	}
+}
+
+// ParseDeleteUserResponseBody creates a new instance of DeleteUserResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseDeleteUserResponseBody(data map[string]any) (*DeleteUserResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseDeleteUserResponseBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseDeleteUserResponseBody parses data into a new DeleteUserResponseBody, recording issues in verr with paths relative to path.
+func parseDeleteUserResponseBody(data map[string]any, path string, verr *ValidationError) *DeleteUserResponseBody {
+	body := new(DeleteUserResponseBody)
+
+	pathAuthAlternative := joinValidationPath(path, "AuthAlternative")
+
+	valAuthAlternative, ok := data["AuthAlternative"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathAuthAlternative, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valAuthAlternativeTyped, ok := valAuthAlternative.(string); !ok {
+			verr.add(ValidationLocationBody, pathAuthAlternative, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valAuthAlternativeTyped = strings.TrimSpace(valAuthAlternativeTyped)
+
+			if len(valAuthAlternativeTyped) == 0 {
+				verr.add(ValidationLocationBody, pathAuthAlternative, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.AuthAlternative = valAuthAlternativeTyped
+		}
+
+	}
+
+	pathUserId := joinValidationPath(path, "UserId")
+
+	valUserId, ok := data["UserId"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathUserId, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valUserIdTyped, ok := valUserId.(string); !ok {
+			verr.add(ValidationLocationBody, pathUserId, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valUserIdTyped = strings.TrimSpace(valUserIdTyped)
+
+			if len(valUserIdTyped) == 0 {
+				verr.add(ValidationLocationBody, pathUserId, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.UserId = valUserIdTyped
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into DeleteUserResponseBody, with the same checks as ParseDeleteUserResponseBody.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *DeleteUserResponseBody) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonDeleteUserResponseBody holds the raw JSON of the fields of a DeleteUserResponseBody, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonDeleteUserResponseBody struct {
+	AuthAlternative jsonValue `json:"AuthAlternative"`
+	UserId          jsonValue `json:"UserId"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *DeleteUserResponseBody) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonDeleteUserResponseBody
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseDeleteUserResponseBody instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseDeleteUserResponseBody(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *DeleteUserResponseBody) decodeRaw(raw *jsonDeleteUserResponseBody, path string, verr *ValidationError) {
+
+	pathAuthAlternative := joinValidationPath(path, "AuthAlternative")
+	if raw.AuthAlternative.absent() {
+
+		verr.add(ValidationLocationBody, pathAuthAlternative, ValidationCodeRequired, "missing required field")
+
+	} else if valAuthAlternative, ok := decodeJSONValue[string](raw.AuthAlternative, pathAuthAlternative, "must be of type string", verr); ok {
+		valAuthAlternative = strings.TrimSpace(valAuthAlternative)
+
+		if len(valAuthAlternative) == 0 {
+			verr.add(ValidationLocationBody, pathAuthAlternative, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.AuthAlternative = valAuthAlternative
+	}
+
+	pathUserId := joinValidationPath(path, "UserId")
+	if raw.UserId.absent() {
+
+		verr.add(ValidationLocationBody, pathUserId, ValidationCodeRequired, "missing required field")
+
+	} else if valUserId, ok := decodeJSONValue[string](raw.UserId, pathUserId, "must be of type string", verr); ok {
+		valUserId = strings.TrimSpace(valUserId)
+
+		if len(valUserId) == 0 {
+			verr.add(ValidationLocationBody, pathUserId, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.UserId = valUserId
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated DeleteUserResponseBody,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *DeleteUserResponseBody) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *DeleteUserResponseBody) validate(path string, verr *ValidationError) {
+
+	if len(strings.TrimSpace(t.AuthAlternative)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "AuthAlternative"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+	if len(strings.TrimSpace(t.UserId)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "UserId"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+}
+
+type EmailNotification struct {
+
+	// The email address the notification is sent to.
+	//
+	// Required
+	//
+	// Must be non-empty
+	Address string `json:"Address"`
+
+	// The subject of the email.
+	//
+	// Optional
+	//
+	Subject *string `json:"Subject,omitempty"`
+}
+
+// NewEmailNotification creates a new instance of EmailNotification with required fields as parameters
+func NewEmailNotification(
+
+	Address string,
+
+) *EmailNotification {
+	return &EmailNotification{
+
+		Address: Address,
+	}
+}
+
+// WithSubject sets the optional field Subject and returns the modified EmailNotification instance
+func (o *EmailNotification) WithSubject(value string) *EmailNotification {
+	o.Subject = &value
+	return o
+}
+
+// ParseEmailNotification creates a new instance of EmailNotification from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseEmailNotification(data map[string]any) (*EmailNotification, error) {
+	verr := &ValidationError{}
+	body := parseEmailNotification(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseEmailNotification parses data into a new EmailNotification, recording issues in verr with paths relative to path.
+func parseEmailNotification(data map[string]any, path string, verr *ValidationError) *EmailNotification {
+	body := new(EmailNotification)
+
+	pathAddress := joinValidationPath(path, "Address")
+
+	valAddress, ok := data["Address"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathAddress, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valAddressTyped, ok := valAddress.(string); !ok {
+			verr.add(ValidationLocationBody, pathAddress, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valAddressTyped = strings.TrimSpace(valAddressTyped)
+
+			if len(valAddressTyped) == 0 {
+				verr.add(ValidationLocationBody, pathAddress, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Address = valAddressTyped
+		}
+
+	}
+
+	pathSubject := joinValidationPath(path, "Subject")
+
+	valSubject, ok := data["Subject"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valSubjectTyped, ok := valSubject.(string); !ok {
+			verr.add(ValidationLocationBody, pathSubject, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valSubjectTyped = strings.TrimSpace(valSubjectTyped)
+
+			body.Subject = &valSubjectTyped
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into EmailNotification, with the same checks as ParseEmailNotification.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *EmailNotification) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonEmailNotification holds the raw JSON of the fields of a EmailNotification, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonEmailNotification struct {
+	Address jsonValue `json:"Address"`
+	Subject jsonValue `json:"Subject"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *EmailNotification) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonEmailNotification
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseEmailNotification instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseEmailNotification(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *EmailNotification) decodeRaw(raw *jsonEmailNotification, path string, verr *ValidationError) {
+
+	pathAddress := joinValidationPath(path, "Address")
+	if raw.Address.absent() {
+
+		verr.add(ValidationLocationBody, pathAddress, ValidationCodeRequired, "missing required field")
+
+	} else if valAddress, ok := decodeJSONValue[string](raw.Address, pathAddress, "must be of type string", verr); ok {
+		valAddress = strings.TrimSpace(valAddress)
+
+		if len(valAddress) == 0 {
+			verr.add(ValidationLocationBody, pathAddress, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.Address = valAddress
+	}
+
+	pathSubject := joinValidationPath(path, "Subject")
+	if raw.Subject.absent() {
+
+		// skip, leave as zero value
+
+	} else if valSubject, ok := decodeJSONValue[string](raw.Subject, pathSubject, "must be of type string", verr); ok {
+		valSubject = strings.TrimSpace(valSubject)
+
+		t.Subject = &valSubject
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated EmailNotification,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *EmailNotification) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *EmailNotification) validate(path string, verr *ValidationError) {
+
+	if len(strings.TrimSpace(t.Address)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Address"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+}
+
+type Entity struct {
+
+	// When the entity was created, in RFC 3339 format.
+	//
+	// Required
+	//
+	// Must be non-empty
+	CreatedAt string `json:"CreatedAt"`
+}
+
+// NewEntity creates a new instance of Entity with required fields as parameters
+func NewEntity(
+
+	CreatedAt string,
+
+) *Entity {
+	return &Entity{
+
+		CreatedAt: CreatedAt,
+	}
+}
+
+// ParseEntity creates a new instance of Entity from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseEntity(data map[string]any) (*Entity, error) {
+	verr := &ValidationError{}
+	body := parseEntity(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseEntity parses data into a new Entity, recording issues in verr with paths relative to path.
+func parseEntity(data map[string]any, path string, verr *ValidationError) *Entity {
+	body := new(Entity)
+
+	pathCreatedAt := joinValidationPath(path, "CreatedAt")
+
+	valCreatedAt, ok := data["CreatedAt"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathCreatedAt, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valCreatedAtTyped, ok := valCreatedAt.(string); !ok {
+			verr.add(ValidationLocationBody, pathCreatedAt, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valCreatedAtTyped = strings.TrimSpace(valCreatedAtTyped)
+
+			if len(valCreatedAtTyped) == 0 {
+				verr.add(ValidationLocationBody, pathCreatedAt, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.CreatedAt = valCreatedAtTyped
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into Entity, with the same checks as ParseEntity.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *Entity) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonEntity holds the raw JSON of the fields of a Entity, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonEntity struct {
+	CreatedAt jsonValue `json:"CreatedAt"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *Entity) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonEntity
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseEntity instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseEntity(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *Entity) decodeRaw(raw *jsonEntity, path string, verr *ValidationError) {
+
+	pathCreatedAt := joinValidationPath(path, "CreatedAt")
+	if raw.CreatedAt.absent() {
+
+		verr.add(ValidationLocationBody, pathCreatedAt, ValidationCodeRequired, "missing required field")
+
+	} else if valCreatedAt, ok := decodeJSONValue[string](raw.CreatedAt, pathCreatedAt, "must be of type string", verr); ok {
+		valCreatedAt = strings.TrimSpace(valCreatedAt)
+
+		if len(valCreatedAt) == 0 {
+			verr.add(ValidationLocationBody, pathCreatedAt, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.CreatedAt = valCreatedAt
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated Entity,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *Entity) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *Entity) validate(path string, verr *ValidationError) {
+
+	if len(strings.TrimSpace(t.CreatedAt)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "CreatedAt"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+}
+
+type ErrorResponse struct {
+
+	// A detailed debug message for developers. Only passed if in debug mode.
+	//
+	// Optional
+	//
+	DebugMessage *string `json:"DebugMessage,omitempty"`
+
+	// An error message which is user-friendly.
+	//
+	// Required
+	//
+	// Must be non-empty
+	ErrorMessage string `json:"ErrorMessage"`
+}
+
+// NewErrorResponse creates a new instance of ErrorResponse with required fields as parameters
+func NewErrorResponse(
+
+	ErrorMessage string,
+
+) *ErrorResponse {
+	return &ErrorResponse{
+
+		ErrorMessage: ErrorMessage,
+	}
+}
+
+// WithDebugMessage sets the optional field DebugMessage and returns the modified ErrorResponse instance
+func (o *ErrorResponse) WithDebugMessage(value string) *ErrorResponse {
+	o.DebugMessage = &value
+	return o
+}
+
+// ParseErrorResponse creates a new instance of ErrorResponse from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseErrorResponse(data map[string]any) (*ErrorResponse, error) {
+	verr := &ValidationError{}
+	body := parseErrorResponse(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseErrorResponse parses data into a new ErrorResponse, recording issues in verr with paths relative to path.
+func parseErrorResponse(data map[string]any, path string, verr *ValidationError) *ErrorResponse {
+	body := new(ErrorResponse)
+
+	pathDebugMessage := joinValidationPath(path, "DebugMessage")
+
+	valDebugMessage, ok := data["DebugMessage"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valDebugMessageTyped, ok := valDebugMessage.(string); !ok {
+			verr.add(ValidationLocationBody, pathDebugMessage, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valDebugMessageTyped = strings.TrimSpace(valDebugMessageTyped)
+
+			body.DebugMessage = &valDebugMessageTyped
+		}
+
+	}
+
+	pathErrorMessage := joinValidationPath(path, "ErrorMessage")
+
+	valErrorMessage, ok := data["ErrorMessage"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valErrorMessageTyped, ok := valErrorMessage.(string); !ok {
+			verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valErrorMessageTyped = strings.TrimSpace(valErrorMessageTyped)
+
+			if len(valErrorMessageTyped) == 0 {
+				verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.ErrorMessage = valErrorMessageTyped
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into ErrorResponse, with the same checks as ParseErrorResponse.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *ErrorResponse) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonErrorResponse holds the raw JSON of the fields of a ErrorResponse, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonErrorResponse struct {
+	DebugMessage jsonValue `json:"DebugMessage"`
+	ErrorMessage jsonValue `json:"ErrorMessage"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *ErrorResponse) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonErrorResponse
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseErrorResponse instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseErrorResponse(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *ErrorResponse) decodeRaw(raw *jsonErrorResponse, path string, verr *ValidationError) {
+
+	pathDebugMessage := joinValidationPath(path, "DebugMessage")
+	if raw.DebugMessage.absent() {
+
+		// skip, leave as zero value
+
+	} else if valDebugMessage, ok := decodeJSONValue[string](raw.DebugMessage, pathDebugMessage, "must be of type string", verr); ok {
+		valDebugMessage = strings.TrimSpace(valDebugMessage)
+
+		t.DebugMessage = &valDebugMessage
+	}
+
+	pathErrorMessage := joinValidationPath(path, "ErrorMessage")
+	if raw.ErrorMessage.absent() {
+
+		verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeRequired, "missing required field")
+
+	} else if valErrorMessage, ok := decodeJSONValue[string](raw.ErrorMessage, pathErrorMessage, "must be of type string", verr); ok {
+		valErrorMessage = strings.TrimSpace(valErrorMessage)
+
+		if len(valErrorMessage) == 0 {
+			verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.ErrorMessage = valErrorMessage
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated ErrorResponse,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *ErrorResponse) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *ErrorResponse) validate(path string, verr *ValidationError) {
+
+	if len(strings.TrimSpace(t.ErrorMessage)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "ErrorMessage"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+}
+
+type EventFeed struct {
+
+	// The IDs of the events of the page.
+	//
+	// Required
+	//
+	Events []string `json:"Events"`
+
+	// The cursor of the next page, absent on the last one.
+	//
+	// Optional
+	//
+	NextCursor *string `json:"NextCursor,omitempty"`
+}
+
+// NewEventFeed creates a new instance of EventFeed with required fields as parameters
+func NewEventFeed(
+
+	Events []string,
+
+) *EventFeed {
+	return &EventFeed{
+
+		Events: Events,
+	}
+}
+
+// WithNextCursor sets the optional field NextCursor and returns the modified EventFeed instance
+func (o *EventFeed) WithNextCursor(value string) *EventFeed {
+	o.NextCursor = &value
+	return o
+}
+
+// ParseEventFeed creates a new instance of EventFeed from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseEventFeed(data map[string]any) (*EventFeed, error) {
+	verr := &ValidationError{}
+	body := parseEventFeed(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseEventFeed parses data into a new EventFeed, recording issues in verr with paths relative to path.
+func parseEventFeed(data map[string]any, path string, verr *ValidationError) *EventFeed {
+	body := new(EventFeed)
+
+	pathEvents := joinValidationPath(path, "Events")
+
+	valEvents, ok := data["Events"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathEvents, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		valEventsSlice, ok := valEvents.([]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathEvents, ValidationCodeInvalidType, "must be an array")
+
+		} else if len(valEventsSlice) == 0 {
+			verr.add(ValidationLocationBody, pathEvents, ValidationCodeNonEmpty, "must be non-empty")
+
+		} else {
+			valEventsTyped := make([]string, 0, len(valEventsSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valEventsSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathEvents, idx)
+
+				itemTyped, ok := item.(string)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be a string")
+					continue
+				}
+				itemTyped = strings.TrimSpace(itemTyped)
+
+				if len(itemTyped) == 0 {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeNonEmpty, "must be non-empty")
+					continue
+				}
+
+				valEventsTyped = append(valEventsTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.Events = valEventsTyped
+			}
+		}
+
+	}
+
+	pathNextCursor := joinValidationPath(path, "NextCursor")
+
+	valNextCursor, ok := data["NextCursor"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valNextCursorTyped, ok := valNextCursor.(string); !ok {
+			verr.add(ValidationLocationBody, pathNextCursor, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valNextCursorTyped = strings.TrimSpace(valNextCursorTyped)
+
+			body.NextCursor = &valNextCursorTyped
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into EventFeed, with the same checks as ParseEventFeed.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *EventFeed) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonEventFeed holds the raw JSON of the fields of a EventFeed, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonEventFeed struct {
+	Events     []jsonValue `json:"Events"`
+	NextCursor jsonValue   `json:"NextCursor"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *EventFeed) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonEventFeed
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseEventFeed instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseEventFeed(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *EventFeed) decodeRaw(raw *jsonEventFeed, path string, verr *ValidationError) {
+
+	pathEvents := joinValidationPath(path, "Events")
+	if raw.Events == nil {
+
+		verr.add(ValidationLocationBody, pathEvents, ValidationCodeRequired, "missing required field")
+
+	} else if len(raw.Events) == 0 {
+		verr.add(ValidationLocationBody, pathEvents, ValidationCodeNonEmpty, "must be non-empty")
+
+	} else {
+		valEvents := make([]string, 0, len(raw.Events))
+		numIssues := len(verr.Issues)
+		for idx, rawItem := range raw.Events {
+			itemPath := fmt.Sprintf("%s[%d]", pathEvents, idx)
+
+			item, ok := decodeJSONValue[string](rawItem, itemPath, "must be a string", verr)
+			if !ok {
+				continue
+			}
+			item = strings.TrimSpace(item)
+
+			if len(item) == 0 {
+				verr.add(ValidationLocationBody, itemPath, ValidationCodeNonEmpty, "must be non-empty")
+				continue
+			}
+
+			valEvents = append(valEvents, item)
+		}
+		if len(verr.Issues) == numIssues {
+			t.Events = valEvents
+		}
+	}
+
+	pathNextCursor := joinValidationPath(path, "NextCursor")
+	if raw.NextCursor.absent() {
+
+		// skip, leave as zero value
+
+	} else if valNextCursor, ok := decodeJSONValue[string](raw.NextCursor, pathNextCursor, "must be of type string", verr); ok {
+		valNextCursor = strings.TrimSpace(valNextCursor)
+
+		t.NextCursor = &valNextCursor
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated EventFeed,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *EventFeed) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *EventFeed) validate(path string, verr *ValidationError) {
+
+	if len(t.Events) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Events"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+	for idx, item := range t.Events {
+		itemPath := fmt.Sprintf("%s[%d]", joinValidationPath(path, "Events"), idx)
+
+		if len(strings.TrimSpace(item)) == 0 {
+			verr.add(ValidationLocationBody, itemPath, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+	}
+
+}
+
+type EventPage struct {
+
+	// The IDs of the events of the page.
+	//
+	// Required
+	//
+	Events []string `json:"Events"`
+
+	// The total number of events.
+	//
+	// Required
+	//
+	TotalCount int64 `json:"TotalCount"`
+}
+
+// NewEventPage creates a new instance of EventPage with required fields as parameters
+func NewEventPage(
+
+	Events []string,
+
+	TotalCount int64,
+
+) *EventPage {
+	return &EventPage{
+
+		Events: Events,
+
+		TotalCount: TotalCount,
+	}
+}
+
+// ParseEventPage creates a new instance of EventPage from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseEventPage(data map[string]any) (*EventPage, error) {
+	verr := &ValidationError{}
+	body := parseEventPage(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseEventPage parses data into a new EventPage, recording issues in verr with paths relative to path.
+func parseEventPage(data map[string]any, path string, verr *ValidationError) *EventPage {
+	body := new(EventPage)
+
+	pathEvents := joinValidationPath(path, "Events")
+
+	valEvents, ok := data["Events"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathEvents, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		valEventsSlice, ok := valEvents.([]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathEvents, ValidationCodeInvalidType, "must be an array")
+
+		} else if len(valEventsSlice) == 0 {
+			verr.add(ValidationLocationBody, pathEvents, ValidationCodeNonEmpty, "must be non-empty")
+
+		} else {
+			valEventsTyped := make([]string, 0, len(valEventsSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valEventsSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathEvents, idx)
+
+				itemTyped, ok := item.(string)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be a string")
+					continue
+				}
+				itemTyped = strings.TrimSpace(itemTyped)
+
+				if len(itemTyped) == 0 {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeNonEmpty, "must be non-empty")
+					continue
+				}
+
+				valEventsTyped = append(valEventsTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.Events = valEventsTyped
+			}
+		}
+
+	}
+
+	pathTotalCount := joinValidationPath(path, "TotalCount")
+
+	valTotalCount, ok := data["TotalCount"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		// JSON numbers are float64 by default, so we need to handle that case
+		switch v := valTotalCount.(type) {
+		case float64:
+			valTotalCountTyped := int64(v)
+			body.TotalCount = valTotalCountTyped
+		case int64:
+			body.TotalCount = v
+		default:
+			verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeInvalidType, "must be an integer")
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into EventPage, with the same checks as ParseEventPage.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *EventPage) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonEventPage holds the raw JSON of the fields of a EventPage, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonEventPage struct {
+	Events     []jsonValue `json:"Events"`
+	TotalCount jsonValue   `json:"TotalCount"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *EventPage) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonEventPage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseEventPage instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseEventPage(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *EventPage) decodeRaw(raw *jsonEventPage, path string, verr *ValidationError) {
+
+	pathEvents := joinValidationPath(path, "Events")
+	if raw.Events == nil {
+
+		verr.add(ValidationLocationBody, pathEvents, ValidationCodeRequired, "missing required field")
+
+	} else if len(raw.Events) == 0 {
+		verr.add(ValidationLocationBody, pathEvents, ValidationCodeNonEmpty, "must be non-empty")
+
+	} else {
+		valEvents := make([]string, 0, len(raw.Events))
+		numIssues := len(verr.Issues)
+		for idx, rawItem := range raw.Events {
+			itemPath := fmt.Sprintf("%s[%d]", pathEvents, idx)
+
+			item, ok := decodeJSONValue[string](rawItem, itemPath, "must be a string", verr)
+			if !ok {
+				continue
+			}
+			item = strings.TrimSpace(item)
+
+			if len(item) == 0 {
+				verr.add(ValidationLocationBody, itemPath, ValidationCodeNonEmpty, "must be non-empty")
+				continue
+			}
+
+			valEvents = append(valEvents, item)
+		}
+		if len(verr.Issues) == numIssues {
+			t.Events = valEvents
+		}
+	}
+
+	pathTotalCount := joinValidationPath(path, "TotalCount")
+	if raw.TotalCount.absent() {
+
+		verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeRequired, "missing required field")
+
+	} else if valTotalCount, ok := decodeJSONValue[int64](raw.TotalCount, pathTotalCount, "must be an integer", verr); ok {
+		t.TotalCount = valTotalCount
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated EventPage,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *EventPage) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *EventPage) validate(path string, verr *ValidationError) {
+
+	if len(t.Events) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Events"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+	for idx, item := range t.Events {
+		itemPath := fmt.Sprintf("%s[%d]", joinValidationPath(path, "Events"), idx)
+
+		if len(strings.TrimSpace(item)) == 0 {
+			verr.add(ValidationLocationBody, itemPath, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+	}
+
+}
+
+type FlakyResponseBody struct {
+
+	// The number of requests received for the key, including this one.
+	//
+	// Required
+	//
+	Attempts int64 `json:"Attempts"`
+}
+
+// NewFlakyResponseBody creates a new instance of FlakyResponseBody with required fields as parameters
+func NewFlakyResponseBody(
+
+	Attempts int64,
+
+) *FlakyResponseBody {
+	return &FlakyResponseBody{
+
+		Attempts: Attempts,
+	}
+}
+
+// ParseFlakyResponseBody creates a new instance of FlakyResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseFlakyResponseBody(data map[string]any) (*FlakyResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseFlakyResponseBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseFlakyResponseBody parses data into a new FlakyResponseBody, recording issues in verr with paths relative to path.
+func parseFlakyResponseBody(data map[string]any, path string, verr *ValidationError) *FlakyResponseBody {
+	body := new(FlakyResponseBody)
+
+	pathAttempts := joinValidationPath(path, "Attempts")
+
+	valAttempts, ok := data["Attempts"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathAttempts, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		// JSON numbers are float64 by default, so we need to handle that case
+		switch v := valAttempts.(type) {
+		case float64:
+			valAttemptsTyped := int64(v)
+			body.Attempts = valAttemptsTyped
+		case int64:
+			body.Attempts = v
+		default:
+			verr.add(ValidationLocationBody, pathAttempts, ValidationCodeInvalidType, "must be an integer")
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into FlakyResponseBody, with the same checks as ParseFlakyResponseBody.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *FlakyResponseBody) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonFlakyResponseBody holds the raw JSON of the fields of a FlakyResponseBody, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonFlakyResponseBody struct {
+	Attempts jsonValue `json:"Attempts"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *FlakyResponseBody) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonFlakyResponseBody
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseFlakyResponseBody instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseFlakyResponseBody(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *FlakyResponseBody) decodeRaw(raw *jsonFlakyResponseBody, path string, verr *ValidationError) {
+
+	pathAttempts := joinValidationPath(path, "Attempts")
+	if raw.Attempts.absent() {
+
+		verr.add(ValidationLocationBody, pathAttempts, ValidationCodeRequired, "missing required field")
+
+	} else if valAttempts, ok := decodeJSONValue[int64](raw.Attempts, pathAttempts, "must be an integer", verr); ok {
+		t.Attempts = valAttempts
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated FlakyResponseBody,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *FlakyResponseBody) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *FlakyResponseBody) validate(path string, verr *ValidationError) {
+
+}
+
+type GetSessionResponseBody struct {
+
+	// The name of the authentication method the request was authenticated with.
+	//
+	// Required
+	//
+	// Must be non-empty
+	AuthMethod string `json:"AuthMethod"`
+
+	// The credential the request was authenticated with. For basic authentication, "username:password".
+	//
+	// Required
+	//
+	// Must be non-empty
+	Credential string `json:"Credential"`
+}
+
+// NewGetSessionResponseBody creates a new instance of GetSessionResponseBody with required fields as parameters
+func NewGetSessionResponseBody(
+
+	AuthMethod string,
+
+	Credential string,
+
+) *GetSessionResponseBody {
+	return &GetSessionResponseBody{
+
+		AuthMethod: AuthMethod,
+
+		Credential: Credential,
+	}
+}
+
+// ParseGetSessionResponseBody creates a new instance of GetSessionResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseGetSessionResponseBody(data map[string]any) (*GetSessionResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseGetSessionResponseBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseGetSessionResponseBody parses data into a new GetSessionResponseBody, recording issues in verr with paths relative to path.
+func parseGetSessionResponseBody(data map[string]any, path string, verr *ValidationError) *GetSessionResponseBody {
+	body := new(GetSessionResponseBody)
+
+	pathAuthMethod := joinValidationPath(path, "AuthMethod")
+
+	valAuthMethod, ok := data["AuthMethod"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathAuthMethod, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valAuthMethodTyped, ok := valAuthMethod.(string); !ok {
+			verr.add(ValidationLocationBody, pathAuthMethod, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valAuthMethodTyped = strings.TrimSpace(valAuthMethodTyped)
+
+			if len(valAuthMethodTyped) == 0 {
+				verr.add(ValidationLocationBody, pathAuthMethod, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.AuthMethod = valAuthMethodTyped
+		}
+
+	}
+
+	pathCredential := joinValidationPath(path, "Credential")
+
+	valCredential, ok := data["Credential"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathCredential, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valCredentialTyped, ok := valCredential.(string); !ok {
+			verr.add(ValidationLocationBody, pathCredential, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valCredentialTyped = strings.TrimSpace(valCredentialTyped)
+
+			if len(valCredentialTyped) == 0 {
+				verr.add(ValidationLocationBody, pathCredential, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Credential = valCredentialTyped
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into GetSessionResponseBody, with the same checks as ParseGetSessionResponseBody.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *GetSessionResponseBody) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonGetSessionResponseBody holds the raw JSON of the fields of a GetSessionResponseBody, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonGetSessionResponseBody struct {
+	AuthMethod jsonValue `json:"AuthMethod"`
+	Credential jsonValue `json:"Credential"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *GetSessionResponseBody) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonGetSessionResponseBody
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseGetSessionResponseBody instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseGetSessionResponseBody(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *GetSessionResponseBody) decodeRaw(raw *jsonGetSessionResponseBody, path string, verr *ValidationError) {
+
+	pathAuthMethod := joinValidationPath(path, "AuthMethod")
+	if raw.AuthMethod.absent() {
+
+		verr.add(ValidationLocationBody, pathAuthMethod, ValidationCodeRequired, "missing required field")
+
+	} else if valAuthMethod, ok := decodeJSONValue[string](raw.AuthMethod, pathAuthMethod, "must be of type string", verr); ok {
+		valAuthMethod = strings.TrimSpace(valAuthMethod)
+
+		if len(valAuthMethod) == 0 {
+			verr.add(ValidationLocationBody, pathAuthMethod, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.AuthMethod = valAuthMethod
+	}
+
+	pathCredential := joinValidationPath(path, "Credential")
+	if raw.Credential.absent() {
+
+		verr.add(ValidationLocationBody, pathCredential, ValidationCodeRequired, "missing required field")
+
+	} else if valCredential, ok := decodeJSONValue[string](raw.Credential, pathCredential, "must be of type string", verr); ok {
+		valCredential = strings.TrimSpace(valCredential)
+
+		if len(valCredential) == 0 {
+			verr.add(ValidationLocationBody, pathCredential, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.Credential = valCredential
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated GetSessionResponseBody,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *GetSessionResponseBody) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *GetSessionResponseBody) validate(path string, verr *ValidationError) {
+
+	if len(strings.TrimSpace(t.AuthMethod)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "AuthMethod"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+	if len(strings.TrimSpace(t.Credential)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Credential"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+}
+
+type HealthCheckResponseBody struct {
+
+	// The health status of the API, typically "OK".
+	//
+	// Required
+	//
+	// Must be non-empty
+	Status string `json:"Status"`
+}
+
+// NewHealthCheckResponseBody creates a new instance of HealthCheckResponseBody with required fields as parameters
+func NewHealthCheckResponseBody(
+
+	Status string,
+
+) *HealthCheckResponseBody {
+	return &HealthCheckResponseBody{
+
+		Status: Status,
+	}
+}
+
+// ParseHealthCheckResponseBody creates a new instance of HealthCheckResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseHealthCheckResponseBody(data map[string]any) (*HealthCheckResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseHealthCheckResponseBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseHealthCheckResponseBody parses data into a new HealthCheckResponseBody, recording issues in verr with paths relative to path.
+func parseHealthCheckResponseBody(data map[string]any, path string, verr *ValidationError) *HealthCheckResponseBody {
+	body := new(HealthCheckResponseBody)
+
+	pathStatus := joinValidationPath(path, "Status")
+
+	valStatus, ok := data["Status"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathStatus, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valStatusTyped, ok := valStatus.(string); !ok {
+			verr.add(ValidationLocationBody, pathStatus, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valStatusTyped = strings.TrimSpace(valStatusTyped)
+
+			if len(valStatusTyped) == 0 {
+				verr.add(ValidationLocationBody, pathStatus, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Status = valStatusTyped
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into HealthCheckResponseBody, with the same checks as ParseHealthCheckResponseBody.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *HealthCheckResponseBody) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonHealthCheckResponseBody holds the raw JSON of the fields of a HealthCheckResponseBody, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonHealthCheckResponseBody struct {
+	Status jsonValue `json:"Status"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *HealthCheckResponseBody) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonHealthCheckResponseBody
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseHealthCheckResponseBody instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseHealthCheckResponseBody(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *HealthCheckResponseBody) decodeRaw(raw *jsonHealthCheckResponseBody, path string, verr *ValidationError) {
+
+	pathStatus := joinValidationPath(path, "Status")
+	if raw.Status.absent() {
+
+		verr.add(ValidationLocationBody, pathStatus, ValidationCodeRequired, "missing required field")
+
+	} else if valStatus, ok := decodeJSONValue[string](raw.Status, pathStatus, "must be of type string", verr); ok {
+		valStatus = strings.TrimSpace(valStatus)
+
+		if len(valStatus) == 0 {
+			verr.add(ValidationLocationBody, pathStatus, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.Status = valStatus
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated HealthCheckResponseBody,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *HealthCheckResponseBody) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *HealthCheckResponseBody) validate(path string, verr *ValidationError) {
+
+	if len(strings.TrimSpace(t.Status)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Status"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+}
+
+type ListUsersResponseBody struct {
+
+	// The current page number.
+	//
+	// Required
+	//
+	PageNumber int64 `json:"PageNumber"`
+
+	// The number of items per page.
+	//
+	// Required
+	//
+	PageSize int64 `json:"PageSize"`
+
+	// The total number of users available.
+	//
+	// Required
+	//
+	TotalCount int64 `json:"TotalCount"`
+
+	// Required
+	//
+	Users []User `json:"Users"`
+}
+
+// NewListUsersResponseBody creates a new instance of ListUsersResponseBody with required fields as parameters
+func NewListUsersResponseBody(
+
+	PageNumber int64,
+
+	PageSize int64,
+
+	TotalCount int64,
+
+	Users []User,
+
+) *ListUsersResponseBody {
+	return &ListUsersResponseBody{
+
+		PageNumber: PageNumber,
+
+		PageSize: PageSize,
+
+		TotalCount: TotalCount,
+
+		Users: Users,
+	}
+}
+
+// ParseListUsersResponseBody creates a new instance of ListUsersResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseListUsersResponseBody(data map[string]any) (*ListUsersResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseListUsersResponseBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseListUsersResponseBody parses data into a new ListUsersResponseBody, recording issues in verr with paths relative to path.
+func parseListUsersResponseBody(data map[string]any, path string, verr *ValidationError) *ListUsersResponseBody {
+	body := new(ListUsersResponseBody)
+
+	pathPageNumber := joinValidationPath(path, "PageNumber")
+
+	valPageNumber, ok := data["PageNumber"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathPageNumber, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		// JSON numbers are float64 by default, so we need to handle that case
+		switch v := valPageNumber.(type) {
+		case float64:
+			valPageNumberTyped := int64(v)
+			body.PageNumber = valPageNumberTyped
+		case int64:
+			body.PageNumber = v
+		default:
+			verr.add(ValidationLocationBody, pathPageNumber, ValidationCodeInvalidType, "must be an integer")
+		}
+
+	}
+
+	pathPageSize := joinValidationPath(path, "PageSize")
+
+	valPageSize, ok := data["PageSize"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathPageSize, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		// JSON numbers are float64 by default, so we need to handle that case
+		switch v := valPageSize.(type) {
+		case float64:
+			valPageSizeTyped := int64(v)
+			body.PageSize = valPageSizeTyped
+		case int64:
+			body.PageSize = v
+		default:
+			verr.add(ValidationLocationBody, pathPageSize, ValidationCodeInvalidType, "must be an integer")
+		}
+
+	}
+
+	pathTotalCount := joinValidationPath(path, "TotalCount")
+
+	valTotalCount, ok := data["TotalCount"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		// JSON numbers are float64 by default, so we need to handle that case
+		switch v := valTotalCount.(type) {
+		case float64:
+			valTotalCountTyped := int64(v)
+			body.TotalCount = valTotalCountTyped
+		case int64:
+			body.TotalCount = v
+		default:
+			verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeInvalidType, "must be an integer")
+		}
+
+	}
+
+	pathUsers := joinValidationPath(path, "Users")
+
+	valUsers, ok := data["Users"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathUsers, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		valUsersSlice, ok := valUsers.([]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathUsers, ValidationCodeInvalidType, "must be an array")
+
+		} else if len(valUsersSlice) == 0 {
+			verr.add(ValidationLocationBody, pathUsers, ValidationCodeNonEmpty, "must be non-empty")
+
+		} else {
+			valUsersTyped := make([]User, 0, len(valUsersSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valUsersSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathUsers, idx)
+
+				itemMap, ok := item.(map[string]any)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be an object")
+					continue
+				}
+				itemTyped := *parseUser(itemMap, itemPath, verr)
+
+				valUsersTyped = append(valUsersTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.Users = valUsersTyped
+			}
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into ListUsersResponseBody, with the same checks as ParseListUsersResponseBody.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *ListUsersResponseBody) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonListUsersResponseBody holds the raw JSON of the fields of a ListUsersResponseBody, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonListUsersResponseBody struct {
+	PageNumber jsonValue  `json:"PageNumber"`
+	PageSize   jsonValue  `json:"PageSize"`
+	TotalCount jsonValue  `json:"TotalCount"`
+	Users      []jsonUser `json:"Users"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *ListUsersResponseBody) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonListUsersResponseBody
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseListUsersResponseBody instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseListUsersResponseBody(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *ListUsersResponseBody) decodeRaw(raw *jsonListUsersResponseBody, path string, verr *ValidationError) {
+
+	pathPageNumber := joinValidationPath(path, "PageNumber")
+	if raw.PageNumber.absent() {
+
+		verr.add(ValidationLocationBody, pathPageNumber, ValidationCodeRequired, "missing required field")
+
+	} else if valPageNumber, ok := decodeJSONValue[int64](raw.PageNumber, pathPageNumber, "must be an integer", verr); ok {
+		t.PageNumber = valPageNumber
+	}
+
+	pathPageSize := joinValidationPath(path, "PageSize")
+	if raw.PageSize.absent() {
+
+		verr.add(ValidationLocationBody, pathPageSize, ValidationCodeRequired, "missing required field")
+
+	} else if valPageSize, ok := decodeJSONValue[int64](raw.PageSize, pathPageSize, "must be an integer", verr); ok {
+		t.PageSize = valPageSize
+	}
+
+	pathTotalCount := joinValidationPath(path, "TotalCount")
+	if raw.TotalCount.absent() {
+
+		verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeRequired, "missing required field")
+
+	} else if valTotalCount, ok := decodeJSONValue[int64](raw.TotalCount, pathTotalCount, "must be an integer", verr); ok {
+		t.TotalCount = valTotalCount
+	}
+
+	pathUsers := joinValidationPath(path, "Users")
+	if raw.Users == nil {
+
+		verr.add(ValidationLocationBody, pathUsers, ValidationCodeRequired, "missing required field")
+
+	} else if len(raw.Users) == 0 {
+		verr.add(ValidationLocationBody, pathUsers, ValidationCodeNonEmpty, "must be non-empty")
+
+	} else {
+		valUsers := make([]User, 0, len(raw.Users))
+		numIssues := len(verr.Issues)
+		for idx, rawItem := range raw.Users {
+			itemPath := fmt.Sprintf("%s[%d]", pathUsers, idx)
+
+			var item User
+			item.decodeRaw(&rawItem, itemPath, verr)
+
+			valUsers = append(valUsers, item)
+		}
+		if len(verr.Issues) == numIssues {
+			t.Users = valUsers
+		}
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated ListUsersResponseBody,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *ListUsersResponseBody) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *ListUsersResponseBody) validate(path string, verr *ValidationError) {
+
+	if len(t.Users) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Users"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+	for idx, item := range t.Users {
+		itemPath := fmt.Sprintf("%s[%d]", joinValidationPath(path, "Users"), idx)
+
+		item.validate(itemPath, verr)
+
+	}
+
+}
+
+type LogoutUserResponseBody struct {
+
+	// A message confirming successful logout.
+	//
+	// Required
+	//
+	// Must be non-empty
+	Message string `json:"Message"`
+}
+
+// NewLogoutUserResponseBody creates a new instance of LogoutUserResponseBody with required fields as parameters
+func NewLogoutUserResponseBody(
+
+	Message string,
+
+) *LogoutUserResponseBody {
+	return &LogoutUserResponseBody{
+
+		Message: Message,
+	}
+}
+
+// ParseLogoutUserResponseBody creates a new instance of LogoutUserResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseLogoutUserResponseBody(data map[string]any) (*LogoutUserResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseLogoutUserResponseBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseLogoutUserResponseBody parses data into a new LogoutUserResponseBody, recording issues in verr with paths relative to path.
+func parseLogoutUserResponseBody(data map[string]any, path string, verr *ValidationError) *LogoutUserResponseBody {
+	body := new(LogoutUserResponseBody)
+
+	pathMessage := joinValidationPath(path, "Message")
+
+	valMessage, ok := data["Message"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathMessage, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valMessageTyped, ok := valMessage.(string); !ok {
+			verr.add(ValidationLocationBody, pathMessage, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valMessageTyped = strings.TrimSpace(valMessageTyped)
+
+			if len(valMessageTyped) == 0 {
+				verr.add(ValidationLocationBody, pathMessage, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Message = valMessageTyped
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into LogoutUserResponseBody, with the same checks as ParseLogoutUserResponseBody.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *LogoutUserResponseBody) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonLogoutUserResponseBody holds the raw JSON of the fields of a LogoutUserResponseBody, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonLogoutUserResponseBody struct {
+	Message jsonValue `json:"Message"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *LogoutUserResponseBody) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonLogoutUserResponseBody
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseLogoutUserResponseBody instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseLogoutUserResponseBody(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *LogoutUserResponseBody) decodeRaw(raw *jsonLogoutUserResponseBody, path string, verr *ValidationError) {
+
+	pathMessage := joinValidationPath(path, "Message")
+	if raw.Message.absent() {
+
+		verr.add(ValidationLocationBody, pathMessage, ValidationCodeRequired, "missing required field")
+
+	} else if valMessage, ok := decodeJSONValue[string](raw.Message, pathMessage, "must be of type string", verr); ok {
+		valMessage = strings.TrimSpace(valMessage)
+
+		if len(valMessage) == 0 {
+			verr.add(ValidationLocationBody, pathMessage, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.Message = valMessage
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated LogoutUserResponseBody,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *LogoutUserResponseBody) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *LogoutUserResponseBody) validate(path string, verr *ValidationError) {
+
+	if len(strings.TrimSpace(t.Message)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Message"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+}
+
+// A notification sent to a user, by email or SMS.
+//
+// Notification is one of *EmailNotification, *SmsNotification,
+// told apart in JSON by their Channel property.
+type Notification interface {
+	isNotification()
+}
+
+func (*EmailNotification) isNotification() {}
+
+// MarshalJSON encodes v with its Channel, "email", so that it can be decoded as a Notification.
+func (v EmailNotification) MarshalJSON() ([]byte, error) {
+	// fields has the fields of EmailNotification, without its MarshalJSON method
+	type fields EmailNotification
+	return marshalWithDiscriminator(fields(v), "Channel", "email")
+}
+
+func (*SmsNotification) isNotification() {}
+
+// MarshalJSON encodes v with its Channel, "sms", so that it can be decoded as a Notification.
+func (v SmsNotification) MarshalJSON() ([]byte, error) {
+	// fields has the fields of SmsNotification, without its MarshalJSON method
+	type fields SmsNotification
+	return marshalWithDiscriminator(fields(v), "Channel", "sms")
+}
+
+// ParseNotification creates a new Notification from decoded JSON data, as the variant named by its Channel,
+// validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseNotification(data map[string]any) (Notification, error) {
+	verr := &ValidationError{}
+	value := parseNotification(data, "", verr)
+	return value, verr.errOrNil()
+}
+
+// parseNotification parses data into the variant of Notification named by its Channel, recording issues in verr
+// with paths relative to path. It returns nil if the Channel is missing or unknown.
+func parseNotification(data map[string]any, path string, verr *ValidationError) Notification {
+	pathDiscriminator := joinValidationPath(path, "Channel")
+	rawDiscriminator, ok := data["Channel"]
+	if !ok {
+		verr.add(ValidationLocationBody, pathDiscriminator, ValidationCodeRequired, "missing required field")
+		return nil
+	}
+	discriminator, ok := rawDiscriminator.(string)
+	if !ok {
+		verr.add(ValidationLocationBody, pathDiscriminator, ValidationCodeInvalidType, "must be a string")
+		return nil
+	}
+	switch discriminator {
+	case "email":
+		return parseEmailNotification(data, path, verr)
+	case "sms":
+		return parseSmsNotification(data, path, verr)
+	default:
+		verr.add(ValidationLocationBody, pathDiscriminator, ValidationCodeInvalidValue, "must be one of email, sms")
+		return nil
+	}
+}
+
+// decodeNotification decodes data into the variant of Notification named by its Channel, with the same checks
+// as ParseNotification, recording issues in verr with paths relative to path. It returns nil if data is not an object,
+// or its Channel is missing or unknown.
+func decodeNotification(data []byte, path string, verr *ValidationError) Notification {
+	var raw struct {
+		Channel jsonValue `json:"Channel"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		}
+		return nil
+	}
+	pathDiscriminator := joinValidationPath(path, "Channel")
+	if raw.Channel.absent() {
+		verr.add(ValidationLocationBody, pathDiscriminator, ValidationCodeRequired, "missing required field")
+		return nil
+	}
+	discriminator, ok := decodeJSONValue[string](raw.Channel, pathDiscriminator, "must be a string", verr)
+	if !ok {
+		return nil
+	}
+	switch discriminator {
+	case "email":
+		value := new(EmailNotification)
+		value.decodeJSON(data, path, verr)
+		return value
+	case "sms":
+		value := new(SmsNotification)
+		value.decodeJSON(data, path, verr)
+		return value
+	default:
+		verr.add(ValidationLocationBody, pathDiscriminator, ValidationCodeInvalidValue, "must be one of email, sms")
+		return nil
+	}
+}
+
+// ValidateNotification checks the required and non-empty constraints of value, an already-populated variant of Notification,
+// e.g. one built by a handler, as the Validate method of the variant does.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ValidateNotification(value Notification) error {
+	verr := &ValidationError{}
+	validateNotification(value, "", verr)
+	return verr.errOrNil()
+}
+
+// validateNotification records the issues of value, a variant of Notification, in verr with paths relative to path.
+func validateNotification(value Notification, path string, verr *ValidationError) {
+	switch value := value.(type) {
+	case *EmailNotification:
+		value.validate(path, verr)
+	case *SmsNotification:
+		value.validate(path, verr)
+	case nil:
+		verr.add(ValidationLocationBody, path, ValidationCodeRequired, "missing required field")
+	}
+}
+
+type ReceiveWebhookRequestBody struct {
+
+	// The name of the delivered event.
+	//
+	// Required
+	//
+	// Must be non-empty
+	Event string `json:"Event"`
+}
+
+// NewReceiveWebhookRequestBody creates a new instance of ReceiveWebhookRequestBody with required fields as parameters
+func NewReceiveWebhookRequestBody(
+
+	Event string,
+
+) *ReceiveWebhookRequestBody {
+	return &ReceiveWebhookRequestBody{
+
+		Event: Event,
+	}
+}
+
+// ParseReceiveWebhookRequestBody creates a new instance of ReceiveWebhookRequestBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseReceiveWebhookRequestBody(data map[string]any) (*ReceiveWebhookRequestBody, error) {
+	verr := &ValidationError{}
+	body := parseReceiveWebhookRequestBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseReceiveWebhookRequestBody parses data into a new ReceiveWebhookRequestBody, recording issues in verr with paths relative to path.
+func parseReceiveWebhookRequestBody(data map[string]any, path string, verr *ValidationError) *ReceiveWebhookRequestBody {
+	body := new(ReceiveWebhookRequestBody)
+
+	pathEvent := joinValidationPath(path, "Event")
+
+	valEvent, ok := data["Event"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathEvent, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valEventTyped, ok := valEvent.(string); !ok {
+			verr.add(ValidationLocationBody, pathEvent, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valEventTyped = strings.TrimSpace(valEventTyped)
+
+			if len(valEventTyped) == 0 {
+				verr.add(ValidationLocationBody, pathEvent, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Event = valEventTyped
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into ReceiveWebhookRequestBody, with the same checks as ParseReceiveWebhookRequestBody.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *ReceiveWebhookRequestBody) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonReceiveWebhookRequestBody holds the raw JSON of the fields of a ReceiveWebhookRequestBody, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonReceiveWebhookRequestBody struct {
+	Event jsonValue `json:"Event"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *ReceiveWebhookRequestBody) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonReceiveWebhookRequestBody
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseReceiveWebhookRequestBody instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseReceiveWebhookRequestBody(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *ReceiveWebhookRequestBody) decodeRaw(raw *jsonReceiveWebhookRequestBody, path string, verr *ValidationError) {
+
+	pathEvent := joinValidationPath(path, "Event")
+	if raw.Event.absent() {
+
+		verr.add(ValidationLocationBody, pathEvent, ValidationCodeRequired, "missing required field")
+
+	} else if valEvent, ok := decodeJSONValue[string](raw.Event, pathEvent, "must be of type string", verr); ok {
+		valEvent = strings.TrimSpace(valEvent)
+
+		if len(valEvent) == 0 {
+			verr.add(ValidationLocationBody, pathEvent, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.Event = valEvent
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated ReceiveWebhookRequestBody,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *ReceiveWebhookRequestBody) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *ReceiveWebhookRequestBody) validate(path string, verr *ValidationError) {
+
+	if len(strings.TrimSpace(t.Event)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Event"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+}
+
+type ReceiveWebhookResponseBody struct {
+
+	// The name of the received event.
+	//
+	// Required
+	//
+	// Must be non-empty
+	Event string `json:"Event"`
+
+	// The ID of the key the delivery was signed with.
+	//
+	// Required
+	//
+	// Must be non-empty
+	KeyId string `json:"KeyId"`
+}
+
+// NewReceiveWebhookResponseBody creates a new instance of ReceiveWebhookResponseBody with required fields as parameters
+func NewReceiveWebhookResponseBody(
+
+	Event string,
+
+	KeyId string,
+
+) *ReceiveWebhookResponseBody {
+	return &ReceiveWebhookResponseBody{
+
+		Event: Event,
+
+		KeyId: KeyId,
+	}
+}
+
+// ParseReceiveWebhookResponseBody creates a new instance of ReceiveWebhookResponseBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseReceiveWebhookResponseBody(data map[string]any) (*ReceiveWebhookResponseBody, error) {
+	verr := &ValidationError{}
+	body := parseReceiveWebhookResponseBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseReceiveWebhookResponseBody parses data into a new ReceiveWebhookResponseBody, recording issues in verr with paths relative to path.
+func parseReceiveWebhookResponseBody(data map[string]any, path string, verr *ValidationError) *ReceiveWebhookResponseBody {
+	body := new(ReceiveWebhookResponseBody)
+
+	pathEvent := joinValidationPath(path, "Event")
+
+	valEvent, ok := data["Event"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathEvent, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valEventTyped, ok := valEvent.(string); !ok {
+			verr.add(ValidationLocationBody, pathEvent, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valEventTyped = strings.TrimSpace(valEventTyped)
+
+			if len(valEventTyped) == 0 {
+				verr.add(ValidationLocationBody, pathEvent, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Event = valEventTyped
+		}
+
+	}
+
+	pathKeyId := joinValidationPath(path, "KeyId")
+
+	valKeyId, ok := data["KeyId"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathKeyId, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valKeyIdTyped, ok := valKeyId.(string); !ok {
+			verr.add(ValidationLocationBody, pathKeyId, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valKeyIdTyped = strings.TrimSpace(valKeyIdTyped)
+
+			if len(valKeyIdTyped) == 0 {
+				verr.add(ValidationLocationBody, pathKeyId, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.KeyId = valKeyIdTyped
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into ReceiveWebhookResponseBody, with the same checks as ParseReceiveWebhookResponseBody.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *ReceiveWebhookResponseBody) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonReceiveWebhookResponseBody holds the raw JSON of the fields of a ReceiveWebhookResponseBody, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonReceiveWebhookResponseBody struct {
+	Event jsonValue `json:"Event"`
+	KeyId jsonValue `json:"KeyId"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *ReceiveWebhookResponseBody) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonReceiveWebhookResponseBody
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseReceiveWebhookResponseBody instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseReceiveWebhookResponseBody(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *ReceiveWebhookResponseBody) decodeRaw(raw *jsonReceiveWebhookResponseBody, path string, verr *ValidationError) {
+
+	pathEvent := joinValidationPath(path, "Event")
+	if raw.Event.absent() {
+
+		verr.add(ValidationLocationBody, pathEvent, ValidationCodeRequired, "missing required field")
+
+	} else if valEvent, ok := decodeJSONValue[string](raw.Event, pathEvent, "must be of type string", verr); ok {
+		valEvent = strings.TrimSpace(valEvent)
+
+		if len(valEvent) == 0 {
+			verr.add(ValidationLocationBody, pathEvent, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.Event = valEvent
+	}
+
+	pathKeyId := joinValidationPath(path, "KeyId")
+	if raw.KeyId.absent() {
+
+		verr.add(ValidationLocationBody, pathKeyId, ValidationCodeRequired, "missing required field")
+
+	} else if valKeyId, ok := decodeJSONValue[string](raw.KeyId, pathKeyId, "must be of type string", verr); ok {
+		valKeyId = strings.TrimSpace(valKeyId)
+
+		if len(valKeyId) == 0 {
+			verr.add(ValidationLocationBody, pathKeyId, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.KeyId = valKeyId
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated ReceiveWebhookResponseBody,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *ReceiveWebhookResponseBody) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *ReceiveWebhookResponseBody) validate(path string, verr *ValidationError) {
+
+	if len(strings.TrimSpace(t.Event)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Event"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+	if len(strings.TrimSpace(t.KeyId)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "KeyId"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+}
+
+type SmsNotification struct {
+
+	// The phone number the notification is sent to.
+	//
+	// Required
+	//
+	// Must be non-empty
+	PhoneNumber string `json:"PhoneNumber"`
+}
+
+// NewSmsNotification creates a new instance of SmsNotification with required fields as parameters
+func NewSmsNotification(
 
-		User: User,
+	PhoneNumber string,
+
+) *SmsNotification {
+	return &SmsNotification{
+
+		PhoneNumber: PhoneNumber,
 	}
 }
 
-// WithArbitraryData sets the optional field ArbitraryData and returns the modified CreateUserResponseBody instance
-func (o *CreateUserResponseBody) WithArbitraryData(value map[string]any) *CreateUserResponseBody {
-	o.ArbitraryData = &value
-	return o
+// ParseSmsNotification creates a new instance of SmsNotification from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseSmsNotification(data map[string]any) (*SmsNotification, error) {
+	verr := &ValidationError{}
+	body := parseSmsNotification(data, "", verr)
+	return body, verr.errOrNil()
 }
 
-// WithOptionalStatus sets the optional field OptionalStatus and returns the modified CreateUserResponseBody instance
-func (o *CreateUserResponseBody) WithOptionalStatus(value UserStatus) *CreateUserResponseBody {
-	o.OptionalStatus = &value
-	return o
-}
+// parseSmsNotification parses data into a new SmsNotification, recording issues in verr with paths relative to path.
+func parseSmsNotification(data map[string]any, path string, verr *ValidationError) *SmsNotification {
+	body := new(SmsNotification)
 
-func ParseCreateUserResponseBody(data map[string]any) (*CreateUserResponseBody, error) {
-	body := new(CreateUserResponseBody)
+	pathPhoneNumber := joinValidationPath(path, "PhoneNumber")
 
-	valArbitraryData, ok := data["ArbitraryData"]
+	valPhoneNumber, ok := data["PhoneNumber"]
 	if !ok {
 
-		// skip, leave as zero value
+		verr.add(ValidationLocationBody, pathPhoneNumber, ValidationCodeRequired, "missing required field")
 
 	} else {
-		valArbitraryDataTyped, ok := valArbitraryData.(map[string]any)
-		if !ok {
-			return body, fmt.Errorf("field 'ArbitraryData' has incorrect type")
-		}
 
-		body.ArbitraryData = &valArbitraryDataTyped
-	}
-
-	valOptionalStatus, ok := data["OptionalStatus"]
-	if !ok {
+		if valPhoneNumberTyped, ok := valPhoneNumber.(string); !ok {
+			verr.add(ValidationLocationBody, pathPhoneNumber, ValidationCodeInvalidType, "must be of type string")
+		} else {
 
-		// skip, leave as zero value
+			valPhoneNumberTyped = strings.TrimSpace(valPhoneNumberTyped)
 
-	} else {
+			if len(valPhoneNumberTyped) == 0 {
+				verr.add(ValidationLocationBody, pathPhoneNumber, ValidationCodeNonEmpty, "must be non-empty")
+			}
 
-		valOptionalStatusStr, ok := valOptionalStatus.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'OptionalStatus' has incorrect type")
-		}
-		valOptionalStatusTyped, err := ParseUserStatus(valOptionalStatusStr)
-		if err != nil {
-			return body, fmt.Errorf("field 'OptionalStatus' is invalid: %w", err)
+			body.PhoneNumber = valPhoneNumberTyped
 		}
 
-		body.OptionalStatus = valOptionalStatusTyped
-
 	}
 
-	valStatus, ok := data["Status"]
-	if !ok {
+	return body
+}
 
-		return body, fmt.Errorf("missing required field 'Status'")
+// UnmarshalJSON decodes a JSON object into SmsNotification, with the same checks as ParseSmsNotification.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *SmsNotification) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
 
-	} else {
+// jsonSmsNotification holds the raw JSON of the fields of a SmsNotification, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonSmsNotification struct {
+	PhoneNumber jsonValue `json:"PhoneNumber"`
+}
 
-		valStatusStr, ok := valStatus.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'Status' has incorrect type")
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *SmsNotification) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonSmsNotification
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
 		}
-		valStatusTyped, err := ParseUserStatus(valStatusStr)
-		if err != nil {
-			return body, fmt.Errorf("field 'Status' is invalid: %w", err)
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseSmsNotification instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseSmsNotification(fields, path, verr)
 		}
-
-		body.Status = *valStatusTyped
-
+		return
 	}
+	t.decodeRaw(&raw, path, verr)
+}
 
-	valUser, ok := data["User"]
-	if !ok {
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *SmsNotification) decodeRaw(raw *jsonSmsNotification, path string, verr *ValidationError) {
 
-		return body, fmt.Errorf("missing required field 'User'")
+	pathPhoneNumber := joinValidationPath(path, "PhoneNumber")
+	if raw.PhoneNumber.absent() {
 
-	} else {
+		verr.add(ValidationLocationBody, pathPhoneNumber, ValidationCodeRequired, "missing required field")
 
-		valUserMap, ok := valUser.(map[string]any)
-		if !ok {
-			return body, fmt.Errorf("field 'User' has incorrect type")
-		}
-		valUserTyped, err := ParseUser(valUserMap)
-		if err != nil {
-			return body, fmt.Errorf("field 'User' is invalid: %w", err)
+	} else if valPhoneNumber, ok := decodeJSONValue[string](raw.PhoneNumber, pathPhoneNumber, "must be of type string", verr); ok {
+		valPhoneNumber = strings.TrimSpace(valPhoneNumber)
+
+		if len(valPhoneNumber) == 0 {
+			verr.add(ValidationLocationBody, pathPhoneNumber, ValidationCodeNonEmpty, "must be non-empty")
 		}
 
-		body.User = valUserTyped
+		t.PhoneNumber = valPhoneNumber
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated SmsNotification,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *SmsNotification) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *SmsNotification) validate(path string, verr *ValidationError) {
 
+	if len(strings.TrimSpace(t.PhoneNumber)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "PhoneNumber"), ValidationCodeNonEmpty, "must be non-empty")
 	}
 
-	return body, nil
 }
 
-type ErrorResponse struct {
+type User struct {
+	AuditedEntity
 
-	// A detailed debug message for developers. Only passed if in debug mode.
+	// The postal address of the user, if known. Just for testing inline object support in the generator.
 	//
 	// Optional
 	//
-	DebugMessage *string `json:"DebugMessage,omitempty"`
+	Address *UserAddress `json:"Address,omitempty"`
 
-	// An error message which is user-friendly.
+	// The age of the user.
+	//
+	// Optional
+	//
+	Age *int64 `json:"Age,omitempty"`
+
+	// The email address of the user.
 	//
 	// Required
 	//
 	// Must be non-empty
-	ErrorMessage string `json:"ErrorMessage"`
+	Email string `json:"Email"`
+
+	// Indicates whether the user is active.
+	//
+	// Required
+	//
+	IsActive bool `json:"IsActive"`
+
+	// The unique identifier of the user.
+	//
+	// Required
+	//
+	// Must be non-empty
+	UserId string `json:"UserId"`
+
+	// The name of the user.
+	//
+	// Required
+	//
+	// Must be non-empty
+	UserName string `json:"UserName"`
 }
 
-// NewErrorResponse creates a new instance of ErrorResponse with required fields as parameters
-func NewErrorResponse(
+// NewUser creates a new instance of User with required fields as parameters
+func NewUser(
 
-	ErrorMessage string,
+	CreatedAt string,
 
-) *ErrorResponse {
-	return &ErrorResponse{
+	Email string,
 
-		ErrorMessage: ErrorMessage,
+	IsActive bool,
+
+	UserId string,
+
+	UserName string,
+
+) *User {
+	return &User{
+		AuditedEntity: *NewAuditedEntity(CreatedAt),
+
+		Email: Email,
+
+		IsActive: IsActive,
+
+		UserId: UserId,
+
+		UserName: UserName,
 	}
 }
 
-// WithDebugMessage sets the optional field DebugMessage and returns the modified ErrorResponse instance
-func (o *ErrorResponse) WithDebugMessage(value string) *ErrorResponse {
-	o.DebugMessage = &value
+// WithAddress sets the optional field Address and returns the modified User instance
+func (o *User) WithAddress(value UserAddress) *User {
+	o.Address = &value
 	return o
 }
 
-func ParseErrorResponse(data map[string]any) (*ErrorResponse, error) {
-	body := new(ErrorResponse)
+// WithAge sets the optional field Age and returns the modified User instance
+func (o *User) WithAge(value int64) *User {
+	o.Age = &value
+	return o
+}
 
-	valDebugMessage, ok := data["DebugMessage"]
+// WithUpdatedBy sets the optional field UpdatedBy and returns the modified User instance
+func (o *User) WithUpdatedBy(value string) *User {
+	o.UpdatedBy = &value
+	return o
+}
+
+// ParseUser creates a new instance of User from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseUser(data map[string]any) (*User, error) {
+	verr := &ValidationError{}
+	body := parseUser(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseUser parses data into a new User, recording issues in verr with paths relative to path.
+func parseUser(data map[string]any, path string, verr *ValidationError) *User {
+	body := new(User)
+	body.AuditedEntity = *parseAuditedEntity(data, path, verr)
+
+	pathAddress := joinValidationPath(path, "Address")
+
+	valAddress, ok := data["Address"]
 	if !ok {
 
 		// skip, leave as zero value
 
 	} else {
 
-		valDebugMessageTyped, ok := valDebugMessage.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'DebugMessage' has incorrect type")
+		if valAddressMap, ok := valAddress.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, pathAddress, ValidationCodeInvalidType, "must be an object")
+		} else {
+			body.Address = parseUserAddress(valAddressMap, pathAddress, verr)
 		}
 
-		valDebugMessageTyped = strings.TrimSpace(valDebugMessageTyped)
+	}
+
+	pathAge := joinValidationPath(path, "Age")
+
+	valAge, ok := data["Age"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
 
-		body.DebugMessage = &valDebugMessageTyped
+		// JSON numbers are float64 by default, so we need to handle that case
+		switch v := valAge.(type) {
+		case float64:
+			valAgeTyped := int64(v)
+			body.Age = &valAgeTyped
+		case int64:
+			body.Age = &v
+		default:
+			verr.add(ValidationLocationBody, pathAge, ValidationCodeInvalidType, "must be an integer")
+		}
 
 	}
 
-	valErrorMessage, ok := data["ErrorMessage"]
+	pathEmail := joinValidationPath(path, "Email")
+
+	valEmail, ok := data["Email"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'ErrorMessage'")
+		verr.add(ValidationLocationBody, pathEmail, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valErrorMessageTyped, ok := valErrorMessage.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'ErrorMessage' has incorrect type")
+		if valEmailTyped, ok := valEmail.(string); !ok {
+			verr.add(ValidationLocationBody, pathEmail, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valEmailTyped = strings.TrimSpace(valEmailTyped)
+
+			if len(valEmailTyped) == 0 {
+				verr.add(ValidationLocationBody, pathEmail, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Email = valEmailTyped
 		}
 
-		valErrorMessageTyped = strings.TrimSpace(valErrorMessageTyped)
+	}
+
+	pathIsActive := joinValidationPath(path, "IsActive")
+
+	valIsActive, ok := data["IsActive"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathIsActive, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valIsActiveTyped, ok := valIsActive.(bool); !ok {
+			verr.add(ValidationLocationBody, pathIsActive, ValidationCodeInvalidType, "must be of type bool")
+		} else {
+
+			body.IsActive = valIsActiveTyped
+		}
+
+	}
+
+	pathUserId := joinValidationPath(path, "UserId")
+
+	valUserId, ok := data["UserId"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathUserId, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valUserIdTyped, ok := valUserId.(string); !ok {
+			verr.add(ValidationLocationBody, pathUserId, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valUserIdTyped = strings.TrimSpace(valUserIdTyped)
+
+			if len(valUserIdTyped) == 0 {
+				verr.add(ValidationLocationBody, pathUserId, ValidationCodeNonEmpty, "must be non-empty")
+			}
 
-		valErrorMessageTyped = strings.TrimSpace(valErrorMessageTyped)
-		if len(valErrorMessageTyped) == 0 {
-			return body, fmt.Errorf("field 'ErrorMessage' must be non-empty")
+			body.UserId = valUserIdTyped
 		}
 
-		body.ErrorMessage = valErrorMessageTyped
+	}
+
+	pathUserName := joinValidationPath(path, "UserName")
+
+	valUserName, ok := data["UserName"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathUserName, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valUserNameTyped, ok := valUserName.(string); !ok {
+			verr.add(ValidationLocationBody, pathUserName, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valUserNameTyped = strings.TrimSpace(valUserNameTyped)
+
+			if len(valUserNameTyped) == 0 {
+				verr.add(ValidationLocationBody, pathUserName, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.UserName = valUserNameTyped
+		}
 
 	}
 
-	return body, nil
+	return body
 }
 
-type HealthCheckResponseBody struct {
+// UnmarshalJSON decodes a JSON object into User, with the same checks as ParseUser.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *User) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
 
-	// The health status of the API, typically "OK".
-	//
-	// Required
-	//
-	// Must be non-empty
-	Status string `json:"Status"`
+// jsonUser holds the raw JSON of the fields of a User, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonUser struct {
+	CreatedAt jsonValue        `json:"CreatedAt"`
+	UpdatedBy jsonValue        `json:"UpdatedBy"`
+	Address   *jsonUserAddress `json:"Address"`
+	Age       jsonValue        `json:"Age"`
+	Email     jsonValue        `json:"Email"`
+	IsActive  jsonValue        `json:"IsActive"`
+	UserId    jsonValue        `json:"UserId"`
+	UserName  jsonValue        `json:"UserName"`
 }
 
-// NewHealthCheckResponseBody creates a new instance of HealthCheckResponseBody with required fields as parameters
-func NewHealthCheckResponseBody(
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *User) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonUser
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseUser instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseUser(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *User) decodeRaw(raw *jsonUser, path string, verr *ValidationError) {
+
+	pathCreatedAt := joinValidationPath(path, "CreatedAt")
+	if raw.CreatedAt.absent() {
+
+		verr.add(ValidationLocationBody, pathCreatedAt, ValidationCodeRequired, "missing required field")
+
+	} else if valCreatedAt, ok := decodeJSONValue[string](raw.CreatedAt, pathCreatedAt, "must be of type string", verr); ok {
+		valCreatedAt = strings.TrimSpace(valCreatedAt)
+
+		if len(valCreatedAt) == 0 {
+			verr.add(ValidationLocationBody, pathCreatedAt, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.CreatedAt = valCreatedAt
+	}
+
+	pathUpdatedBy := joinValidationPath(path, "UpdatedBy")
+	if raw.UpdatedBy.absent() {
+
+		// skip, leave as zero value
+
+	} else if valUpdatedBy, ok := decodeJSONValue[string](raw.UpdatedBy, pathUpdatedBy, "must be of type string", verr); ok {
+		valUpdatedBy = strings.TrimSpace(valUpdatedBy)
+
+		t.UpdatedBy = &valUpdatedBy
+	}
+
+	pathAddress := joinValidationPath(path, "Address")
+	if raw.Address == nil {
+
+		// skip, leave as zero value
+
+	} else {
+		valAddress := new(UserAddress)
+		valAddress.decodeRaw(raw.Address, pathAddress, verr)
+		t.Address = valAddress
+	}
+
+	pathAge := joinValidationPath(path, "Age")
+	if raw.Age.absent() {
+
+		// skip, leave as zero value
+
+	} else if valAge, ok := decodeJSONValue[int64](raw.Age, pathAge, "must be an integer", verr); ok {
+		t.Age = &valAge
+	}
+
+	pathEmail := joinValidationPath(path, "Email")
+	if raw.Email.absent() {
+
+		verr.add(ValidationLocationBody, pathEmail, ValidationCodeRequired, "missing required field")
+
+	} else if valEmail, ok := decodeJSONValue[string](raw.Email, pathEmail, "must be of type string", verr); ok {
+		valEmail = strings.TrimSpace(valEmail)
+
+		if len(valEmail) == 0 {
+			verr.add(ValidationLocationBody, pathEmail, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.Email = valEmail
+	}
+
+	pathIsActive := joinValidationPath(path, "IsActive")
+	if raw.IsActive.absent() {
+
+		verr.add(ValidationLocationBody, pathIsActive, ValidationCodeRequired, "missing required field")
+
+	} else if valIsActive, ok := decodeJSONValue[bool](raw.IsActive, pathIsActive, "must be of type bool", verr); ok {
+		t.IsActive = valIsActive
+	}
+
+	pathUserId := joinValidationPath(path, "UserId")
+	if raw.UserId.absent() {
+
+		verr.add(ValidationLocationBody, pathUserId, ValidationCodeRequired, "missing required field")
+
+	} else if valUserId, ok := decodeJSONValue[string](raw.UserId, pathUserId, "must be of type string", verr); ok {
+		valUserId = strings.TrimSpace(valUserId)
+
+		if len(valUserId) == 0 {
+			verr.add(ValidationLocationBody, pathUserId, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.UserId = valUserId
+	}
+
+	pathUserName := joinValidationPath(path, "UserName")
+	if raw.UserName.absent() {
 
-	Status string,
+		verr.add(ValidationLocationBody, pathUserName, ValidationCodeRequired, "missing required field")
 
-) *HealthCheckResponseBody {
-	return &HealthCheckResponseBody{
+	} else if valUserName, ok := decodeJSONValue[string](raw.UserName, pathUserName, "must be of type string", verr); ok {
+		valUserName = strings.TrimSpace(valUserName)
 
-		Status: Status,
+		if len(valUserName) == 0 {
+			verr.add(ValidationLocationBody, pathUserName, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.UserName = valUserName
 	}
-}
 
-func ParseHealthCheckResponseBody(data map[string]any) (*HealthCheckResponseBody, error) {
-	body := new(HealthCheckResponseBody)
+}
 
-	valStatus, ok := data["Status"]
-	if !ok {
+// Validate checks the required and non-empty constraints of an already-populated User,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *User) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
 
-		return body, fmt.Errorf("missing required field 'Status'")
+// validate records the issues of t in verr, with paths relative to path.
+func (t *User) validate(path string, verr *ValidationError) {
+	t.AuditedEntity.validate(path, verr)
 
-	} else {
+	if t.Address != nil {
 
-		valStatusTyped, ok := valStatus.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'Status' has incorrect type")
-		}
+		t.Address.validate(joinValidationPath(path, "Address"), verr)
 
-		valStatusTyped = strings.TrimSpace(valStatusTyped)
+	}
 
-		valStatusTyped = strings.TrimSpace(valStatusTyped)
-		if len(valStatusTyped) == 0 {
-			return body, fmt.Errorf("field 'Status' must be non-empty")
-		}
+	if len(strings.TrimSpace(t.Email)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Email"), ValidationCodeNonEmpty, "must be non-empty")
+	}
 
-		body.Status = valStatusTyped
+	if len(strings.TrimSpace(t.UserId)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "UserId"), ValidationCodeNonEmpty, "must be non-empty")
+	}
 
+	if len(strings.TrimSpace(t.UserName)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "UserName"), ValidationCodeNonEmpty, "must be non-empty")
 	}
 
-	return body, nil
 }
 
-type ListUsersResponseBody struct {
+type UserAddress struct {
 
-	// The current page number.
+	// The city.
 	//
 	// Required
 	//
-	PageNumber int64 `json:"PageNumber"`
+	// Must be non-empty
+	City string `json:"City"`
 
-	// The number of items per page.
+	// The coordinates of the address, if known.
 	//
-	// Required
+	// Optional
 	//
-	PageSize int64 `json:"PageSize"`
+	Geo *UserAddressGeo `json:"Geo,omitempty"`
 
-	// The total number of users available.
-	//
-	// Required
+	// The street and number.
 	//
-	TotalCount int64 `json:"TotalCount"`
-
 	// Required
 	//
-	Users []User `json:"Users"`
+	// Must be non-empty
+	Street string `json:"Street"`
 }
 
-// NewListUsersResponseBody creates a new instance of ListUsersResponseBody with required fields as parameters
-func NewListUsersResponseBody(
-
-	PageNumber int64,
-
-	PageSize int64,
-
-	TotalCount int64,
-
-	Users []User,
+// NewUserAddress creates a new instance of UserAddress with required fields as parameters
+func NewUserAddress(
 
-) *ListUsersResponseBody {
-	return &ListUsersResponseBody{
+	City string,
 
-		PageNumber: PageNumber,
+	Street string,
 
-		PageSize: PageSize,
+) *UserAddress {
+	return &UserAddress{
 
-		TotalCount: TotalCount,
+		City: City,
 
-		Users: Users,
+		Street: Street,
 	}
 }
 
-func ParseListUsersResponseBody(data map[string]any) (*ListUsersResponseBody, error) {
-	body := new(ListUsersResponseBody)
+// WithGeo sets the optional field Geo and returns the modified UserAddress instance
+func (o *UserAddress) WithGeo(value UserAddressGeo) *UserAddress {
+	o.Geo = &value
+	return o
+}
 
-	valPageNumber, ok := data["PageNumber"]
-	if !ok {
+// ParseUserAddress creates a new instance of UserAddress from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseUserAddress(data map[string]any) (*UserAddress, error) {
+	verr := &ValidationError{}
+	body := parseUserAddress(data, "", verr)
+	return body, verr.errOrNil()
+}
 
-		return body, fmt.Errorf("missing required field 'PageNumber'")
+// parseUserAddress parses data into a new UserAddress, recording issues in verr with paths relative to path.
+func parseUserAddress(data map[string]any, path string, verr *ValidationError) *UserAddress {
+	body := new(UserAddress)
 
-	} else {
+	pathCity := joinValidationPath(path, "City")
 
-		var valPageNumberTyped int64
-		// JSON numbers are float64 by default, so we need to handle that case
-		switch v := valPageNumber.(type) {
-		case float64:
-			valPageNumberTyped = int64(v)
-		case int64:
-			valPageNumberTyped = v
-		default:
-			return body, fmt.Errorf("field 'PageNumber' has incorrect type")
-		}
+	valCity, ok := data["City"]
+	if !ok {
 
-		body.PageNumber = valPageNumberTyped
+		verr.add(ValidationLocationBody, pathCity, ValidationCodeRequired, "missing required field")
 
-	}
+	} else {
 
-	valPageSize, ok := data["PageSize"]
-	if !ok {
+		if valCityTyped, ok := valCity.(string); !ok {
+			verr.add(ValidationLocationBody, pathCity, ValidationCodeInvalidType, "must be of type string")
+		} else {
 
-		return body, fmt.Errorf("missing required field 'PageSize'")
+			valCityTyped = strings.TrimSpace(valCityTyped)
 
-	} else {
+			if len(valCityTyped) == 0 {
+				verr.add(ValidationLocationBody, pathCity, ValidationCodeNonEmpty, "must be non-empty")
+			}
 
-		var valPageSizeTyped int64
-		// JSON numbers are float64 by default, so we need to handle that case
-		switch v := valPageSize.(type) {
-		case float64:
-			valPageSizeTyped = int64(v)
-		case int64:
-			valPageSizeTyped = v
-		default:
-			return body, fmt.Errorf("field 'PageSize' has incorrect type")
+			body.City = valCityTyped
 		}
 
-		body.PageSize = valPageSizeTyped
-
 	}
 
-	valTotalCount, ok := data["TotalCount"]
+	pathGeo := joinValidationPath(path, "Geo")
+
+	valGeo, ok := data["Geo"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'TotalCount'")
+		// skip, leave as zero value
 
 	} else {
 
-		var valTotalCountTyped int64
-		// JSON numbers are float64 by default, so we need to handle that case
-		switch v := valTotalCount.(type) {
-		case float64:
-			valTotalCountTyped = int64(v)
-		case int64:
-			valTotalCountTyped = v
-		default:
-			return body, fmt.Errorf("field 'TotalCount' has incorrect type")
+		if valGeoMap, ok := valGeo.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, pathGeo, ValidationCodeInvalidType, "must be an object")
+		} else {
+			body.Geo = parseUserAddressGeo(valGeoMap, pathGeo, verr)
 		}
 
-		body.TotalCount = valTotalCountTyped
-
 	}
 
-	valUsers, ok := data["Users"]
+	pathStreet := joinValidationPath(path, "Street")
+
+	valStreet, ok := data["Street"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'Users'")
+		verr.add(ValidationLocationBody, pathStreet, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valUsersSlice, ok := valUsers.([]any)
-		if !ok {
-			return body, fmt.Errorf("field 'Users' has incorrect type")
-		}
-
-		if len(valUsersSlice) == 0 {
-			return body, fmt.Errorf("field 'Users' must be non-empty")
-		}
-
-		valUsersTyped := make([]User, 0, len(valUsersSlice))
+		if valStreetTyped, ok := valStreet.(string); !ok {
+			verr.add(ValidationLocationBody, pathStreet, ValidationCodeInvalidType, "must be of type string")
+		} else {
 
-		for idx, item := range valUsersSlice {
+			valStreetTyped = strings.TrimSpace(valStreetTyped)
 
-			itemMap, ok := item.(map[string]any)
-			if !ok {
-				return body, fmt.Errorf("element %d of field 'Users' has incorrect type", idx)
-			}
-			validatedItem, err := ParseUser(itemMap)
-			if err != nil {
-				return body, fmt.Errorf("element %d of field 'Users' is invalid: %w", idx, err)
+			if len(valStreetTyped) == 0 {
+				verr.add(ValidationLocationBody, pathStreet, ValidationCodeNonEmpty, "must be non-empty")
 			}
 
-			valUsersTyped = append(valUsersTyped, *validatedItem)
+			body.Street = valStreetTyped
 		}
 
-		body.Users = valUsersTyped
-
 	}
 
-	return body, nil
+	return body
 }
 
-type LogoutUserResponseBody struct {
+// UnmarshalJSON decodes a JSON object into UserAddress, with the same checks as ParseUserAddress.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *UserAddress) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
 
-	// A message confirming successful logout.
-	//
-	// Required
-	//
-	// Must be non-empty
-	Message string `json:"Message"`
+// jsonUserAddress holds the raw JSON of the fields of a UserAddress, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonUserAddress struct {
+	City   jsonValue           `json:"City"`
+	Geo    *jsonUserAddressGeo `json:"Geo"`
+	Street jsonValue           `json:"Street"`
 }
 
-// NewLogoutUserResponseBody creates a new instance of LogoutUserResponseBody with required fields as parameters
-func NewLogoutUserResponseBody(
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *UserAddress) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonUserAddress
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseUserAddress instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseUserAddress(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
 
-	Message string,
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *UserAddress) decodeRaw(raw *jsonUserAddress, path string, verr *ValidationError) {
 
-) *LogoutUserResponseBody {
-	return &LogoutUserResponseBody{
+	pathCity := joinValidationPath(path, "City")
+	if raw.City.absent() {
 
-		Message: Message,
-	}
-}
+		verr.add(ValidationLocationBody, pathCity, ValidationCodeRequired, "missing required field")
 
-func ParseLogoutUserResponseBody(data map[string]any) (*LogoutUserResponseBody, error) {
-	body := new(LogoutUserResponseBody)
+	} else if valCity, ok := decodeJSONValue[string](raw.City, pathCity, "must be of type string", verr); ok {
+		valCity = strings.TrimSpace(valCity)
 
-	valMessage, ok := data["Message"]
-	if !ok {
+		if len(valCity) == 0 {
+			verr.add(ValidationLocationBody, pathCity, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.City = valCity
+	}
+
+	pathGeo := joinValidationPath(path, "Geo")
+	if raw.Geo == nil {
 
-		return body, fmt.Errorf("missing required field 'Message'")
+		// skip, leave as zero value
 
 	} else {
+		valGeo := new(UserAddressGeo)
+		valGeo.decodeRaw(raw.Geo, pathGeo, verr)
+		t.Geo = valGeo
+	}
 
-		valMessageTyped, ok := valMessage.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'Message' has incorrect type")
-		}
+	pathStreet := joinValidationPath(path, "Street")
+	if raw.Street.absent() {
 
-		valMessageTyped = strings.TrimSpace(valMessageTyped)
+		verr.add(ValidationLocationBody, pathStreet, ValidationCodeRequired, "missing required field")
 
-		valMessageTyped = strings.TrimSpace(valMessageTyped)
-		if len(valMessageTyped) == 0 {
-			return body, fmt.Errorf("field 'Message' must be non-empty")
-		}
+	} else if valStreet, ok := decodeJSONValue[string](raw.Street, pathStreet, "must be of type string", verr); ok {
+		valStreet = strings.TrimSpace(valStreet)
 
-		body.Message = valMessageTyped
+		if len(valStreet) == 0 {
+			verr.add(ValidationLocationBody, pathStreet, ValidationCodeNonEmpty, "must be non-empty")
+		}
 
+		t.Street = valStreet
 	}
 
-	return body, nil
 }
 
-type User struct {
+// Validate checks the required and non-empty constraints of an already-populated UserAddress,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *UserAddress) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
 
-	// The age of the user.
-	//
-	// Optional
-	//
-	Age *int64 `json:"Age,omitempty"`
+// validate records the issues of t in verr, with paths relative to path.
+func (t *UserAddress) validate(path string, verr *ValidationError) {
 
-	// The email address of the user.
-	//
-	// Required
-	//
-	// Must be non-empty
-	Email string `json:"Email"`
+	if len(strings.TrimSpace(t.City)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "City"), ValidationCodeNonEmpty, "must be non-empty")
+	}
 
-	// Indicates whether the user is active.
-	//
-	// Required
-	//
-	IsActive bool `json:"IsActive"`
+	if t.Geo != nil {
 
-	// The unique identifier of the user.
+		t.Geo.validate(joinValidationPath(path, "Geo"), verr)
+
+	}
+
+	if len(strings.TrimSpace(t.Street)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Street"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+}
+
+type UserAddressGeo struct {
+
+	// The latitude, in degrees.
 	//
 	// Required
 	//
-	// Must be non-empty
-	UserId string `json:"UserId"`
+	Latitude float64 `json:"Latitude"`
 
-	// The name of the user.
+	// The longitude, in degrees.
 	//
 	// Required
 	//
-	// Must be non-empty
-	UserName string `json:"UserName"`
+	Longitude float64 `json:"Longitude"`
 }
 
-// NewUser creates a new instance of User with required fields as parameters
-func NewUser(
-
-	Email string,
-
-	IsActive bool,
-
-	UserId string,
-
-	UserName string,
+// NewUserAddressGeo creates a new instance of UserAddressGeo with required fields as parameters
+func NewUserAddressGeo(
 
-) *User {
-	return &User{
+	Latitude float64,
 
-		Email: Email,
+	Longitude float64,
 
-		IsActive: IsActive,
+) *UserAddressGeo {
+	return &UserAddressGeo{
 
-		UserId: UserId,
+		Latitude: Latitude,
 
-		UserName: UserName,
+		Longitude: Longitude,
 	}
 }
 
-// WithAge sets the optional field Age and returns the modified User instance
-func (o *User) WithAge(value int64) *User {
-	o.Age = &value
-	return o
+// ParseUserAddressGeo creates a new instance of UserAddressGeo from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseUserAddressGeo(data map[string]any) (*UserAddressGeo, error) {
+	verr := &ValidationError{}
+	body := parseUserAddressGeo(data, "", verr)
+	return body, verr.errOrNil()
 }
 
-func ParseUser(data map[string]any) (*User, error) {
-	body := new(User)
-
-	valAge, ok := data["Age"]
-	if !ok {
-
-		// skip, leave as zero value
-
-	} else {
-
-		var valAgeTyped int64
-		// JSON numbers are float64 by default, so we need to handle that case
-		switch v := valAge.(type) {
-		case float64:
-			valAgeTyped = int64(v)
-		case int64:
-			valAgeTyped = v
-		default:
-			return body, fmt.Errorf("field 'Age' has incorrect type")
-		}
-
-		body.Age = &valAgeTyped
+// parseUserAddressGeo parses data into a new UserAddressGeo, recording issues in verr with paths relative to path.
+func parseUserAddressGeo(data map[string]any, path string, verr *ValidationError) *UserAddressGeo {
+	body := new(UserAddressGeo)
 
-	}
+	pathLatitude := joinValidationPath(path, "Latitude")
 
-	valEmail, ok := data["Email"]
+	valLatitude, ok := data["Latitude"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'Email'")
+		verr.add(ValidationLocationBody, pathLatitude, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valEmailTyped, ok := valEmail.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'Email' has incorrect type")
-		}
+		if valLatitudeTyped, ok := valLatitude.(float64); !ok {
+			verr.add(ValidationLocationBody, pathLatitude, ValidationCodeInvalidType, "must be of type float64")
+		} else {
 
-		valEmailTyped = strings.TrimSpace(valEmailTyped)
-
-		valEmailTyped = strings.TrimSpace(valEmailTyped)
-		if len(valEmailTyped) == 0 {
-			return body, fmt.Errorf("field 'Email' must be non-empty")
+			body.Latitude = valLatitudeTyped
 		}
 
-		body.Email = valEmailTyped
-
 	}
 
-	valIsActive, ok := data["IsActive"]
+	pathLongitude := joinValidationPath(path, "Longitude")
+
+	valLongitude, ok := data["Longitude"]
 	if !ok {
 
-		return body, fmt.Errorf("missing required field 'IsActive'")
+		verr.add(ValidationLocationBody, pathLongitude, ValidationCodeRequired, "missing required field")
 
 	} else {
 
-		valIsActiveTyped, ok := valIsActive.(bool)
-		if !ok {
-			return body, fmt.Errorf("field 'IsActive' has incorrect type")
-		}
+		if valLongitudeTyped, ok := valLongitude.(float64); !ok {
+			verr.add(ValidationLocationBody, pathLongitude, ValidationCodeInvalidType, "must be of type float64")
+		} else {
 
-		body.IsActive = valIsActiveTyped
+			body.Longitude = valLongitudeTyped
+		}
 
 	}
 
-	valUserId, ok := data["UserId"]
-	if !ok {
+	return body
+}
 
-		return body, fmt.Errorf("missing required field 'UserId'")
+// UnmarshalJSON decodes a JSON object into UserAddressGeo, with the same checks as ParseUserAddressGeo.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *UserAddressGeo) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
 
-	} else {
+// jsonUserAddressGeo holds the raw JSON of the fields of a UserAddressGeo, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonUserAddressGeo struct {
+	Latitude  jsonValue `json:"Latitude"`
+	Longitude jsonValue `json:"Longitude"`
+}
 
-		valUserIdTyped, ok := valUserId.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'UserId' has incorrect type")
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *UserAddressGeo) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonUserAddressGeo
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
 		}
-
-		valUserIdTyped = strings.TrimSpace(valUserIdTyped)
-
-		valUserIdTyped = strings.TrimSpace(valUserIdTyped)
-		if len(valUserIdTyped) == 0 {
-			return body, fmt.Errorf("field 'UserId' must be non-empty")
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseUserAddressGeo instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseUserAddressGeo(fields, path, verr)
 		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
 
-		body.UserId = valUserIdTyped
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *UserAddressGeo) decodeRaw(raw *jsonUserAddressGeo, path string, verr *ValidationError) {
 
-	}
+	pathLatitude := joinValidationPath(path, "Latitude")
+	if raw.Latitude.absent() {
 
-	valUserName, ok := data["UserName"]
-	if !ok {
+		verr.add(ValidationLocationBody, pathLatitude, ValidationCodeRequired, "missing required field")
 
-		return body, fmt.Errorf("missing required field 'UserName'")
+	} else if valLatitude, ok := decodeJSONValue[float64](raw.Latitude, pathLatitude, "must be of type float64", verr); ok {
+		t.Latitude = valLatitude
+	}
 
-	} else {
+	pathLongitude := joinValidationPath(path, "Longitude")
+	if raw.Longitude.absent() {
 
-		valUserNameTyped, ok := valUserName.(string)
-		if !ok {
-			return body, fmt.Errorf("field 'UserName' has incorrect type")
-		}
+		verr.add(ValidationLocationBody, pathLongitude, ValidationCodeRequired, "missing required field")
 
-		valUserNameTyped = strings.TrimSpace(valUserNameTyped)
+	} else if valLongitude, ok := decodeJSONValue[float64](raw.Longitude, pathLongitude, "must be of type float64", verr); ok {
+		t.Longitude = valLongitude
+	}
 
-		valUserNameTyped = strings.TrimSpace(valUserNameTyped)
-		if len(valUserNameTyped) == 0 {
-			return body, fmt.Errorf("field 'UserName' must be non-empty")
-		}
+}
 
-		body.UserName = valUserNameTyped
+// Validate checks the required and non-empty constraints of an already-populated UserAddressGeo,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *UserAddressGeo) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
 
-	}
+// validate records the issues of t in verr, with paths relative to path.
+func (t *UserAddressGeo) validate(path string, verr *ValidationError) {
 
-	return body, nil
 }
 
 // Enum representing the status of a user.
@@ -946,3 +5495,83 @@ func ParseUserStatus(data string) (*UserStatus, error) {
 		return nil, fmt.Errorf("invalid value for UserStatus: %s", data)
 	}
 }
+
+// marshalWithDiscriminator encodes fields, the fields of a variant of a oneOf schema, as a JSON object
+// whose first property is its discriminator, set to value.
+func marshalWithDiscriminator(fields any, property, value string) ([]byte, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(`{"` + property + `":`)
+	buf.Write(encodedValue)
+	if len(data) > 2 {
+		buf.WriteByte(',')
+	}
+	// data is a JSON object, whose opening brace is replaced
+	buf.Write(data[1:])
+	return buf.Bytes(), nil
+}
+
+// jsonValue holds the raw JSON of a single value while a decodeJSON method runs.
+type jsonValue []byte
+
+func (v *jsonValue) UnmarshalJSON(data []byte) error {
+	// data is a sub-slice of the buffer passed to json.Unmarshal by decodeJSON, which outlives v,
+	// so it is kept without copying.
+	*v = data
+	return nil
+}
+
+// absent reports whether the field was missing from the JSON object, or null.
+func (v jsonValue) absent() bool {
+	return len(v) == 0 || string(v) == "null"
+}
+
+// decodeJSONValue decodes raw into a T, recording an invalid_type issue with the given message if it has the wrong type.
+//
+// raw is already known to be valid JSON, so primitives are parsed directly instead of going through json.Unmarshal again.
+func decodeJSONValue[T any](raw jsonValue, path, message string, verr *ValidationError) (T, bool) {
+	var val T
+	ok := true
+	switch dst := any(&val).(type) {
+	case *string:
+		if len(raw) >= 2 && raw[0] == '"' && bytes.IndexByte(raw, '\\') == -1 {
+			*dst = string(raw[1 : len(raw)-1])
+		} else {
+			ok = json.Unmarshal(raw, dst) == nil
+		}
+	case *int64:
+		var err error
+		if *dst, err = strconv.ParseInt(string(raw), 10, 64); err != nil {
+			// Like Parse<Schema>, which gets JSON numbers as float64, numbers like 1.0 or 1e3 are accepted,
+			// and truncated to an integer
+			var number float64
+			number, ok = decodeJSONNumber(raw)
+			*dst = int64(number)
+		}
+	case *float64:
+		*dst, ok = decodeJSONNumber(raw)
+	case *bool:
+		*dst = string(raw) == "true"
+		ok = *dst || string(raw) == "false"
+	default:
+		ok = json.Unmarshal(raw, dst) == nil
+	}
+	if !ok {
+		verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, message)
+	}
+	return val, ok
+}
+
+// decodeJSONNumber parses raw as a JSON number.
+func decodeJSONNumber(raw jsonValue) (float64, bool) {
+	number, err := strconv.ParseFloat(string(raw), 64)
+	// ParseFloat also accepts values like "Inf" or "0x1p-2", which are not JSON numbers
+	return number, err == nil && (raw[0] == '-' || (raw[0] >= '0' && raw[0] <= '9'))
+}
@@ -1,39 +1,54 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand/v2"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/nbrglm/napiway/testdata/out/server/api"
 )
 
 type User struct {
-	ID       string `json:"id"`
-	Name     string `json:"name"`
-	Email    string `json:"email"`
-	IsActive bool   `json:"is_active"`
-	Age      *int64 `json:"age"`
+	ID        string           `json:"id"`
+	Name      string           `json:"name"`
+	Email     string           `json:"email"`
+	IsActive  bool             `json:"is_active"`
+	Age       *int64           `json:"age"`
+	CreatedAt string           `json:"created_at"`
+	UpdatedBy string           `json:"updated_by"`
+	Address   *api.UserAddress `json:"address"`
 }
 
 var age1 = int64(28)
 
 var users = []User{
 	{
-		ID:       "1",
-		Name:     "Alice",
-		Email:    "alice@example.com",
-		IsActive: true,
-		Age:      &age1,
+		ID:        "1",
+		Name:      "Alice",
+		Email:     "alice@example.com",
+		IsActive:  true,
+		Age:       &age1,
+		CreatedAt: "2024-01-01T00:00:00Z",
+		UpdatedBy: "2",
+		Address:   api.NewUserAddress("Springfield", "742 Evergreen Terrace").WithGeo(*api.NewUserAddressGeo(39.78, -89.65)),
 	},
 	{
-		ID:       "2",
-		Name:     "Bob",
-		Email:    "bob@example.com",
-		IsActive: false,
-		Age:      nil,
+		ID:        "2",
+		Name:      "Bob",
+		Email:     "bob@example.com",
+		IsActive:  false,
+		Age:       nil,
+		CreatedAt: "2024-01-02T00:00:00Z",
 	},
 }
 
@@ -53,71 +68,176 @@ func main() {
 	serverAddr := args[1]
 
 	mux := http.NewServeMux()
+	srv := &server{
+		MemoryIdempotencyStore: api.NewMemoryIdempotencyStore(0),
+		serviceTokens:          make(map[string]bool),
+		flakyAttempts:          make(map[string]int64),
+	}
+	api.RegisterRoutes(mux, srv)
+	mux.HandleFunc("POST /oauth/token", srv.IssueServiceToken)
 
-	mux.HandleFunc(api.ListUsersReqRoutePath, handleListUsers)
+	if err := http.ListenAndServe(serverAddr, mux); err != nil {
+		stdErr(true, "Failed to start server OR Exiting Server: %v\n", err)
+	}
+}
 
-	mux.HandleFunc(api.GetUserReqRoutePath, handleGetUser)
+// server implements api.Handler
+type server struct {
+	*api.MemoryIdempotencyStore
 
-	mux.HandleFunc(api.CreateUserReqRoutePath, handleCreateUser)
+	// mu guards serviceTokens, the tokens issued by IssueServiceToken and accepted by VerifyService,
+	// and flakyAttempts, the number of GetFlaky and CreateFlaky requests per key.
+	mu            sync.Mutex
+	serviceTokens map[string]bool
+	flakyAttempts map[string]int64
+}
 
-	mux.HandleFunc(api.LogoutUserReqRoutePath, handleLogoutUser)
+// principal is the api.Principal of every auth method. Any token except revokedToken is accepted,
+// the handlers check for "valid" tokens to answer with the 400s the client expects.
+type principal struct {
+	Token string
+}
 
-	mux.HandleFunc(api.WhoAmIReqRoutePath, handleWhoAmI)
+// revokedToken is rejected by VerifyAdminToken, so the client can check that rejected credentials are answered with 401.
+const revokedToken = "revoked"
 
-	mux.HandleFunc(api.HealthCheckReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
-		req, _ := api.ParseHealthCheckReq(w, r)
-		if r.Method != api.HealthCheckReqHTTPMethod {
-			w.WriteHeader(http.StatusMethodNotAllowed)
-			return
-		}
-		req.Write200(
-			w,
-			api.NewHealthCheck200(
-				api.NewHealthCheckResponseBody("ok"),
-			),
-		)
-	})
+func (s *server) VerifyAPIKey(ctx context.Context, token string) (api.Principal, error) {
+	return principal{Token: token}, nil
+}
 
-	if err := http.ListenAndServe(serverAddr, mux); err != nil {
-		stdErr(true, "Failed to start server OR Exiting Server: %v\n", err)
+func (s *server) VerifyAdminToken(ctx context.Context, token string) (api.Principal, error) {
+	if token == revokedToken {
+		return nil, errors.New("admin token revoked")
 	}
+	return principal{Token: token}, nil
 }
 
-func handleListUsers(w http.ResponseWriter, r *http.Request) {
-	if r.Method != api.ListUsersReqHTTPMethod {
-		w.WriteHeader(http.StatusMethodNotAllowed)
-		return
+func (s *server) VerifyRefreshToken(ctx context.Context, token string) (api.Principal, error) {
+	return principal{Token: token}, nil
+}
+
+func (s *server) VerifySessionToken(ctx context.Context, token string) (api.Principal, error) {
+	return principal{Token: token}, nil
+}
+
+func (s *server) VerifyBearerToken(ctx context.Context, token string) (api.Principal, error) {
+	return principal{Token: token}, nil
+}
+
+func (s *server) VerifyBasic(ctx context.Context, credentials api.BasicAuthCredentials) (api.Principal, error) {
+	return principal{Token: credentials.Username + ":" + credentials.Password}, nil
+}
+
+func (s *server) VerifyQueryKey(ctx context.Context, token string) (api.Principal, error) {
+	return principal{Token: token}, nil
+}
+
+func (s *server) VerifySessionCookie(ctx context.Context, token string) (api.Principal, error) {
+	return principal{Token: token}, nil
+}
+
+func (s *server) VerifyService(ctx context.Context, token string) (api.Principal, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.serviceTokens[token] {
+		return nil, errors.New("unknown service token")
 	}
+	return principal{Token: token}, nil
+}
 
-	req, err := api.ParseListUsersReq(w, r)
-	if err != nil {
-		debugMsg := err.Error()
-		req.Write400(w, api.NewListUsers400(
-			api.NewErrorResponse(
-				debugMsg,
-			),
-		))
+// The signing key of the webhookAuth auth method.
+const (
+	webhookKeyID     = "webhook-key"
+	webhookKeySecret = "webhook-secret"
+)
+
+func (s *server) LookupWebhookSignatureKey(ctx context.Context, keyID string) (api.Principal, []byte, error) {
+	if keyID != webhookKeyID {
+		return nil, nil, errors.New("unknown webhook key")
+	}
+	return principal{Token: keyID}, []byte(webhookKeySecret), nil
+}
+
+// The client credentials accepted by IssueServiceToken.
+const (
+	serviceClientID     = "service-client"
+	serviceClientSecret = "service-secret"
+)
+
+// IssueServiceToken is the OAuth2 token endpoint of the serviceAuth auth method, for the client credentials grant.
+func (s *server) IssueServiceToken(w http.ResponseWriter, r *http.Request) {
+	// The client credentials are form-encoded (RFC 6749, section 2.3.1)
+	clientID, clientSecret, ok := r.BasicAuth()
+	clientID, _ = url.QueryUnescape(clientID)
+	clientSecret, _ = url.QueryUnescape(clientSecret)
+	if !ok || clientID != serviceClientID || clientSecret != serviceClientSecret {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+		return
+	}
+	if r.PostFormValue("grant_type") != "client_credentials" {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"unsupported_grant_type"}`))
 		return
 	}
 
+	s.mu.Lock()
+	token := fmt.Sprintf("service-token-%d", len(s.serviceTokens)+1)
+	s.serviceTokens[token] = true
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = fmt.Fprintf(w, `{"access_token":%q,"token_type":"Bearer","expires_in":3600}`, token)
+}
+
+// readOnlySessionToken is a session token whose caller only has the "users:read" scope, every other caller has all scopes.
+const readOnlySessionToken = "read-only"
+
+func (s *server) CheckScopes(r *http.Request, route api.Route) error {
+	session, ok := api.SessionTokenPrincipal(r.Context())
+	if !ok || session.(principal).Token != readOnlySessionToken {
+		return nil
+	}
+	for _, scope := range route.Scopes {
+		if scope != "users:read" {
+			return fmt.Errorf("read-only session lacks scope %s", scope)
+		}
+	}
+	return nil
+}
+
+func (s *server) RenderParseError(r *http.Request, err *api.ParseError) *api.ErrorResponse {
+	body := api.NewErrorResponse(http.StatusText(err.StatusCode()))
+	debugMessage := err.Error()
+	body.DebugMessage = &debugMessage
+	return body
+}
+
+func (s *server) HealthCheck(r *http.Request, req *api.HealthCheckReq) (api.HealthCheckResponse, error) {
+	return api.NewHealthCheck200(
+		api.NewHealthCheckResponseBody("ok"),
+	), nil
+}
+
+func (s *server) ListUsers(r *http.Request, req *api.ListUsersReq) (api.ListUsersResponse, error) {
 	if req.APIKeyAuth != "valid" {
 		debugMsg := "Invalid API key"
-		req.Write400(w, api.NewListUsers400(
+		return api.NewListUsers400(
 			api.NewErrorResponse(
 				debugMsg,
 			),
-		))
-		return
+		), nil
 	}
 
 	if req.AdminTokenAuth != "valid" {
 		debugMsg := "Invalid Admin token"
-		req.Write400(w, api.NewListUsers400(
+		return api.NewListUsers400(
 			api.NewErrorResponse(
 				debugMsg,
 			),
-		))
-		return
+		), nil
 	}
 
 	pageNumber := 0
@@ -144,7 +264,7 @@ func handleListUsers(w http.ResponseWriter, r *http.Request) {
 		respUsers = append(respUsers, *mapToApiUser(user))
 	}
 
-	req.Write200(w, api.NewListUsers200(
+	return api.NewListUsers200(
 		rand.Int64N(100),
 		api.NewListUsersResponseBody(
 			int64(pageNumber),
@@ -152,53 +272,64 @@ func handleListUsers(w http.ResponseWriter, r *http.Request) {
 			int64(len(users)),
 			respUsers,
 		),
-	))
+	), nil
 }
 
-func handleGetUser(w http.ResponseWriter, r *http.Request) {
-	if r.Method != api.GetUserReqHTTPMethod {
-		w.WriteHeader(http.StatusMethodNotAllowed)
-		return
+// events are listed by ListEvents and ListEventFeed.
+var events = []string{"event-1", "event-2", "event-3", "event-4", "event-5"}
+
+// eventsPage returns the events from start, at most limit of them (default 10), and the index of the next one.
+func eventsPage(start int64, limit *int64) ([]string, int64) {
+	end := start + 10
+	if limit != nil && *limit > 0 {
+		end = start + *limit
 	}
+	start = min(max(start, 0), int64(len(events)))
+	end = min(max(end, start), int64(len(events)))
+	return events[start:end], end
+}
 
-	req, err := api.ParseGetUserReq(w, r)
-	if err != nil {
-		debugMsg := err.Error()
-		fmt.Printf("Error parsing GetUser request: %s\n", debugMsg)
-		req.Write400(
-			w,
-			api.NewGetUser400(
-				api.NewErrorResponse("Invalid request"),
-			),
-		)
-		return
+func (s *server) ListEvents(r *http.Request, req *api.ListEventsReq) (api.ListEventsResponse, error) {
+	var offset int64
+	if req.Offset != nil {
+		offset = *req.Offset
 	}
+	page, _ := eventsPage(offset, req.Limit)
+	return api.NewListEvents200(api.NewEventPage(page, int64(len(events)))), nil
+}
 
+func (s *server) ListEventFeed(r *http.Request, req *api.ListEventFeedReq) (api.ListEventFeedResponse, error) {
+	// The cursor is the index of the first event of the page
+	var start int64
+	if req.Cursor != nil {
+		start, _ = strconv.ParseInt(*req.Cursor, 10, 64)
+	}
+	page, next := eventsPage(start, req.Limit)
+	body := api.NewEventFeed(page)
+	if next < int64(len(events)) {
+		body.WithNextCursor(strconv.FormatInt(next, 10))
+	}
+	return api.NewListEventFeed200(body), nil
+}
+
+func (s *server) GetUser(r *http.Request, req *api.GetUserReq) (api.GetUserResponse, error) {
 	if req.APIKeyAuth != "valid" {
 		debugMsg := "Invalid API key"
 		fmt.Printf("Error handling GetUser request: %s\n", debugMsg)
-		req.Write400(
-			w,
-			api.NewGetUser400(
-				api.NewErrorResponse("Unauthorized"),
-			),
-		)
-		return
+		return api.NewGetUser400(
+			api.NewErrorResponse("Unauthorized"),
+		), nil
 	}
 
 	if req.SessionTokenAuth != "valid" {
 		debugMsg := "Invalid Session token"
 		fmt.Printf("Error handling GetUser request: %s\n", debugMsg)
-		req.Write400(
-			w,
-			api.NewGetUser400(
-				api.NewErrorResponse("Unauthorized"),
-			),
-		)
-		return
+		return api.NewGetUser400(
+			api.NewErrorResponse("Unauthorized"),
+		), nil
 	}
 
-	user := new(api.User)
+	var user *api.User
 	for _, u := range users {
 		if u.ID == req.UserId {
 			user = mapToApiUser(u)
@@ -209,73 +340,48 @@ func handleGetUser(w http.ResponseWriter, r *http.Request) {
 	if user == nil {
 		debugMsg := "User not found"
 		fmt.Printf("Error handling GetUser request: %s\n", debugMsg)
-		req.Write404(
-			w,
-			api.NewGetUser404(
-				api.NewErrorResponse("User not found"),
-			),
-		)
-		return
+		return api.NewGetUser404(
+			api.NewErrorResponse("User not found"),
+		), nil
 	}
 
-	req.Write200(
-		w,
-		api.NewGetUser200(
-			user,
-		),
-	)
+	return api.NewGetUser200(
+		user,
+	), nil
 }
 
-func handleCreateUser(w http.ResponseWriter, r *http.Request) {
-	if r.Method != api.CreateUserReqHTTPMethod {
-		w.WriteHeader(http.StatusMethodNotAllowed)
-		return
-	}
-
-	req, err := api.ParseCreateUserReq(w, r)
-
-	if err != nil {
-		debugMsg := err.Error()
-		fmt.Printf("Error parsing CreateUser request: %s\n", debugMsg)
-		req.Write400(w, api.NewCreateUser400(
-			api.NewErrorResponse("Invalid request"),
-		))
-		return
-	}
-
+func (s *server) CreateUser(r *http.Request, req *api.CreateUserReq) (api.CreateUserResponse, error) {
 	if req.APIKeyAuth != "valid" {
 		debugMsg := "Invalid API key"
 		fmt.Printf("Error handling CreateUser request: %s\n", debugMsg)
-		req.Write400(w, api.NewCreateUser400(
+		return api.NewCreateUser400(
 			api.NewErrorResponse(debugMsg),
-		))
-		return
+		), nil
 	}
 
 	if req.AdminTokenAuth != "valid" {
 		debugMsg := "Invalid Admin token"
 		fmt.Printf("Error handling CreateUser request: %s\n", debugMsg)
-		req.Write400(w, api.NewCreateUser400(
+		return api.NewCreateUser400(
 			api.NewErrorResponse(debugMsg),
-		))
-		return
+		), nil
 	}
 
 	if req.Body.Age != nil && *req.Body.Age < 0 {
 		debugMsg := "Age cannot be negative"
 		fmt.Printf("Error handling CreateUser request: %s\n", debugMsg)
-		req.Write400(w, api.NewCreateUser400(
+		return api.NewCreateUser400(
 			api.NewErrorResponse(debugMsg),
-		))
-		return
+		), nil
 	}
 
 	user := User{
-		ID:       fmt.Sprintf("%d", len(users)+1),
-		Name:     req.Body.UserName,
-		Email:    req.Body.Email,
-		IsActive: true,
-		Age:      req.Body.Age,
+		ID:        fmt.Sprintf("%d", len(users)+1),
+		Name:      req.Body.UserName,
+		Email:     req.Body.Email,
+		IsActive:  true,
+		Age:       req.Body.Age,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
 	}
 
 	users = append(users, user)
@@ -289,107 +395,161 @@ func handleCreateUser(w http.ResponseWriter, r *http.Request) {
 	if req.Body.OptionalStatus != nil {
 		respBody = respBody.WithOptionalStatus(*req.Body.OptionalStatus)
 	}
+	if req.Body.WelcomeNotification != nil {
+		respBody = respBody.WithWelcomeNotification(req.Body.WelcomeNotification)
+	}
+	if req.Body.Notifications != nil {
+		respBody = respBody.WithNotifications(req.Body.Notifications)
+	}
+	if req.Body.Labels != nil {
+		respBody = respBody.WithLabels(req.Body.Labels)
+	}
+	if req.Body.Contacts != nil {
+		respBody = respBody.WithContacts(req.Body.Contacts)
+	}
+	if req.Body.Coordinates != nil {
+		respBody = respBody.WithCoordinates(req.Body.Coordinates)
+	}
+	if req.Body.NotificationRounds != nil {
+		respBody = respBody.WithNotificationRounds(req.Body.NotificationRounds)
+	}
 
-	resp := api.NewCreateUser201(respBody)
+	return api.NewCreateUser201(respBody), nil
+}
 
-	req.Write201(w, resp)
+// SendNotification answers with the notification of the request, giving an email without a subject the default one.
+func (s *server) SendNotification(r *http.Request, req *api.SendNotificationReq) (api.SendNotificationResponse, error) {
+	if email, ok := req.Body.(*api.EmailNotification); ok && email.Subject == nil {
+		email.WithSubject("(no subject)")
+	}
+	return api.NewSendNotification200(req.Body), nil
 }
 
-func handleLogoutUser(w http.ResponseWriter, r *http.Request) {
-	if r.Method != api.LogoutUserReqHTTPMethod {
-		w.WriteHeader(http.StatusMethodNotAllowed)
-		return
+func (s *server) LogoutUser(r *http.Request, req *api.LogoutUserReq) (api.LogoutUserResponse, error) {
+	if req.APIKeyAuth != "valid" {
+		debugMsg := "Invalid API key"
+		return api.NewLogoutUser400(
+			api.NewErrorResponse(debugMsg),
+		), nil
 	}
 
-	req, err := api.ParseLogoutUserReq(w, r)
-	if err != nil {
-		debugMsg := err.Error()
-		req.Write400(
-			w,
-			api.NewLogoutUser400(
-				api.NewErrorResponse(debugMsg),
+	if p, ok := api.RefreshTokenPrincipal(r.Context()); ok && p.(principal).Token == "valid" {
+		return api.NewLogoutUser200(
+			api.NewLogoutUserResponseBody(
+				"RefreshToken Logout successful",
 			),
-		)
-		return
+		), nil
 	}
 
-	if req.APIKeyAuth == "valid" {
-		if req.RefreshTokenAuth != nil && *req.RefreshTokenAuth == "valid" {
-			req.Write200(
-				w,
-				api.NewLogoutUser200(
-					api.NewLogoutUserResponseBody(
-						"RefreshToken Logout successful",
-					),
-				),
-			)
-		} else if req.SessionTokenAuth != nil && *req.SessionTokenAuth == "valid" {
-			req.Write200(
-				w,
-				api.NewLogoutUser200(
-					api.NewLogoutUserResponseBody(
-						"SessionToken Logout successful",
-					),
-				),
-			)
-		} else {
-			debugMsg := "Invalid or missing token"
-			req.Write400(
-				w,
-				api.NewLogoutUser400(
-					api.NewErrorResponse(debugMsg),
-				),
-			)
-		}
-	} else {
-		debugMsg := "Invalid API key"
-		req.Write400(
-			w,
-			api.NewLogoutUser400(
-				api.NewErrorResponse(debugMsg),
+	if p, ok := api.SessionTokenPrincipal(r.Context()); ok && p.(principal).Token == "valid" {
+		return api.NewLogoutUser200(
+			api.NewLogoutUserResponseBody(
+				"SessionToken Logout successful",
 			),
-		)
+		), nil
 	}
+
+	debugMsg := "Invalid or missing token"
+	return api.NewLogoutUser400(
+		api.NewErrorResponse(debugMsg),
+	), nil
 }
 
-func handleWhoAmI(w http.ResponseWriter, r *http.Request) {
-	if r.Method != api.WhoAmIReqHTTPMethod {
-		w.WriteHeader(http.StatusMethodNotAllowed)
-		return
+func (s *server) GetSession(r *http.Request, req *api.GetSessionReq) (api.GetSessionResponse, error) {
+	principals := []struct {
+		authMethod string
+		principal  func(context.Context) (api.Principal, bool)
+	}{
+		{"BearerToken", api.BearerTokenPrincipal},
+		{"Basic", api.BasicPrincipal},
+		{"QueryKey", api.QueryKeyPrincipal},
+		{"SessionCookie", api.SessionCookiePrincipal},
+		{"Service", api.ServicePrincipal},
+	}
+	for _, p := range principals {
+		if authenticated, ok := p.principal(r.Context()); ok {
+			return api.NewGetSession200(
+				api.NewGetSessionResponseBody(p.authMethod, authenticated.(principal).Token),
+			), nil
+		}
 	}
+	return nil, fmt.Errorf("request authenticated without a principal")
+}
 
-	req, err := api.ParseWhoAmIReq(w, r)
-	if err != nil {
-		debugMsg := err.Error()
-		fmt.Printf("Error parsing WhoAmI request: %s\n", debugMsg)
-		req.Write400(w)
-		return
+func (s *server) ReceiveWebhook(r *http.Request, req *api.ReceiveWebhookReq) (api.ReceiveWebhookResponse, error) {
+	return api.NewReceiveWebhook200(
+		api.NewReceiveWebhookResponseBody(req.Body.Event, req.WebhookSignatureAuth.KeyID),
+	), nil
+}
+
+// flakyAttempt counts a GetFlaky or CreateFlaky request with key, and returns the number of requests with key so far.
+//
+// The 503 responses ask to retry after 0 seconds, or after 1 second, which exceeds the retry policy's
+// maxBackoff, for keys prefixed with "slow-".
+func (s *server) flakyAttempt(key string) (attempts int64, retryAfter int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.flakyAttempts[key]++
+	if strings.HasPrefix(key, "slow-") {
+		retryAfter = 1
+	}
+	return s.flakyAttempts[key], retryAfter
+}
+
+func (s *server) GetFlaky(r *http.Request, req *api.GetFlakyReq) (api.GetFlakyResponse, error) {
+	attempts, retryAfter := s.flakyAttempt(req.Key)
+	if attempts <= req.Failures {
+		return api.NewGetFlaky503(retryAfter, api.NewErrorResponse("Service Unavailable")), nil
+	}
+	return api.NewGetFlaky200(api.NewFlakyResponseBody(attempts)), nil
+}
+
+func (s *server) CreateFlaky(r *http.Request, req *api.CreateFlakyReq) (api.CreateFlakyResponse, error) {
+	attempts, retryAfter := s.flakyAttempt(req.Key)
+	if attempts <= req.Failures {
+		return api.NewCreateFlaky503(retryAfter, api.NewErrorResponse("Service Unavailable")), nil
+	}
+	return api.NewCreateFlaky200(api.NewFlakyResponseBody(attempts)), nil
+}
+
+// SubmitFlaky counts the requests that reach it, the ones replayed from the idempotency store are not.
+func (s *server) SubmitFlaky(r *http.Request, req *api.SubmitFlakyReq) (api.SubmitFlakyResponse, error) {
+	attempts, retryAfter := s.flakyAttempt(req.Key)
+	if attempts <= req.Failures {
+		return api.NewSubmitFlaky503(retryAfter, api.NewErrorResponse("Service Unavailable")), nil
 	}
+	return api.NewSubmitFlaky200(api.NewFlakyResponseBody(attempts)), nil
+}
+
+func (s *server) DeleteUser(r *http.Request, req *api.DeleteUserReq) (api.DeleteUserResponse, error) {
+	return api.NewDeleteUser200(
+		api.NewDeleteUserResponseBody(string(req.AuthAlternative), req.UserId),
+	), nil
+}
 
+func (s *server) WhoAmI(r *http.Request, req *api.WhoAmIReq) (api.WhoAmIResponse, error) {
 	if req.APIKeyAuth != "valid" {
 		debugMsg := "Invalid API key"
 		fmt.Printf("Error handling WhoAmI request: %s\n", debugMsg)
-		req.Write400(w)
-		return
+		return api.NewWhoAmI400(), nil
 	}
 
 	if req.SessionTokenAuth != "valid" {
 		debugMsg := "Invalid Session token"
 		fmt.Printf("Error handling WhoAmI request: %s\n", debugMsg)
-		req.Write400(w)
-		return
+		return api.NewWhoAmI400(), nil
 	}
 
 	userIdBytes, err := io.ReadAll(r.Body)
 	if err != nil {
 		debugMsg := err.Error()
 		fmt.Printf("Error reading WhoAmI request body: %s\n", debugMsg)
-		req.Write400(w)
-		return
+		return api.NewWhoAmI400(), nil
 	}
 
-	req.Write200(w, api.NewWhoAmI200(10)) // To write the status code
-	w.Write(userIdBytes)                  // Write the raw body
+	resp := api.NewWhoAmI200(10)
+	resp.RawBody = bytes.NewReader(userIdBytes) // Echo the raw body back
+	return resp, nil
 }
 
 func stdErr(exit bool, format string, a ...any) {
@@ -400,9 +560,15 @@ func stdErr(exit bool, format string, a ...any) {
 }
 
 func mapToApiUser(user User) *api.User {
-	u := api.NewUser(user.Email, user.IsActive, user.ID, user.Name)
+	u := api.NewUser(user.CreatedAt, user.Email, user.IsActive, user.ID, user.Name)
 	if user.Age != nil {
 		u.WithAge(*user.Age)
 	}
+	if user.UpdatedBy != "" {
+		u.WithUpdatedBy(user.UpdatedBy)
+	}
+	if user.Address != nil {
+		u.WithAddress(*user.Address)
+	}
 	return u
 }
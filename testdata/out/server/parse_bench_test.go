@@ -0,0 +1,80 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/nbrglm/napiway/testdata/out/server/api"
+)
+
+// Compares decoding request bodies through map[string]any and the Parse<Schema> functions (map)
+// with decoding them straight into the generated structs through UnmarshalJSON (struct).
+//
+//	go test -run '^$' -bench . -benchmem
+
+var createUserBody = []byte(`{
+	"UserName": "John Doe",
+	"Email": "john.doe@example.com",
+	"Age": 30,
+	"Status": "ACTIVE",
+	"OptionalStatus": "INACTIVE_USER",
+	"ArbitraryData": {"source": "benchmark", "tags": ["a", "b"], "score": 4.5}
+}`)
+
+var listUsersBody = func() []byte {
+	users := make([]string, 0, 100)
+	for i := range 100 {
+		users = append(users, fmt.Sprintf(`{"CreatedAt": "2024-01-01T00:00:00Z", "UserId": "user-%d", "UserName": "User %d", "Email": "user%d@example.com", "IsActive": %t, "Age": %d}`, i, i, i, i%2 == 0, 20+i))
+	}
+	return []byte(`{"PageNumber": 1, "PageSize": 100, "TotalCount": 1000, "Users": [` + strings.Join(users, ",") + `]}`)
+}()
+
+func BenchmarkDecodeCreateUserRequestBody(b *testing.B) {
+	b.Run("map", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			var data map[string]any
+			if err := json.Unmarshal(createUserBody, &data); err != nil {
+				b.Fatal(err)
+			}
+			if _, err := api.ParseCreateUserRequestBody(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("struct", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			var body api.CreateUserRequestBody
+			if err := json.Unmarshal(createUserBody, &body); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkDecodeListUsersResponseBody(b *testing.B) {
+	b.Run("map", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			var data map[string]any
+			if err := json.Unmarshal(listUsersBody, &data); err != nil {
+				b.Fatal(err)
+			}
+			if _, err := api.ParseListUsersResponseBody(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("struct", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			var body api.ListUsersResponseBody
+			if err := json.Unmarshal(listUsersBody, &body); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -12,6 +12,8 @@ var AGE1 = 28
 
 var users: sdk.User[] = [
   {
+    CreatedAt: "2024-01-01T00:00:00Z",
+    UpdatedBy: "2",
     UserId: "1",
     UserName: "Alice",
     Email: "alice@example.com",
@@ -19,6 +21,7 @@ var users: sdk.User[] = [
     Age: AGE1,
   },
   {
+    CreatedAt: "2024-01-02T00:00:00Z",
     UserId: "2",
     UserName: "Bob",
     Email: "bob@example.com",
@@ -46,8 +49,32 @@ async function runTests() {
 
     await testCreateUser(api);
 
+    await testNotifications(api);
+
+    await testMaps(api);
+
+    await testNestedArrays(api);
+
     await testWhoAmI(api);
 
+    await testGetSession(api);
+
+    await testGetSessionWithTokenProvider(serverAddr);
+
+    await testReceiveWebhook(api, serverAddr);
+
+    await testDefaultCredentials(serverAddr);
+
+    await testDeleteUser(api);
+
+    await testRetry(api);
+
+    await testIdempotencyKey(api, serverAddr);
+
+    await testInterceptors(serverAddr);
+
+    await testPagination(serverAddr);
+
     // print the results
     console.log(JSON.stringify(results, null, 2));
   } catch (e) {
@@ -259,10 +286,17 @@ async function testGetUser(api: sdk.TestingAPI) {
   }
   // No need for a try/catch as an error isn't expected for this case to pass
   const r1 = await api.GetUser(validReq)
-  if (r1.StatusCode == 200 && r1.Response200.Body.UserId == "1")
+  if (!r1.UnknownResponse && r1.StatusCode == 200 && r1.Response200.Body.UserId == "1")
     results["GetUserValidOperation"] = true;
   else
     results["GetUserValidOperation"] = false;
+  if (!r1.UnknownResponse && r1.StatusCode == 200 && r1.Response200.Body.CreatedAt == "2024-01-01T00:00:00Z" && r1.Response200.Body.UpdatedBy == "2")
+    results["GetUserWithInheritedFields"] = true;
+  else
+    results["GetUserWithInheritedFields"] = false;
+  // Address is an inline object, hoisted as UserAddress
+  const address: sdk.UserAddress | undefined = !r1.UnknownResponse && r1.StatusCode == 200 ? r1.Response200.Body.Address : undefined;
+  results["GetUserWithInlineObject"] = address?.City == "Springfield" && address.Geo?.Latitude == 39.78;
 }
 
 async function testCreateUser(api: sdk.TestingAPI) {
@@ -279,7 +313,7 @@ async function testCreateUser(api: sdk.TestingAPI) {
   }
   // No need for a try/catch as an error isn't expected for this case to pass
   const r1 = await api.CreateUser(validWithoutOptionalReq)
-  if (r1.StatusCode == 201 && r1.Response201.Body.User.Email == "test@example.com" && r1.Response201.Body.Status == sdk.UserStatusACTIVE)
+  if (!r1.UnknownResponse && r1.StatusCode == 201 && r1.Response201.Body.User.Email == "test@example.com" && r1.Response201.Body.Status == sdk.UserStatusACTIVE)
     results["CreateUserValidOperationWithoutOptionalField"] = true;
   else
     results["CreateUserValidOperationWithoutOptionalField"] = false;
@@ -299,7 +333,7 @@ async function testCreateUser(api: sdk.TestingAPI) {
   }
   // No need for a try/catch as an error isn't expected for this case to pass
   const r2 = await api.CreateUser(validWithOptionalReq)
-  if (r2.StatusCode == 201 && r2.Response201.Body.User.Age == AGE && r2.Response201.Body.OptionalStatus == sdk.UserStatusINACTIVE_USER)
+  if (!r2.UnknownResponse && r2.StatusCode == 201 && r2.Response201.Body.User.Age == AGE && r2.Response201.Body.OptionalStatus == sdk.UserStatusINACTIVE_USER)
     results["CreateUserValidOperationWithOptionalField"] = true;
   else
     results["CreateUserValidOperationWithOptionalField"] = false;
@@ -319,7 +353,7 @@ async function testCreateUser(api: sdk.TestingAPI) {
   }
   // No need for a try/catch as an error isn't expected for this case to pass
   const r3 = await api.CreateUser(validWithArbitraryDataReq)
-  if (r3.StatusCode == 201 && JSON.stringify(r3.Response201.Body.ArbitraryData) == JSON.stringify(arbitraryData))
+  if (!r3.UnknownResponse && r3.StatusCode == 201 && JSON.stringify(r3.Response201.Body.ArbitraryData) == JSON.stringify(arbitraryData))
     results["CreateUserValidOperationWithArbitraryData"] = true;
   else
     results["CreateUserValidOperationWithArbitraryData"] = false;
@@ -333,11 +367,453 @@ async function testWhoAmI(api: sdk.TestingAPI) {
   }
   // No need for a try/catch as an error isn't expected for this case to pass
   const r1 = await api.WhoAmI(validWithoutOptionalReq, userId)
-  if (r1.StatusCode == 200 && (await r1.Response200.RawBody.text()) == "test@example.com")
+  if (!r1.UnknownResponse && r1.StatusCode == 200 && (await r1.Response200.RawBody.text()) == "test@example.com")
     results["WhoAmIValidRawBody"] = true;
   else
     results["WhoAmIValidRawBody"] = false;
 }
 
+async function testNotifications(api: sdk.TestingAPI) {
+  const r = await api.CreateUser({
+    APIKeyAuth: VALID,
+    AdminTokenAuth: VALID,
+    Body: sdk.createCreateUserRequestBody({
+      UserName: "Test User",
+      Email: "test@example.com",
+      Status: sdk.UserStatusACTIVE,
+      WelcomeNotification: { Channel: "email", Address: "test@example.com", Subject: "Welcome" },
+      Notifications: [
+        { Channel: "sms", PhoneNumber: "+15550100" },
+        { Channel: "email", Address: "other@example.com" },
+      ],
+      NotificationRounds: [
+        [{ Channel: "email", Address: "first@example.com" }],
+        [{ Channel: "sms", PhoneNumber: "+15550101" }, { Channel: "sms", PhoneNumber: "+15550102" }],
+      ],
+    }),
+  });
+  if (r.UnknownResponse || r.StatusCode != 201) {
+    results["NotificationsRoundTrip"] = false;
+    return;
+  }
+  const welcome = r.Response201.Body.WelcomeNotification;
+  const [first, second] = r.Response201.Body.Notifications ?? [];
+  // The Channel narrows each notification to its variant
+  results["NotificationsRoundTrip"] = welcome?.Channel == "email" && welcome.Subject == "Welcome" &&
+    first?.Channel == "sms" && first.PhoneNumber == "+15550100" &&
+    second?.Channel == "email" && second.Address == "other@example.com";
+  const rounds = r.Response201.Body.NotificationRounds ?? [];
+  const last = rounds[1]?.[1];
+  results["NotificationsRoundsRoundTrip"] = rounds.length == 2 && rounds[0]?.[0]?.Channel == "email" &&
+    last?.Channel == "sms" && last.PhoneNumber == "+15550102";
+
+  // A oneOf request body is sent as its variant, and the response body narrowed by its Channel
+  const sent = await api.SendNotification({ Body: { Channel: "email", Address: "test@example.com" } });
+  if (sent.UnknownResponse || sent.StatusCode != 200) {
+    results["NotificationsSendsEmailBody"] = false;
+    return;
+  }
+  const email = sent.Response200.Body;
+  results["NotificationsSendsEmailBody"] = email.Channel == "email" && email.Address == "test@example.com" &&
+    email.Subject == "(no subject)";
+}
+
+async function testMaps(api: sdk.TestingAPI) {
+  const r = await api.CreateUser({
+    APIKeyAuth: VALID,
+    AdminTokenAuth: VALID,
+    Body: sdk.createCreateUserRequestBody({
+      UserName: "Test User",
+      Email: "test@example.com",
+      Status: sdk.UserStatusACTIVE,
+      Labels: { team: "core" },
+      Contacts: { work: { Address: "work@example.com" } },
+    }),
+  });
+  if (!r.UnknownResponse && r.StatusCode == 201)
+    results["MapsRoundTrip"] = r.Response201.Body.Labels?.["team"] == "core" &&
+      r.Response201.Body.Contacts?.["work"]?.Address == "work@example.com";
+  else
+    results["MapsRoundTrip"] = false;
+}
+
+async function testNestedArrays(api: sdk.TestingAPI) {
+  const r = await api.CreateUser({
+    APIKeyAuth: VALID,
+    AdminTokenAuth: VALID,
+    Body: sdk.createCreateUserRequestBody({
+      UserName: "Test User",
+      Email: "test@example.com",
+      Status: sdk.UserStatusACTIVE,
+      Coordinates: [[39.78, -89.65], [40.71, -74.01]],
+    }),
+  });
+  if (!r.UnknownResponse && r.StatusCode == 201)
+    results["NestedArraysRoundTrip"] = r.Response201.Body.Coordinates?.[1]?.[1] == -74.01;
+  else
+    results["NestedArraysRoundTrip"] = false;
+}
+
+async function testGetSession(api: sdk.TestingAPI) {
+  try {
+    await api.GetSession({});
+    results["GetSessionWithMissingCredentials"] = false;
+  } catch (e) {
+    results["GetSessionWithMissingCredentials"] = e instanceof sdk.TestingAPIError && e.reason == sdk.ReasonEncoding;
+  }
+
+  var token = "bearer-token";
+  var cookie = "v1.cookie-session";
+  var queryKey = "query key&=";
+  var cases: [string, sdk.GetSessionReq, string, string][] = [
+    ["ValidOperationWithBearer", { BearerTokenAuth: token }, "BearerToken", token],
+    ["ValidOperationWithBasic", { BasicAuth: { Username: "user", Password: "pass:word" } }, "Basic", "user:pass:word"],
+    ["ValidOperationWithQueryKey", { QueryKeyAuth: queryKey }, "QueryKey", queryKey],
+    ["ValidOperationWithCookie", { SessionCookieAuth: cookie }, "SessionCookie", "cookie-session"],
+    ["WithFormattedQueryKey", { QueryKeyAuth: "qk_" + queryKey }, "QueryKey", queryKey],
+  ];
+  for (const [name, req, authMethod, credential] of cases) {
+    // No need for a try/catch as an error isn't expected for these cases to pass
+    const r = await api.GetSession(req);
+    results["GetSession" + name] = !r.UnknownResponse && r.StatusCode == 200 && r.Response200.Body.AuthMethod == authMethod && r.Response200.Body.Credential == credential;
+  }
+
+  try {
+    await api.GetSession({ BearerTokenAuth: token, SessionCookieAuth: cookie });
+    results["GetSessionWithMultipleCredentialsError"] = false;
+  } catch (e) {
+    results["GetSessionWithMultipleCredentialsError"] = e instanceof sdk.TestingAPIError && e.reason == sdk.ReasonEncoding;
+  }
+
+  var malformed: [string, sdk.GetSessionReq][] = [
+    ["WithMalformedBearerToken", { BearerTokenAuth: "not a token!" }],
+    ["WithMalformedCookie", { SessionCookieAuth: "v2.cookie-session" }],
+  ];
+  for (const [name, req] of malformed) {
+    const r = await api.GetSession(req);
+    results["GetSession" + name] = r.StatusCode == 401;
+  }
+}
+
+/**
+ * Returns a token unknown to the server until it is invalidated, and then the tokens of provider.
+ */
+class StaleTokenProvider implements sdk.TokenProvider {
+  private invalidated = false;
+
+  constructor(private provider: sdk.TokenProvider) {}
+
+  async token(): Promise<string> {
+    return this.invalidated ? this.provider.token() : "stale-token";
+  }
+
+  invalidate(token: string): void {
+    this.invalidated = true;
+    this.provider.invalidate(token);
+  }
+}
+
+async function testGetSessionWithTokenProvider(serverAddr: string) {
+  const newProvider = (clientSecret: string) => {
+    const provider = sdk.newServiceTokenProvider("service-client", clientSecret);
+    provider.tokenURL = new URL("/oauth/token", serverAddr).toString();
+    return provider;
+  };
+
+  const api = new sdk.TestingAPI(serverAddr, { tokenProviders: { Service: newProvider("service-secret") } });
+  const first = await api.GetSession({});
+  const second = await api.GetSession({});
+  results["GetSessionWithTokenProviderValidOperationWithServiceToken"] = !first.UnknownResponse && first.StatusCode == 200 && first.Response200.Body.AuthMethod == "Service";
+  results["GetSessionWithTokenProviderWithCachedServiceToken"] = !first.UnknownResponse && first.StatusCode == 200 &&
+    !second.UnknownResponse && second.StatusCode == 200 && second.Response200.Body.Credential == first.Response200.Body.Credential;
+
+  const staleAPI = new sdk.TestingAPI(serverAddr, { tokenProviders: { Service: new StaleTokenProvider(newProvider("service-secret")) } });
+  const r = await staleAPI.GetSession({});
+  results["GetSessionWithTokenProviderWithRetriedStaleToken"] = !r.UnknownResponse && r.StatusCode == 200 && r.Response200.Body.AuthMethod == "Service";
+
+  try {
+    await new sdk.TestingAPI(serverAddr, { tokenProviders: { Service: newProvider("wrong-secret") } }).GetSession({});
+    results["GetSessionWithTokenProviderWithInvalidClientCredentials"] = false;
+  } catch (e) {
+    results["GetSessionWithTokenProviderWithInvalidClientCredentials"] = e instanceof sdk.TestingAPIError && e.reason == sdk.ReasonAuth;
+  }
+}
+
+async function testReceiveWebhook(api: sdk.TestingAPI, serverAddr: string) {
+  const body = { Event: "user.created" };
+
+  const r = await api.ReceiveWebhook({ WebhookSignatureAuth: { KeyID: "webhook-key", Secret: "webhook-secret" }, Body: body });
+  results["ReceiveWebhookValidOperation"] = !r.UnknownResponse && r.StatusCode == 200 && r.Response200.Body.KeyId == "webhook-key" && r.Response200.Body.Event == body.Event;
+
+  var rejected: [string, sdk.HMACKey][] = [
+    ["WithWrongSecret", { KeyID: "webhook-key", Secret: "wrong-secret" }],
+    ["WithUnknownKey", { KeyID: "unknown-key", Secret: "webhook-secret" }],
+  ];
+  for (const [name, key] of rejected) {
+    const r = await api.ReceiveWebhook({ WebhookSignatureAuth: key, Body: body });
+    results["ReceiveWebhook" + name] = r.StatusCode == 401;
+  }
+
+  try {
+    await api.ReceiveWebhook({ WebhookSignatureAuth: { KeyID: "", Secret: "webhook-secret" }, Body: body });
+    results["ReceiveWebhookWithMissingKey"] = false;
+  } catch (e) {
+    results["ReceiveWebhookWithMissingKey"] = e instanceof sdk.TestingAPIError && e.reason == sdk.ReasonEncoding;
+  }
+
+  // A request signed correctly, but 10 minutes ago, outside of the 5 minutes clock skew window
+  const encoder = new TextEncoder();
+  const toHex = (buffer: ArrayBuffer) => Array.from(new Uint8Array(buffer), (b) => b.toString(16).padStart(2, "0")).join("");
+  const bodyText = JSON.stringify(body);
+  const timestamp = (Math.floor(Date.now() / 1000) - 600).toString();
+  const bodyHash = toHex(await crypto.subtle.digest("SHA-256", encoder.encode(bodyText)));
+  const key = await crypto.subtle.importKey("raw", encoder.encode("webhook-secret"), { name: "HMAC", hash: "SHA-256" }, false, ["sign"]);
+  const signature = toHex(await crypto.subtle.sign("HMAC", key, encoder.encode(`POST\n/webhooks\n${timestamp}\n${bodyHash}`)));
+  const stale = await fetch(new URL("/webhooks", serverAddr), {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+      "X-Signature": signature,
+      "X-Signature-Key-Id": "webhook-key",
+      "X-Signature-Timestamp": timestamp,
+    },
+    body: bodyText,
+  });
+  results["ReceiveWebhookWithStaleTimestamp"] = stale.status == 401;
+}
+
+async function testDefaultCredentials(serverAddr: string) {
+  const api = new sdk.TestingAPI(serverAddr, {
+    auth: {
+      AdminToken: VALID,
+      APIKey: VALID,
+      SessionToken: VALID,
+      WebhookSignature: { KeyID: "webhook-key", Secret: "webhook-secret" },
+      BearerToken: async () => "provided-token",
+    },
+    headers: { "X-Request-Source": "ts-client" },
+    timeout: 5000,
+  });
+
+  const r = await api.ListUsers({});
+  results["DefaultCredentialsValidOperationWithDefaults"] = r.StatusCode == 200;
+
+  const r2 = await api.ListUsers({ APIKeyAuth: INVALID });
+  results["DefaultCredentialsWithOverriddenDefault"] = r2.StatusCode == 400;
+
+  const r3 = await api.GetSession({});
+  results["DefaultCredentialsWithCredentialsProvider"] = !r3.UnknownResponse && r3.StatusCode == 200 && r3.Response200.Body.AuthMethod == "BearerToken" && r3.Response200.Body.Credential == "provided-token";
+
+  // The first alternative of DeleteUser, API key and session token, has defaults
+  const r4 = await api.DeleteUser({ UserId: "user-1" });
+  results["DefaultCredentialsWithDefaultAlternative"] = !r4.UnknownResponse && r4.StatusCode == 200 && r4.Response200.Body.AuthAlternative == "apiKeyAuth+sessionTokenAuth";
+
+  const r5 = await api.ReceiveWebhook({ Body: { Event: "user.created" } });
+  results["DefaultCredentialsWithDefaultWebhookKey"] = !r5.UnknownResponse && r5.StatusCode == 200 && r5.Response200.Body.KeyId == "webhook-key";
+
+  try {
+    await new sdk.TestingAPI(serverAddr, { auth: { BearerToken: async () => { throw new Error("no token available"); } } }).GetSession({});
+    results["DefaultCredentialsWithFailingCredentialsProvider"] = false;
+  } catch (e) {
+    results["DefaultCredentialsWithFailingCredentialsProvider"] = e instanceof sdk.TestingAPIError && e.reason == sdk.ReasonAuth;
+  }
+
+  try {
+    await new sdk.TestingAPI(serverAddr).ListUsers({});
+    results["DefaultCredentialsWithoutDefaultsMissingCredentials"] = false;
+  } catch (e) {
+    results["DefaultCredentialsWithoutDefaultsMissingCredentials"] = e instanceof sdk.TestingAPIError && e.reason == sdk.ReasonEncoding;
+  }
+}
+
+async function testRetry(api: sdk.TestingAPI) {
+  // The server counts the requests per key, so every run uses new keys
+  const run = Date.now().toString(36);
+
+  // GetFlaky is retried up to 4 attempts
+  const r = await api.GetFlaky({ Key: "ts-success-" + run, Failures: 3 });
+  results["RetryRetriedToSuccess"] = !r.UnknownResponse && r.StatusCode == 200 && r.Response200.Body.Attempts == 4;
+
+  const r2 = await api.GetFlaky({ Key: "ts-exhausted-" + run, Failures: 10 });
+  const r3 = await api.GetFlaky({ Key: "ts-exhausted-" + run, Failures: 0 });
+  results["RetryWithExhaustedAttempts"] = r2.StatusCode == 503 && !r3.UnknownResponse && r3.StatusCode == 200 && r3.Response200.Body.Attempts == 5;
+
+  // A Retry-After above the policy's maxBackoff is not waited for
+  const r4 = await api.GetFlaky({ Key: "slow-ts-" + run, Failures: 1 });
+  const r5 = await api.GetFlaky({ Key: "slow-ts-" + run, Failures: 1 });
+  results["RetryWithLongRetryAfter"] = !r4.UnknownResponse && r4.StatusCode == 503 && r4.Response503.RetryAfter == 1 && !r5.UnknownResponse && r5.StatusCode == 200 && r5.Response200.Body.Attempts == 2;
+
+  const r6 = await api.CreateFlaky({ Key: "ts-create-" + run, Failures: 1 });
+  const r7 = await api.CreateFlaky({ Key: "ts-create-" + run, Failures: 1 });
+  results["RetryNonIdempotentNotRetried"] = r6.StatusCode == 503 && !r7.UnknownResponse && r7.StatusCode == 200 && r7.Response200.Body.Attempts == 2;
+}
+
+async function testIdempotencyKey(api: sdk.TestingAPI, serverAddr: string) {
+  const run = Date.now().toString(36);
+
+  // The 503 responses are not stored, so the retries with the same key reach the handler
+  const r = await api.SubmitFlaky({ Key: "ts-submit-" + run, Failures: 2 });
+  results["IdempotencyKeyRetriedWithSameKey"] = !r.UnknownResponse && r.StatusCode == 200 && r.Response200.Body.Attempts == 3;
+
+  const r2 = await api.SubmitFlaky({ Key: "ts-submit-" + run, Failures: 0 });
+  results["IdempotencyKeyNewKeyPerCall"] = !r2.UnknownResponse && r2.StatusCode == 200 && r2.Response200.Body.Attempts == 4;
+
+  const key = "ts-key-" + run;
+  const first = await api.SubmitFlaky({ Key: "ts-replayed-" + run, Failures: 0, IdempotencyKey: key });
+  const second = await api.SubmitFlaky({ Key: "ts-replayed-" + run, Failures: 0, IdempotencyKey: key });
+  results["IdempotencyKeyWithReplayedResponse"] = !first.UnknownResponse && first.StatusCode == 200 && !second.UnknownResponse && second.StatusCode == 200 &&
+    first.Response200.Body.Attempts == 1 && second.Response200.Body.Attempts == 1;
+
+  const reused = await api.SubmitFlaky({ Key: "ts-replayed-" + run, Failures: 1, IdempotencyKey: key });
+  await reused.UnknownResponse?.body?.cancel();
+  results["IdempotencyKeyWithReusedKey"] = reused.StatusCode == 422;
+
+  const missing = await fetch(new URL("/flaky/submit?key=ts-missing-" + run + "&failures=0", serverAddr), { method: "POST" });
+  await missing.body?.cancel();
+  results["IdempotencyKeyWithMissingKey"] = missing.status == 400;
+}
+
+async function testInterceptors(serverAddr: string) {
+  const run = Date.now().toString(36);
+  const api = new sdk.TestingAPI(serverAddr);
+
+  const seen: string[] = [];
+  const removeRequest = api.onRequest((request, route) => {
+    seen.push("request " + route.name + " " + request.method);
+  });
+  const removeResponse = api.onResponse((response, _request, route) => {
+    seen.push("response " + route.name + " " + response.status);
+  });
+  const r = await api.GetFlaky({ Key: "ts-intercepted-" + run, Failures: 1 });
+  results["InterceptorsSeeEveryAttempt"] = r.StatusCode == 200 && seen.join(",") == [
+    "request GetFlaky GET", "response GetFlaky 503", "request GetFlaky GET", "response GetFlaky 200",
+  ].join(",");
+
+  removeRequest();
+  removeResponse();
+  await api.GetFlaky({ Key: "ts-removed-" + run, Failures: 0 });
+  results["InterceptorsRemoved"] = seen.length == 4;
+
+  const errors: string[] = [];
+  api.onError((error, route) => {
+    errors.push(route.name + " " + error.reason + " " + error.message);
+  });
+
+  const controller = new AbortController();
+  controller.abort();
+  try {
+    await api.GetFlaky({ Key: "ts-aborted-" + run, Failures: 0 }, { signal: controller.signal });
+    results["InterceptorsWithAbortedSignal"] = false;
+  } catch (e) {
+    results["InterceptorsWithAbortedSignal"] = e instanceof sdk.TestingAPIError && e.reason == sdk.ReasonTransport &&
+      errors[0] == "GetFlaky transport request aborted";
+  }
+
+  // The request interceptor outlasts the timeout of the call, so the request is sent with an aborted signal
+  const slow = new sdk.TestingAPI(serverAddr);
+  slow.onRequest(() => new Promise<void>((resolve) => setTimeout(resolve, 50)));
+  try {
+    await slow.GetFlaky({ Key: "ts-timeout-" + run, Failures: 0 }, { timeout: 10 });
+    results["InterceptorsWithTimeout"] = false;
+  } catch (e) {
+    results["InterceptorsWithTimeout"] = e instanceof sdk.TestingAPIError && e.reason == sdk.ReasonTransport && e.message == "request timed out";
+  }
+}
+
+async function testPagination(serverAddr: string) {
+  const api = new sdk.TestingAPI(serverAddr, { auth: { APIKey: VALID, AdminToken: VALID } });
+  let requests = 0;
+  api.onRequest(() => {
+    requests++;
+  });
+
+  // The users in a single page, to compare with those of the iterator
+  const all = await api.ListUsers({ PageSize: 100 });
+  if (all.UnknownResponse || all.StatusCode != 200) {
+    throw new Error("ListUsers responded with status " + all.StatusCode);
+  }
+  const want = all.Response200.Body.Users.map((user) => user.UserId);
+
+  // One user per page, so every user takes a request, and the total count ends the iteration
+  requests = 0;
+  let got: string[] = [];
+  for await (const user of api.listUsersAll({ PageSize: 1 })) {
+    got.push(user.UserId);
+  }
+  results["PaginationIteratesAllPages"] = want.length >= 2 && got.join(",") == want.join(",") && requests == want.length;
+
+  // The users of the pages before the first one count towards the total, so no page is requested past it
+  requests = 0;
+  got = [];
+  for await (const user of api.listUsersAll({ PageSize: 1, PageNumber: 1 })) {
+    got.push(user.UserId);
+  }
+  results["PaginationStartsAtParamPage"] = got.join(",") == want.slice(1).join(",") && requests == want.length - 1;
+
+  requests = 0;
+  for await (const _user of api.listUsersAll({ PageSize: 1 })) {
+    break;
+  }
+  results["PaginationStopsWhenBroken"] = requests == 1;
+
+  try {
+    for await (const _user of api.listUsersAll({ APIKeyAuth: INVALID })) {
+    }
+    results["PaginationThrowsErrors"] = false;
+  } catch (e) {
+    results["PaginationThrowsErrors"] = e instanceof sdk.TestingAPIError && e.reason == sdk.ReasonUnexpected;
+  }
+
+  // The server has 5 events, listed 2 per page
+  const wantEvents = ["event-1", "event-2", "event-3", "event-4", "event-5"];
+  const collect = async (events: AsyncGenerator<string, void, undefined>) => {
+    requests = 0;
+    const got: string[] = [];
+    for await (const event of events) {
+      got.push(event);
+    }
+    return got.join(",");
+  };
+
+  results["PaginationIteratesAllOffsets"] = (await collect(api.listEventsAll({ Limit: 2 }))) == wantEvents.join(",") && requests == 3;
+  // From the offset 3, the last page holds the total count
+  results["PaginationStartsAtParamOffset"] = (await collect(api.listEventsAll({ Limit: 2, Offset: 3 }))) == wantEvents.slice(3).join(",") && requests == 1;
+  results["PaginationIteratesAllCursors"] = (await collect(api.listEventFeedAll({ Limit: 2 }))) == wantEvents.join(",") && requests == 3;
+  results["PaginationStartsAtParamCursor"] = (await collect(api.listEventFeedAll({ Limit: 2, Cursor: "3" }))) == wantEvents.slice(3).join(",") && requests == 1;
+}
+
+async function testDeleteUser(api: sdk.TestingAPI) {
+  var userId = "user-1";
+
+  var encodingErrors: [string, sdk.DeleteUserReq][] = [
+    ["WithMissingCredentials", { UserId: userId }],
+    ["WithIncompleteAlternative", { UserId: userId, APIKeyAuth: VALID }],
+    ["WithBothAlternativesError", { UserId: userId, APIKeyAuth: VALID, SessionTokenAuth: VALID, AdminTokenAuth: VALID }],
+  ];
+  for (const [name, req] of encodingErrors) {
+    try {
+      await api.DeleteUser(req);
+      results["DeleteUser" + name] = false;
+    } catch (e) {
+      results["DeleteUser" + name] = e instanceof sdk.TestingAPIError && e.reason == sdk.ReasonEncoding;
+    }
+  }
+
+  var cases: [string, sdk.DeleteUserReq, string][] = [
+    ["ValidOperationAsUser", { UserId: userId, APIKeyAuth: VALID, SessionTokenAuth: VALID }, "apiKeyAuth+sessionTokenAuth"],
+    ["ValidOperationAsAdmin", { UserId: userId, AdminTokenAuth: VALID }, "adminTokenAuth"],
+  ];
+  for (const [name, req, alternative] of cases) {
+    const r = await api.DeleteUser(req);
+    results["DeleteUser" + name] = !r.UnknownResponse && r.StatusCode == 200 && r.Response200.Body.UserId == userId && r.Response200.Body.AuthAlternative == alternative;
+  }
+
+  const r = await api.DeleteUser({ UserId: userId, AdminTokenAuth: "revoked" });
+  results["DeleteUserWithRevokedAdminToken"] = r.StatusCode == 401;
+
+  const r2 = await api.DeleteUser({ UserId: userId, APIKeyAuth: VALID, SessionTokenAuth: "read-only" });
+  results["DeleteUserWithInsufficientScope"] = r2.StatusCode == 403;
+}
+
 // Run the test runner
 runTests();
@@ -2,519 +2,2125 @@
 
 import * as Models from "./models.js";
 export * from "./models.js";
-import { TestingAPIError, ReasonTransport, ReasonEncoding, ReasonUnexpected } from "./models.js";
+import { TestingAPIError, ReasonTransport, ReasonEncoding, ReasonUnexpected, ReasonAuth } from "./models.js";
 
 
 export const AdminTokenAuthKey = "X-App-Admin-Token";
 
 export const APIKeyAuthKey = "X-App-API-Key";
 
+export const BasicAuthKey = "Authorization";
+
+export const BearerTokenAuthKey = "Authorization";
+
+export const QueryKeyAuthKey = "api_key";
+
 export const RefreshTokenAuthKey = "X-App-Refresh-Token";
 
+export const ServiceAuthKey = "X-App-Service-Token";
+
+export const SessionCookieAuthKey = "app_session";
+
 export const SessionTokenAuthKey = "X-App-Session-Token";
 
+export const WebhookSignatureAuthKey = "X-Signature";
+
 
 export const TestingAPIVersion = "1.0.0"
 
+/** The Route of the CreateUser endpoint. */
+export const CreateUserRoute: Models.Route = {
+  name: "CreateUser",
+  method: "POST",
+  path: "/users/new",
+  scopes: [],
+  authMethods: ["adminTokenAuth", "apiKeyAuth"],
+  idempotent: false,
+};
+
+/** The Route of the GetUser endpoint. */
+export const GetUserRoute: Models.Route = {
+  name: "GetUser",
+  method: "GET",
+  path: "/users/{userId}",
+  scopes: [],
+  authMethods: ["apiKeyAuth", "sessionTokenAuth"],
+  idempotent: true,
+};
+
+/** The Route of the ListUsers endpoint. */
+export const ListUsersRoute: Models.Route = {
+  name: "ListUsers",
+  method: "GET",
+  path: "/users",
+  scopes: ["users:read"],
+  authMethods: ["adminTokenAuth", "apiKeyAuth"],
+  idempotent: true,
+};
+
+/** The Route of the LogoutUser endpoint. */
+export const LogoutUserRoute: Models.Route = {
+  name: "LogoutUser",
+  method: "GET",
+  path: "/users/logout",
+  scopes: [],
+  authMethods: ["apiKeyAuth", "refreshTokenAuth", "sessionTokenAuth"],
+  idempotent: true,
+};
+
+/** The Route of the WhoAmI endpoint. */
+export const WhoAmIRoute: Models.Route = {
+  name: "WhoAmI",
+  method: "POST",
+  path: "/users/whoami",
+  scopes: [],
+  authMethods: ["apiKeyAuth", "sessionTokenAuth"],
+  idempotent: false,
+};
+
+/** The Route of the GetSession endpoint. */
+export const GetSessionRoute: Models.Route = {
+  name: "GetSession",
+  method: "GET",
+  path: "/session",
+  scopes: [],
+  authMethods: ["basicAuth", "bearerAuth", "queryKeyAuth", "serviceAuth", "sessionCookieAuth"],
+  idempotent: true,
+};
+
+/** The Route of the ReceiveWebhook endpoint. */
+export const ReceiveWebhookRoute: Models.Route = {
+  name: "ReceiveWebhook",
+  method: "POST",
+  path: "/webhooks",
+  scopes: [],
+  authMethods: ["webhookAuth"],
+  idempotent: false,
+};
+
+/** The Route of the DeleteUser endpoint. */
+export const DeleteUserRoute: Models.Route = {
+  name: "DeleteUser",
+  method: "DELETE",
+  path: "/users/{userId}",
+  scopes: ["users:delete"],
+  authMethods: ["adminTokenAuth", "apiKeyAuth", "sessionTokenAuth"],
+  idempotent: true,
+};
+
+/** The Route of the GetFlaky endpoint. */
+export const GetFlakyRoute: Models.Route = {
+  name: "GetFlaky",
+  method: "GET",
+  path: "/flaky",
+  scopes: [],
+  authMethods: [],
+  idempotent: true,
+};
+
+/** The Route of the CreateFlaky endpoint. */
+export const CreateFlakyRoute: Models.Route = {
+  name: "CreateFlaky",
+  method: "POST",
+  path: "/flaky",
+  scopes: [],
+  authMethods: [],
+  idempotent: false,
+};
+
+/** The Route of the SubmitFlaky endpoint. */
+export const SubmitFlakyRoute: Models.Route = {
+  name: "SubmitFlaky",
+  method: "POST",
+  path: "/flaky/submit",
+  scopes: [],
+  authMethods: [],
+  idempotent: true,
+};
+
+/** The Route of the ListEvents endpoint. */
+export const ListEventsRoute: Models.Route = {
+  name: "ListEvents",
+  method: "GET",
+  path: "/events",
+  scopes: [],
+  authMethods: [],
+  idempotent: true,
+};
+
+/** The Route of the ListEventFeed endpoint. */
+export const ListEventFeedRoute: Models.Route = {
+  name: "ListEventFeed",
+  method: "GET",
+  path: "/events/feed",
+  scopes: [],
+  authMethods: [],
+  idempotent: true,
+};
+
+/** The Route of the SendNotification endpoint. */
+export const SendNotificationRoute: Models.Route = {
+  name: "SendNotification",
+  method: "POST",
+  path: "/notifications",
+  scopes: [],
+  authMethods: [],
+  idempotent: false,
+};
+
+/** The Route of the HealthCheck endpoint. */
+export const HealthCheckRoute: Models.Route = {
+  name: "HealthCheck",
+  method: "GET",
+  path: "/health",
+  scopes: [],
+  authMethods: [],
+  idempotent: true,
+};
+
+/** The Route of every endpoint in the specification. */
+export const Routes: Models.Route[] = [
+  CreateUserRoute,
+  GetUserRoute,
+  ListUsersRoute,
+  LogoutUserRoute,
+  WhoAmIRoute,
+  GetSessionRoute,
+  ReceiveWebhookRoute,
+  DeleteUserRoute,
+  GetFlakyRoute,
+  CreateFlakyRoute,
+  SubmitFlakyRoute,
+  ListEventsRoute,
+  ListEventFeedRoute,
+  SendNotificationRoute,
+  HealthCheckRoute,
+];
+
 export class TestingAPI {
   private baseURL: string;
   private headers: Record<string, string>;
   private fetch: typeof fetch;
+  private timeout?: number;
+  private requestInterceptors: Models.RequestInterceptor[] = [];
+  private responseInterceptors: Models.ResponseInterceptor[] = [];
+  private errorInterceptors: Models.ErrorInterceptor[] = [];
+
+  /** Default credentials, see TestingAPIConfig.auth. */
+  private auth: Models.TestingAPIAuthConfig;
 
-  constructor(baseURL: string, customFetch?: typeof fetch) {
+  /** TokenProviders by auth method ID, see TestingAPIConfig.tokenProviders. */
+  private readonly tokenProviders: Record<string, Models.TokenProvider> = {};
+
+  /**
+   * Creates a client for the API at baseURL, configured with config, or only a custom fetch implementation.
+   */
+  constructor(baseURL: string, config?: Models.TestingAPIConfig | typeof fetch) {
+    if (typeof config === "function") {
+      config = { fetch: config };
+    }
     this.baseURL = baseURL;
-    this.fetch = customFetch || fetch;
+    this.fetch = config?.fetch || fetch;
+    this.timeout = config?.timeout;
     this.headers = {
       'Accept': 'application/json',
-      'User-Agent': 'TestingAPI-TypeScriptSDK/1.0.0'
+      'User-Agent': 'TestingAPI-TypeScriptSDK/1.0.0',
+      ...config?.headers,
     };
+    this.auth = { ...config?.auth };
+    if (config?.tokenProviders?.Service) {
+      this.tokenProviders["serviceAuth"] = config.tokenProviders.Service;
+    }
+  }
+
+  /**
+   * Adds an interceptor called with every request, including retries, before it is sent.
+   * Interceptors are called in the order they are added. Returns a function removing the interceptor.
+   */
+  onRequest(interceptor: Models.RequestInterceptor): () => void {
+    return addInterceptor(this.requestInterceptors, interceptor);
+  }
+
+  /**
+   * Adds an interceptor called with every response, including those of the retried requests, before it is handled.
+   * Interceptors are called in the order they are added. Returns a function removing the interceptor.
+   */
+  onResponse(interceptor: Models.ResponseInterceptor): () => void {
+    return addInterceptor(this.responseInterceptors, interceptor);
+  }
+
+  /**
+   * Adds an interceptor called with the error thrown by a call, before it is thrown.
+   * Interceptors are called in the order they are added. Returns a function removing the interceptor.
+   */
+  onError(interceptor: Models.ErrorInterceptor): () => void {
+    return addInterceptor(this.errorInterceptors, interceptor);
   }
 
-  private addHeaders(request: RequestInit): RequestInit {
+  /**
+   * Applies the default headers of the client to request, and the signal and timeout of options, or the timeout of the client.
+   */
+  private addHeaders(request: RequestInit, options?: Models.RequestOptions): RequestInit {
     request.headers = {...this.headers, ...request.headers };
+    const signals: AbortSignal[] = [];
+    if (options?.signal) {
+      signals.push(options.signal);
+    }
+    const timeout = options?.timeout ?? this.timeout;
+    if (timeout !== undefined) {
+      signals.push(AbortSignal.timeout(timeout));
+    }
+    if (signals.length > 0) {
+      request.signal = signals.length === 1 ? signals[0] : AbortSignal.any(signals);
+    }
     return request;
   }
 
+  /**
+   * Sends a request to route through the interceptors.
+   */
+  private async send(route: Models.Route, url: URL, requestInit: RequestInit): Promise<Response> {
+    let request = new Request(url, requestInit);
+    for (const interceptor of this.requestInterceptors) {
+      request = (await interceptor(request, route)) ?? request;
+    }
+    let response = await this.fetch(request);
+    for (const interceptor of this.responseInterceptors) {
+      response = (await interceptor(response, request, route)) ?? response;
+    }
+    return response;
+  }
+
+  /**
+   * Runs a call to route, and passes the error it throws, as a TestingAPIError, to the error interceptors.
+   *
+   * Errors that are not TestingAPIErrors, thrown by fetch or the interceptors, get ReasonTransport.
+   */
+  private async call<T>(route: Models.Route, options: Models.RequestOptions | undefined, run: () => Promise<T>): Promise<T> {
+    try {
+      return await run();
+    } catch (e) {
+      let error: TestingAPIError;
+      const cause = e instanceof Error ? e : undefined;
+      if (e instanceof TestingAPIError) {
+        error = e;
+      } else if (cause?.name === "TimeoutError") {
+        error = new TestingAPIError(ReasonTransport, "request timed out", cause);
+      } else if (options?.signal?.aborted || cause?.name === "AbortError") {
+        error = new TestingAPIError(ReasonTransport, "request aborted", cause);
+      } else {
+        error = new TestingAPIError(ReasonTransport, "HTTP request failed", cause);
+      }
+      for (const interceptor of this.errorInterceptors) {
+        await interceptor(error, route);
+      }
+      throw error;
+    }
+  }
+
+  /**
+   * Sends the request, and retries it according to policy after a network error or a response with a retryable
+   * status code, until an attempt succeeds or the attempts are exhausted.
+   *
+   * Retries wait for a random backoff up to initialBackoff, doubled for each retry up to maxBackoff,
+   * or for the Retry-After of the response. Responses whose Retry-After exceeds maxBackoff are returned.
+   *
+   * Requests with a stream body, which cannot be replayed, are sent once.
+   */
+  private async fetchWithRetry(route: Models.Route, url: URL, requestInit: RequestInit, policy: RetryPolicy): Promise<Response> {
+    const replayable = !(typeof ReadableStream !== "undefined" && requestInit.body instanceof ReadableStream);
+    let backoff = Math.min(policy.initialBackoff, policy.maxBackoff);
+    for (let attempt = 1; ; attempt++) {
+      const last = attempt >= policy.maxAttempts || !replayable;
+      // Full jitter, see https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/
+      let delay = Math.random() * backoff;
+      try {
+        const response = await this.send(route, url, requestInit);
+        if (last || !policy.statusCodes.includes(response.status)) {
+          return response;
+        }
+        const retryAfter = parseRetryAfter(response.headers.get("Retry-After"));
+        if (retryAfter !== undefined) {
+          if (retryAfter > policy.maxBackoff) {
+            return response;
+          }
+          delay = retryAfter;
+        }
+        await response.body?.cancel();
+      } catch (e) {
+        // fetch rejects with a TypeError on network errors, other errors (e.g. an aborted request) are not retried
+        if (last || !(e instanceof TypeError)) {
+          throw e;
+        }
+      }
+      await sleep(delay, requestInit.signal);
+      backoff = Math.min(backoff * 2, policy.maxBackoff);
+    }
+  }
+
+  /**
+   * Returns the default credential of the client config, calling it if it is a function.
+   */
+  private async defaultCredential<T>(credential: Models.Credential<T>, header: string): Promise<T> {
+    if (typeof credential !== "function") {
+      return credential as T;
+    }
+    try {
+      return await (credential as () => T | Promise<T>)();
+    } catch (e) {
+      throw new TestingAPIError(ReasonAuth, `Failed to obtain default credential for auth parameter ${header}`, e instanceof Error ? e : undefined);
+    }
+  }
+  /**
+   * Returns a token from the TokenProvider of the auth method, and records it in provided.
+   *
+   * Returns undefined if the auth method has no TokenProvider.
+   */
+  private async provideToken(authMethodID: string, header: string, provided: ProvidedToken[]): Promise<string | undefined> {
+    const provider = this.tokenProviders[authMethodID];
+    if (!provider) {
+      return undefined;
+    }
+    let token: string;
+    try {
+      token = await provider.token();
+    } catch (e) {
+      throw new TestingAPIError(ReasonAuth, `Failed to obtain token for auth parameter ${header}`, e instanceof Error ? e : undefined);
+    }
+    provided.push({ provider, header, token });
+    return token;
+  }
+
+  /**
+   * Retries a request once with new tokens if response is 401 Unauthorized and the request carries provided tokens,
+   * in case they were revoked before their expiry. Otherwise, returns response.
+   *
+   * Requests with a stream body, which cannot be replayed, are not retried.
+   */
+  private async retryUnauthorized(route: Models.Route, url: URL, requestInit: RequestInit, response: Response, provided: ProvidedToken[]): Promise<Response> {
+    if (response.status !== 401 || provided.length === 0 || (typeof ReadableStream !== "undefined" && requestInit.body instanceof ReadableStream)) {
+      return response;
+    }
+    const headers = { ...requestInit.headers } as Record<string, string>;
+    for (const p of provided) {
+      p.provider.invalidate(p.token);
+      try {
+        headers[p.header] = `Bearer ${await p.provider.token()}`;
+      } catch {
+        return response;
+      }
+    }
+    await response.body?.cancel();
+    return this.send(route, url, { ...requestInit, headers });
+  }
+
   
   
-  // Throws TestingAPIError, or a network error
-  async CreateUser(params: Models.CreateUserReq): Promise<CreateUserResult> {
-    var result = {} as CreateUserResult;
-
-    var path = "/users/new";
-    
+  /**
+   * CreateUser calls POST /users/new.
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async CreateUser(params: Models.CreateUserReq, options?: Models.RequestOptions): Promise<CreateUserResult> {
+    return this.call(CreateUserRoute, options, async (): Promise<CreateUserResult> => {
+      var path = "/users/new";
+      
 
-    const url = new URL(path, this.baseURL);
-    
+      const url = new URL(path, this.baseURL);
+      
 
-    var requestInit: RequestInit = {
-      method: "POST",
-    };
+      var requestInit: RequestInit = {
+        method: "POST",
+      };
+      
+      
+      requestInit.body = JSON.stringify(params.Body);
+      requestInit.headers = { ...requestInit.headers, "Content-Type": "application/json"};
+      
+      // Copy the params, so the default credentials are not visible to the caller
+      params = { ...params };
+      
+      if (params.AdminTokenAuth == null && this.auth.AdminToken !== undefined) {
+        params.AdminTokenAuth = await this.defaultCredential(this.auth.AdminToken, "X-App-Admin-Token");
+      }
+      
+      if (params.APIKeyAuth == null && this.auth.APIKey !== undefined) {
+        params.APIKeyAuth = await this.defaultCredential(this.auth.APIKey, "X-App-API-Key");
+      }
+      
+      
+      
+      
+      
+      var authAdminToken = paramToString(params.AdminTokenAuth, "auth-header: X-App-Admin-Token", "string", true);
+      
+      
     
+    authAdminToken = formatAuthValue(authAdminToken, "Admin ", "");
     
     
-    var authAdminToken = paramToString(params.AdminTokenAuth, "auth-header: X-App-Admin-Token", "string", true);
     requestInit.headers = {...requestInit.headers, "X-App-Admin-Token": authAdminToken};
     
+
+      
+      
+      var authAPIKey = paramToString(params.APIKeyAuth, "auth-header: X-App-API-Key", "string", true);
+      
+      
     
     
-    var authAPIKey = paramToString(params.APIKeyAuth, "auth-header: X-App-API-Key", "string", true);
     requestInit.headers = {...requestInit.headers, "X-App-API-Key": authAPIKey};
     
-    
-    
-    
-    requestInit.body = JSON.stringify(params.Body);
-    requestInit.headers = { ...requestInit.headers, "Content-Type": "application/json"};
-    
-    const request = new Request(url, this.addHeaders(requestInit));
-    const response = await this.fetch(request);
-    result.StatusCode = response.status;
-    switch (response.status) {
-    
+
       
-      case 201:
-        result.Response201 = await Models.ParseCreateUser201(response)
-        break;
       
-    
       
-      case 400:
-        result.Response400 = await Models.ParseCreateUser400(response)
-        break;
+      this.addHeaders(requestInit, options);
+      const response = await this.send(CreateUserRoute, url, requestInit);
+      switch (response.status) {
       
-    
+        
+        case 201:
+          return { StatusCode: 201, Response201: await Models.ParseCreateUser201(response) };
+        
       
-      // CreateUser413 is a status-code only response
-      // Payload Too Large - the request body exceeds the maximum allowed size
+        
+        case 400:
+          return { StatusCode: 400, Response400: await Models.ParseCreateUser400(response) };
+        
       
-    
+        
+        // CreateUser413 is a status-code only response
+        // Payload Too Large - the request body exceeds the maximum allowed size
+        case 413:
+          await response.body?.cancel();
+          return { StatusCode: 413 };
+        
       
-      case 500:
-        result.Response500 = await Models.ParseCreateUser500(response)
-        break;
+        
+        case 500:
+          return { StatusCode: 500, Response500: await Models.ParseCreateUser500(response) };
+        
       
-    
-      default:
-        result.UnknownResponse = response;
-        break;
-    }
-    return result;
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
   }
   
   
-  // Throws TestingAPIError, or a network error
-  async GetUser(params: Models.GetUserReq): Promise<GetUserResult> {
-    var result = {} as GetUserResult;
-
-    var path = "/users/{userId}";
-    
-    var pathParamUserId = paramToString(params.UserId, "path parameter: userId", "string", true);
-    path = path.replace("{userId}", encodeURIComponent(pathParamUserId));
-    
+  /**
+   * GetUser calls GET /users/{userId}.
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async GetUser(params: Models.GetUserReq, options?: Models.RequestOptions): Promise<GetUserResult> {
+    return this.call(GetUserRoute, options, async (): Promise<GetUserResult> => {
+      var path = "/users/{userId}";
+      
+      var pathParamUserId = paramToString(params.UserId, "path parameter: userId", "string", true);
+      path = path.replace("{userId}", encodeURIComponent(pathParamUserId));
+      
 
-    const url = new URL(path, this.baseURL);
-    
+      const url = new URL(path, this.baseURL);
+      
 
-    var requestInit: RequestInit = {
-      method: "GET",
-    };
-    
+      var requestInit: RequestInit = {
+        method: "GET",
+      };
+      
+      
+      // Copy the params, so the default credentials are not visible to the caller
+      params = { ...params };
+      
+      if (params.APIKeyAuth == null && this.auth.APIKey !== undefined) {
+        params.APIKeyAuth = await this.defaultCredential(this.auth.APIKey, "X-App-API-Key");
+      }
+      
+      if (params.SessionTokenAuth == null && this.auth.SessionToken !== undefined) {
+        params.SessionTokenAuth = await this.defaultCredential(this.auth.SessionToken, "X-App-Session-Token");
+      }
+      
+      
+      
+      
+      
+      var authAPIKey = paramToString(params.APIKeyAuth, "auth-header: X-App-API-Key", "string", true);
+      
+      
     
     
-    var authAPIKey = paramToString(params.APIKeyAuth, "auth-header: X-App-API-Key", "string", true);
     requestInit.headers = {...requestInit.headers, "X-App-API-Key": authAPIKey};
     
+
+      
+      
+      var authSessionToken = paramToString(params.SessionTokenAuth, "auth-header: X-App-Session-Token", "string", true);
+      
+      
     
     
-    var authSessionToken = paramToString(params.SessionTokenAuth, "auth-header: X-App-Session-Token", "string", true);
     requestInit.headers = {...requestInit.headers, "X-App-Session-Token": authSessionToken};
     
-    
-    
-    
-    const request = new Request(url, this.addHeaders(requestInit));
-    const response = await this.fetch(request);
-    result.StatusCode = response.status;
-    switch (response.status) {
-    
+
       
-      case 200:
-        result.Response200 = await Models.ParseGetUser200(response)
-        break;
       
-    
       
-      case 400:
-        result.Response400 = await Models.ParseGetUser400(response)
-        break;
+      this.addHeaders(requestInit, options);
+      const response = await this.fetchWithRetry(GetUserRoute, url, requestInit, {
+        maxAttempts: 3,
+        statusCodes: [503],
+        initialBackoff: 10,
+        maxBackoff: 100,
+      });
+      switch (response.status) {
       
-    
+        
+        case 200:
+          return { StatusCode: 200, Response200: await Models.ParseGetUser200(response) };
+        
       
-      case 404:
-        result.Response404 = await Models.ParseGetUser404(response)
-        break;
+        
+        case 400:
+          return { StatusCode: 400, Response400: await Models.ParseGetUser400(response) };
+        
       
-    
+        
+        case 404:
+          return { StatusCode: 404, Response404: await Models.ParseGetUser404(response) };
+        
       
-      case 500:
-        result.Response500 = await Models.ParseGetUser500(response)
-        break;
+        
+        case 500:
+          return { StatusCode: 500, Response500: await Models.ParseGetUser500(response) };
+        
       
-    
-      default:
-        result.UnknownResponse = response;
-        break;
-    }
-    return result;
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
   }
   
   
-  // Throws TestingAPIError, or a network error
-  async ListUsers(params: Models.ListUsersReq): Promise<ListUsersResult> {
-    var result = {} as ListUsersResult;
-
-    var path = "/users";
-    
+  /**
+   * ListUsers calls GET /users.
+   *
+   * Required scopes: users:read
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async ListUsers(params: Models.ListUsersReq, options?: Models.RequestOptions): Promise<ListUsersResult> {
+    return this.call(ListUsersRoute, options, async (): Promise<ListUsersResult> => {
+      var path = "/users";
+      
 
-    const url = new URL(path, this.baseURL);
-    
-    var queryParamPageNumber = paramToString(params.PageNumber, "query parameter: page", "integer", false);
-    if (queryParamPageNumber != "") {
-      url.searchParams.append("page", queryParamPageNumber);
-    }
-    
-    var queryParamPageSize = paramToString(params.PageSize, "query parameter: pageSize", "integer", false);
-    if (queryParamPageSize != "") {
-      url.searchParams.append("pageSize", queryParamPageSize);
-    }
-    
+      const url = new URL(path, this.baseURL);
+      
+      var queryParamPageNumber = paramToString(params.PageNumber, "query parameter: page", "integer", false);
+      if (queryParamPageNumber != "") {
+        url.searchParams.append("page", queryParamPageNumber);
+      }
+      
+      var queryParamPageSize = paramToString(params.PageSize, "query parameter: pageSize", "integer", false);
+      if (queryParamPageSize != "") {
+        url.searchParams.append("pageSize", queryParamPageSize);
+      }
+      
 
-    var requestInit: RequestInit = {
-      method: "GET",
-    };
+      var requestInit: RequestInit = {
+        method: "GET",
+      };
+      
+      
+      // Copy the params, so the default credentials are not visible to the caller
+      params = { ...params };
+      
+      if (params.AdminTokenAuth == null && this.auth.AdminToken !== undefined) {
+        params.AdminTokenAuth = await this.defaultCredential(this.auth.AdminToken, "X-App-Admin-Token");
+      }
+      
+      if (params.APIKeyAuth == null && this.auth.APIKey !== undefined) {
+        params.APIKeyAuth = await this.defaultCredential(this.auth.APIKey, "X-App-API-Key");
+      }
+      
+      
+      
+      
+      
+      var authAdminToken = paramToString(params.AdminTokenAuth, "auth-header: X-App-Admin-Token", "string", true);
+      
+      
     
+    authAdminToken = formatAuthValue(authAdminToken, "Admin ", "");
     
     
-    var authAdminToken = paramToString(params.AdminTokenAuth, "auth-header: X-App-Admin-Token", "string", true);
     requestInit.headers = {...requestInit.headers, "X-App-Admin-Token": authAdminToken};
     
+
+      
+      
+      var authAPIKey = paramToString(params.APIKeyAuth, "auth-header: X-App-API-Key", "string", true);
+      
+      
     
     
-    var authAPIKey = paramToString(params.APIKeyAuth, "auth-header: X-App-API-Key", "string", true);
     requestInit.headers = {...requestInit.headers, "X-App-API-Key": authAPIKey};
     
-    
-    
-    
-    const request = new Request(url, this.addHeaders(requestInit));
-    const response = await this.fetch(request);
-    result.StatusCode = response.status;
-    switch (response.status) {
-    
+
       
-      case 200:
-        result.Response200 = await Models.ParseListUsers200(response)
-        break;
       
-    
       
-      case 400:
-        result.Response400 = await Models.ParseListUsers400(response)
-        break;
+      this.addHeaders(requestInit, options);
+      const response = await this.fetchWithRetry(ListUsersRoute, url, requestInit, {
+        maxAttempts: 3,
+        statusCodes: [503],
+        initialBackoff: 10,
+        maxBackoff: 100,
+      });
+      switch (response.status) {
       
-    
+        
+        case 200:
+          return { StatusCode: 200, Response200: await Models.ParseListUsers200(response) };
+        
       
-      case 500:
-        result.Response500 = await Models.ParseListUsers500(response)
-        break;
+        
+        case 400:
+          return { StatusCode: 400, Response400: await Models.ParseListUsers400(response) };
+        
       
-    
-      default:
-        result.UnknownResponse = response;
-        break;
-    }
-    return result;
+        
+        case 500:
+          return { StatusCode: 500, Response500: await Models.ParseListUsers500(response) };
+        
+      
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
   }
   
   
-  // Throws TestingAPIError, or a network error
-  async LogoutUser(params: Models.LogoutUserReq): Promise<LogoutUserResult> {
-    var result = {} as LogoutUserResult;
-
-    var path = "/users/logout";
-    
+  /**
+   * LogoutUser calls GET /users/logout.
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async LogoutUser(params: Models.LogoutUserReq, options?: Models.RequestOptions): Promise<LogoutUserResult> {
+    return this.call(LogoutUserRoute, options, async (): Promise<LogoutUserResult> => {
+      var path = "/users/logout";
+      
 
-    const url = new URL(path, this.baseURL);
-    
+      const url = new URL(path, this.baseURL);
+      
 
-    var requestInit: RequestInit = {
-      method: "GET",
-    };
-    
+      var requestInit: RequestInit = {
+        method: "GET",
+      };
+      
+      
+      // Copy the params, so the default credentials are not visible to the caller
+      params = { ...params };
+      
+      if (params.APIKeyAuth == null && this.auth.APIKey !== undefined) {
+        params.APIKeyAuth = await this.defaultCredential(this.auth.APIKey, "X-App-API-Key");
+      }
+      
+      
+      if (params.RefreshTokenAuth == null && params.SessionTokenAuth == null) {
+        // Use the first auth method with a default credential
+        
+        if (this.auth.RefreshToken !== undefined) {
+          params.RefreshTokenAuth = await this.defaultCredential(this.auth.RefreshToken, "X-App-Refresh-Token");
+        
+        } else if (this.auth.SessionToken !== undefined) {
+          params.SessionTokenAuth = await this.defaultCredential(this.auth.SessionToken, "X-App-Session-Token");
+        
+        }
+      }
+      
+      
+      
+      
+      var authAPIKey = paramToString(params.APIKeyAuth, "auth-header: X-App-API-Key", "string", true);
+      
+      
     
     
-    var authAPIKey = paramToString(params.APIKeyAuth, "auth-header: X-App-API-Key", "string", true);
     requestInit.headers = {...requestInit.headers, "X-App-API-Key": authAPIKey};
     
+
+      
+      
+      var numAuthParamsSet = 0;
+      
+      try {
+        
+        var authRefreshToken = paramToString(params.RefreshTokenAuth, "auth-header: X-App-Refresh-Token", "string", true);
+        
+        
     
     
-    var numAuthParamsSet = 0;
-    
+    requestInit.headers = {...requestInit.headers, "X-App-Refresh-Token": authRefreshToken};
     
-    try {
-      var authRefreshToken = paramToString(params.RefreshTokenAuth, "auth-header: X-App-Refresh-Token", "string", true);
-      requestInit.headers = {...requestInit.headers, "X-App-Refresh-Token": authRefreshToken};
-      numAuthParamsSet++;
-    } catch (e) {
-      // do nothing if it's a TestingAPIError, since we mandated the setting of the param to check if it's given or not even though it's a set-one-of auth param.
-      if (!(e instanceof TestingAPIError)) {
-        throw e;
+
+        numAuthParamsSet++;
+      } catch (e) {
+        // do nothing if it's a TestingAPIError, since we mandated the setting of the param to check if it's given or not even though it's a set-one-of auth param.
+        if (!(e instanceof TestingAPIError)) {
+          throw e;
+        }
       }
-    }
+      
+      try {
+        
+        var authSessionToken = paramToString(params.SessionTokenAuth, "auth-header: X-App-Session-Token", "string", true);
+        
+        
     
     
+    requestInit.headers = {...requestInit.headers, "X-App-Session-Token": authSessionToken};
     
-    try {
-      var authSessionToken = paramToString(params.SessionTokenAuth, "auth-header: X-App-Session-Token", "string", true);
-      requestInit.headers = {...requestInit.headers, "X-App-Session-Token": authSessionToken};
-      numAuthParamsSet++;
-    } catch (e) {
-      // do nothing if it's a TestingAPIError, since we mandated the setting of the param to check if it's given or not even though it's a set-one-of auth param.
-      if (!(e instanceof TestingAPIError)) {
-        throw e;
+
+        numAuthParamsSet++;
+      } catch (e) {
+        // do nothing if it's a TestingAPIError, since we mandated the setting of the param to check if it's given or not even though it's a set-one-of auth param.
+        if (!(e instanceof TestingAPIError)) {
+          throw e;
+        }
       }
-    }
-    
-    
+      
 
-    if (numAuthParamsSet != 1) {
-      throw new TestingAPIError(ReasonEncoding, `Exactly one auth param must be set, but ${numAuthParamsSet} were set instead`);
-    }
-    
-    
-    const request = new Request(url, this.addHeaders(requestInit));
-    const response = await this.fetch(request);
-    result.StatusCode = response.status;
-    switch (response.status) {
-    
+      if (numAuthParamsSet != 1) {
+        throw new TestingAPIError(ReasonEncoding, `Exactly one auth param must be set, but ${numAuthParamsSet} were set instead`);
+      }
       
-      case 200:
-        result.Response200 = await Models.ParseLogoutUser200(response)
-        break;
       
-    
+      this.addHeaders(requestInit, options);
+      const response = await this.fetchWithRetry(LogoutUserRoute, url, requestInit, {
+        maxAttempts: 3,
+        statusCodes: [503],
+        initialBackoff: 10,
+        maxBackoff: 100,
+      });
+      switch (response.status) {
       
-      case 400:
-        result.Response400 = await Models.ParseLogoutUser400(response)
-        break;
+        
+        case 200:
+          return { StatusCode: 200, Response200: await Models.ParseLogoutUser200(response) };
+        
       
-    
+        
+        case 400:
+          return { StatusCode: 400, Response400: await Models.ParseLogoutUser400(response) };
+        
       
-      case 500:
-        result.Response500 = await Models.ParseLogoutUser500(response)
-        break;
+        
+        case 500:
+          return { StatusCode: 500, Response500: await Models.ParseLogoutUser500(response) };
+        
       
-    
-      default:
-        result.UnknownResponse = response;
-        break;
-    }
-    return result;
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
   }
   
   
-  // Throws TestingAPIError, or a network error
-  async WhoAmI(params: Models.WhoAmIReq, body: BodyInit): Promise<WhoAmIResult> {
-    var result = {} as WhoAmIResult;
-
-    var path = "/users/whoami";
-    
+  /**
+   * WhoAmI calls POST /users/whoami.
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async WhoAmI(params: Models.WhoAmIReq, body: BodyInit, options?: Models.RequestOptions): Promise<WhoAmIResult> {
+    return this.call(WhoAmIRoute, options, async (): Promise<WhoAmIResult> => {
+      var path = "/users/whoami";
+      
 
-    const url = new URL(path, this.baseURL);
-    
+      const url = new URL(path, this.baseURL);
+      
 
-    var requestInit: RequestInit = {
-      method: "POST",
-    };
-    
+      var requestInit: RequestInit = {
+        method: "POST",
+      };
+      
+      
+      requestInit.body = body;
+      
+      // Copy the params, so the default credentials are not visible to the caller
+      params = { ...params };
+      
+      if (params.APIKeyAuth == null && this.auth.APIKey !== undefined) {
+        params.APIKeyAuth = await this.defaultCredential(this.auth.APIKey, "X-App-API-Key");
+      }
+      
+      if (params.SessionTokenAuth == null && this.auth.SessionToken !== undefined) {
+        params.SessionTokenAuth = await this.defaultCredential(this.auth.SessionToken, "X-App-Session-Token");
+      }
+      
+      
+      
+      
+      
+      var authAPIKey = paramToString(params.APIKeyAuth, "auth-header: X-App-API-Key", "string", true);
+      
+      
     
     
-    var authAPIKey = paramToString(params.APIKeyAuth, "auth-header: X-App-API-Key", "string", true);
     requestInit.headers = {...requestInit.headers, "X-App-API-Key": authAPIKey};
     
+
+      
+      
+      var authSessionToken = paramToString(params.SessionTokenAuth, "auth-header: X-App-Session-Token", "string", true);
+      
+      
     
     
-    var authSessionToken = paramToString(params.SessionTokenAuth, "auth-header: X-App-Session-Token", "string", true);
     requestInit.headers = {...requestInit.headers, "X-App-Session-Token": authSessionToken};
     
+
+      
+      
+      
+      this.addHeaders(requestInit, options);
+      const response = await this.send(WhoAmIRoute, url, requestInit);
+      switch (response.status) {
+      
+        
+        case 200:
+          return { StatusCode: 200, Response200: await Models.ParseWhoAmI200(response) };
+        
+      
+        
+        // WhoAmI400 is a status-code only response
+        // Invalid Request
+        case 400:
+          await response.body?.cancel();
+          return { StatusCode: 400 };
+        
+      
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
+  }
+  
+  
+  /**
+   * GetSession calls GET /session.
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async GetSession(params: Models.GetSessionReq, options?: Models.RequestOptions): Promise<GetSessionResult> {
+    return this.call(GetSessionRoute, options, async (): Promise<GetSessionResult> => {
+      var path = "/session";
+      
+
+      const url = new URL(path, this.baseURL);
+      
+
+      var requestInit: RequestInit = {
+        method: "GET",
+      };
+      
+      
+      // Copy the params, so the default credentials and the tokens set from TokenProviders are not visible to the caller
+      params = { ...params };
+      
+      
+      if (params.BasicAuth == null && params.BearerTokenAuth == null && params.QueryKeyAuth == null && params.ServiceAuth == null && params.SessionCookieAuth == null) {
+        // Use the first auth method with a default credential
+        
+        if (this.auth.Basic !== undefined) {
+          params.BasicAuth = await this.defaultCredential(this.auth.Basic, "Authorization");
+        
+        } else if (this.auth.BearerToken !== undefined) {
+          params.BearerTokenAuth = await this.defaultCredential(this.auth.BearerToken, "Authorization");
+        
+        } else if (this.auth.QueryKey !== undefined) {
+          params.QueryKeyAuth = await this.defaultCredential(this.auth.QueryKey, "api_key");
+        
+        } else if (this.auth.Service !== undefined) {
+          params.ServiceAuth = await this.defaultCredential(this.auth.Service, "X-App-Service-Token");
+        
+        } else if (this.auth.SessionCookie !== undefined) {
+          params.SessionCookieAuth = await this.defaultCredential(this.auth.SessionCookie, "app_session");
+        
+        }
+      }
+      
+      
+      const provided: ProvidedToken[] = [];
+      
+      
+      if (params.BasicAuth == null && params.BearerTokenAuth == null && params.QueryKeyAuth == null && params.ServiceAuth == null && params.SessionCookieAuth == null) {
+        // Use the first auth method with a TokenProvider
+        
+        if (this.tokenProviders["serviceAuth"]) {
+          params.ServiceAuth = await this.provideToken("serviceAuth", "X-App-Service-Token", provided);
+        
+        }
+      }
+      
+      
+      
+      
+      
+      var numAuthParamsSet = 0;
+      
+      try {
+        
+        var authBasic = basicAuthValue(params.BasicAuth, "auth parameter: Basic");
+        
+        
     
     
+    requestInit.headers = {...requestInit.headers, "Authorization": `Basic ${authBasic}`};
     
-    requestInit.body = body;
+
+        numAuthParamsSet++;
+      } catch (e) {
+        // do nothing if it's a TestingAPIError, since we mandated the setting of the param to check if it's given or not even though it's a set-one-of auth param.
+        if (!(e instanceof TestingAPIError)) {
+          throw e;
+        }
+      }
+      
+      try {
+        
+        var authBearerToken = paramToString(params.BearerTokenAuth, "auth-bearer: Authorization", "string", true);
+        
+        
     
-    const request = new Request(url, this.addHeaders(requestInit));
-    const response = await this.fetch(request);
-    result.StatusCode = response.status;
-    switch (response.status) {
     
+    requestInit.headers = {...requestInit.headers, "Authorization": `Bearer ${authBearerToken}`};
+    
+
+        numAuthParamsSet++;
+      } catch (e) {
+        // do nothing if it's a TestingAPIError, since we mandated the setting of the param to check if it's given or not even though it's a set-one-of auth param.
+        if (!(e instanceof TestingAPIError)) {
+          throw e;
+        }
+      }
       
-      case 200:
-        result.Response200 = await Models.ParseWhoAmI200(response)
-        break;
-      
+      try {
+        
+        var authQueryKey = paramToString(params.QueryKeyAuth, "auth-query: api_key", "string", true);
+        
+        
+    
+    authQueryKey = formatAuthValue(authQueryKey, "qk_", "");
     
+    
+    url.searchParams.set("api_key", authQueryKey);
+    
+
+        numAuthParamsSet++;
+      } catch (e) {
+        // do nothing if it's a TestingAPIError, since we mandated the setting of the param to check if it's given or not even though it's a set-one-of auth param.
+        if (!(e instanceof TestingAPIError)) {
+          throw e;
+        }
+      }
       
-      // WhoAmI400 is a status-code only response
-      // Invalid Request
+      try {
+        
+        var authService = paramToString(params.ServiceAuth, "auth-oauth2ClientCredentials: X-App-Service-Token", "string", true);
+        
+        
+    
+    
+    requestInit.headers = {...requestInit.headers, "X-App-Service-Token": `Bearer ${authService}`};
+    
+
+        numAuthParamsSet++;
+      } catch (e) {
+        // do nothing if it's a TestingAPIError, since we mandated the setting of the param to check if it's given or not even though it's a set-one-of auth param.
+        if (!(e instanceof TestingAPIError)) {
+          throw e;
+        }
+      }
       
+      try {
+        
+        var authSessionCookie = paramToString(params.SessionCookieAuth, "auth-cookie: app_session", "string", true);
+        
+        
     
-      default:
-        result.UnknownResponse = response;
-        break;
-    }
-    return result;
+    
+    addCookie(requestInit, "app_session", authSessionCookie);
+    
+
+        numAuthParamsSet++;
+      } catch (e) {
+        // do nothing if it's a TestingAPIError, since we mandated the setting of the param to check if it's given or not even though it's a set-one-of auth param.
+        if (!(e instanceof TestingAPIError)) {
+          throw e;
+        }
+      }
+      
+
+      if (numAuthParamsSet != 1) {
+        throw new TestingAPIError(ReasonEncoding, `Exactly one auth param must be set, but ${numAuthParamsSet} were set instead`);
+      }
+      
+      
+      this.addHeaders(requestInit, options);
+      let response = await this.fetchWithRetry(GetSessionRoute, url, requestInit, {
+        maxAttempts: 3,
+        statusCodes: [503],
+        initialBackoff: 10,
+        maxBackoff: 100,
+      });
+      response = await this.retryUnauthorized(GetSessionRoute, url, requestInit, response, provided);
+      switch (response.status) {
+      
+        
+        case 200:
+          return { StatusCode: 200, Response200: await Models.ParseGetSession200(response) };
+        
+      
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
   }
   
   
-  // Throws TestingAPIError, or a network error
-  async HealthCheck(params: Models.HealthCheckReq): Promise<HealthCheckResult> {
-    var result = {} as HealthCheckResult;
+  /**
+   * ReceiveWebhook calls POST /webhooks.
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async ReceiveWebhook(params: Models.ReceiveWebhookReq, options?: Models.RequestOptions): Promise<ReceiveWebhookResult> {
+    return this.call(ReceiveWebhookRoute, options, async (): Promise<ReceiveWebhookResult> => {
+      var path = "/webhooks";
+      
+
+      const url = new URL(path, this.baseURL);
+      
 
-    var path = "/health";
+      var requestInit: RequestInit = {
+        method: "POST",
+      };
+      
+      
+      requestInit.body = JSON.stringify(params.Body);
+      requestInit.headers = { ...requestInit.headers, "Content-Type": "application/json"};
+      
+      // Copy the params, so the default credentials are not visible to the caller
+      params = { ...params };
+      
+      if (params.WebhookSignatureAuth == null && this.auth.WebhookSignature !== undefined) {
+        params.WebhookSignatureAuth = await this.defaultCredential(this.auth.WebhookSignature, "X-Signature");
+      }
+      
+      
+      
+      // The request is signed once its URL is complete, as the query is signed too
+      let signRequest: (() => Promise<void>) | undefined;
+      
+      
+      var authWebhookSignature = hmacKey(params.WebhookSignatureAuth, "auth parameter: WebhookSignature");
+      
+      
+    
+    
+    signRequest = () => signHMAC(requestInit, url, authWebhookSignature, "SHA-256", "X-Signature", "X-Signature-Key-Id", "X-Signature-Timestamp");
     
 
-    const url = new URL(path, this.baseURL);
+      
+      
+      
+      if (signRequest) {
+        await signRequest();
+      }
+      this.addHeaders(requestInit, options);
+      const response = await this.send(ReceiveWebhookRoute, url, requestInit);
+      switch (response.status) {
+      
+        
+        case 200:
+          return { StatusCode: 200, Response200: await Models.ParseReceiveWebhook200(response) };
+        
+      
+        
+        // ReceiveWebhook413 is a status-code only response
+        // Payload Too Large - the request body exceeds the maximum allowed size
+        case 413:
+          await response.body?.cancel();
+          return { StatusCode: 413 };
+        
+      
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
+  }
+  
+  
+  /**
+   * DeleteUser calls DELETE /users/{userId}.
+   *
+   * Required scopes: users:delete
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async DeleteUser(params: Models.DeleteUserReq, options?: Models.RequestOptions): Promise<DeleteUserResult> {
+    return this.call(DeleteUserRoute, options, async (): Promise<DeleteUserResult> => {
+      var path = "/users/{userId}";
+      
+      var pathParamUserId = paramToString(params.UserId, "path parameter: userId", "string", true);
+      path = path.replace("{userId}", encodeURIComponent(pathParamUserId));
+      
+
+      const url = new URL(path, this.baseURL);
+      
+
+      var requestInit: RequestInit = {
+        method: "DELETE",
+      };
+      
+      
+      // Copy the params, so the default credentials are not visible to the caller
+      params = { ...params };
+      
+      
+      
+      if (params.AdminTokenAuth == null && params.APIKeyAuth == null && params.SessionTokenAuth == null) {
+        // Use the first alternative with default credentials for each of its auth methods
+        
+        if (this.auth.APIKey !== undefined && this.auth.SessionToken !== undefined) {
+          
+          params.APIKeyAuth = await this.defaultCredential(this.auth.APIKey, "X-App-API-Key");
+          
+          params.SessionTokenAuth = await this.defaultCredential(this.auth.SessionToken, "X-App-Session-Token");
+          
+        
+        } else if (this.auth.AdminToken !== undefined) {
+          
+          params.AdminTokenAuth = await this.defaultCredential(this.auth.AdminToken, "X-App-Admin-Token");
+          
+        
+        }
+      }
+      
+      
+      
+      
+      // The auth params that are set must be exactly those of one of the alternatives
+      
+      if (params.APIKeyAuth != null && params.SessionTokenAuth != null && params.AdminTokenAuth == null) {
+        
+        
+        var authAPIKey = paramToString(params.APIKeyAuth, "auth-header: X-App-API-Key", "string", true);
+        
+        
+    
+    
+    requestInit.headers = {...requestInit.headers, "X-App-API-Key": authAPIKey};
     
 
-    var requestInit: RequestInit = {
-      method: "GET",
-    };
+        
+        
+        var authSessionToken = paramToString(params.SessionTokenAuth, "auth-header: X-App-Session-Token", "string", true);
+        
+        
+    
     
+    requestInit.headers = {...requestInit.headers, "X-App-Session-Token": authSessionToken};
     
+
+        
+      
+      } else if (params.AdminTokenAuth != null && params.APIKeyAuth == null && params.SessionTokenAuth == null) {
+        
+        
+        var authAdminToken = paramToString(params.AdminTokenAuth, "auth-header: X-App-Admin-Token", "string", true);
+        
+        
     
+    authAdminToken = formatAuthValue(authAdminToken, "Admin ", "");
     
-    const request = new Request(url, this.addHeaders(requestInit));
-    const response = await this.fetch(request);
-    result.StatusCode = response.status;
-    switch (response.status) {
     
+    requestInit.headers = {...requestInit.headers, "X-App-Admin-Token": authAdminToken};
+    
+
+        
       
-      case 200:
-        result.Response200 = await Models.ParseHealthCheck200(response)
-        break;
+      } else {
+        throw new TestingAPIError(ReasonEncoding, "The auth params must be exactly those of one of: [X-App-API-Key, X-App-Session-Token] or [X-App-Admin-Token]");
+      }
       
-    
-      default:
-        result.UnknownResponse = response;
-        break;
-    }
-    return result;
+      this.addHeaders(requestInit, options);
+      const response = await this.fetchWithRetry(DeleteUserRoute, url, requestInit, {
+        maxAttempts: 3,
+        statusCodes: [503],
+        initialBackoff: 10,
+        maxBackoff: 100,
+      });
+      switch (response.status) {
+      
+        
+        case 200:
+          return { StatusCode: 200, Response200: await Models.ParseDeleteUser200(response) };
+        
+      
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
   }
   
-}
+  
+  /**
+   * GetFlaky calls GET /flaky.
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async GetFlaky(params: Models.GetFlakyReq, options?: Models.RequestOptions): Promise<GetFlakyResult> {
+    return this.call(GetFlakyRoute, options, async (): Promise<GetFlakyResult> => {
+      var path = "/flaky";
+      
 
+      const url = new URL(path, this.baseURL);
+      
+      var queryParamFailures = paramToString(params.Failures, "query parameter: failures", "integer", true);
+      if (queryParamFailures != "") {
+        url.searchParams.append("failures", queryParamFailures);
+      }
+      
+      var queryParamKey = paramToString(params.Key, "query parameter: key", "string", true);
+      if (queryParamKey != "") {
+        url.searchParams.append("key", queryParamKey);
+      }
+      
 
-export type CreateUserResult = {
-  StatusCode: number;
-  
-  
-  Response201: Models.CreateUser201;
-  
-  
-  
-  Response400: Models.CreateUser400;
-  
-  
-  
-  
-  
-  Response500: Models.CreateUser500;
+      var requestInit: RequestInit = {
+        method: "GET",
+      };
+      
+      
+      
+      
+      
+      this.addHeaders(requestInit, options);
+      const response = await this.fetchWithRetry(GetFlakyRoute, url, requestInit, {
+        maxAttempts: 4,
+        statusCodes: [503],
+        initialBackoff: 10,
+        maxBackoff: 100,
+      });
+      switch (response.status) {
+      
+        
+        case 200:
+          return { StatusCode: 200, Response200: await Models.ParseGetFlaky200(response) };
+        
+      
+        
+        case 503:
+          return { StatusCode: 503, Response503: await Models.ParseGetFlaky503(response) };
+        
+      
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
+  }
   
   
-  UnknownResponse: Response;
-};
+  /**
+   * CreateFlaky calls POST /flaky.
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async CreateFlaky(params: Models.CreateFlakyReq, options?: Models.RequestOptions): Promise<CreateFlakyResult> {
+    return this.call(CreateFlakyRoute, options, async (): Promise<CreateFlakyResult> => {
+      var path = "/flaky";
+      
 
-export type GetUserResult = {
-  StatusCode: number;
-  
-  
-  Response200: Models.GetUser200;
-  
-  
-  
-  Response400: Models.GetUser400;
-  
-  
-  
-  Response404: Models.GetUser404;
-  
-  
-  
-  Response500: Models.GetUser500;
-  
-  
-  UnknownResponse: Response;
-};
+      const url = new URL(path, this.baseURL);
+      
+      var queryParamFailures = paramToString(params.Failures, "query parameter: failures", "integer", true);
+      if (queryParamFailures != "") {
+        url.searchParams.append("failures", queryParamFailures);
+      }
+      
+      var queryParamKey = paramToString(params.Key, "query parameter: key", "string", true);
+      if (queryParamKey != "") {
+        url.searchParams.append("key", queryParamKey);
+      }
+      
 
-export type ListUsersResult = {
-  StatusCode: number;
-  
-  
-  Response200: Models.ListUsers200;
-  
-  
-  
-  Response400: Models.ListUsers400;
-  
-  
-  
-  Response500: Models.ListUsers500;
+      var requestInit: RequestInit = {
+        method: "POST",
+      };
+      
+      
+      
+      
+      
+      this.addHeaders(requestInit, options);
+      const response = await this.send(CreateFlakyRoute, url, requestInit);
+      switch (response.status) {
+      
+        
+        case 200:
+          return { StatusCode: 200, Response200: await Models.ParseCreateFlaky200(response) };
+        
+      
+        
+        case 503:
+          return { StatusCode: 503, Response503: await Models.ParseCreateFlaky503(response) };
+        
+      
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
+  }
   
   
-  UnknownResponse: Response;
-};
+  /**
+   * SubmitFlaky calls POST /flaky/submit.
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async SubmitFlaky(params: Models.SubmitFlakyReq, options?: Models.RequestOptions): Promise<SubmitFlakyResult> {
+    return this.call(SubmitFlakyRoute, options, async (): Promise<SubmitFlakyResult> => {
+      var path = "/flaky/submit";
+      
 
-export type LogoutUserResult = {
-  StatusCode: number;
-  
-  
-  Response200: Models.LogoutUser200;
-  
-  
-  
-  Response400: Models.LogoutUser400;
-  
+      const url = new URL(path, this.baseURL);
+      
+      var queryParamFailures = paramToString(params.Failures, "query parameter: failures", "integer", true);
+      if (queryParamFailures != "") {
+        url.searchParams.append("failures", queryParamFailures);
+      }
+      
+      var queryParamKey = paramToString(params.Key, "query parameter: key", "string", true);
+      if (queryParamKey != "") {
+        url.searchParams.append("key", queryParamKey);
+      }
+      
+
+      var requestInit: RequestInit = {
+        method: "POST",
+      };
+      
+      requestInit.headers = { ...requestInit.headers, "Idempotency-Key": params.IdempotencyKey || crypto.randomUUID() };
+      
+      
+      
+      
+      this.addHeaders(requestInit, options);
+      const response = await this.fetchWithRetry(SubmitFlakyRoute, url, requestInit, {
+        maxAttempts: 3,
+        statusCodes: [503],
+        initialBackoff: 10,
+        maxBackoff: 100,
+      });
+      switch (response.status) {
+      
+        
+        case 200:
+          return { StatusCode: 200, Response200: await Models.ParseSubmitFlaky200(response) };
+        
+      
+        
+        case 503:
+          return { StatusCode: 503, Response503: await Models.ParseSubmitFlaky503(response) };
+        
+      
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
+  }
   
   
-  Response500: Models.LogoutUser500;
+  /**
+   * ListEvents calls GET /events.
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async ListEvents(params: Models.ListEventsReq, options?: Models.RequestOptions): Promise<ListEventsResult> {
+    return this.call(ListEventsRoute, options, async (): Promise<ListEventsResult> => {
+      var path = "/events";
+      
+
+      const url = new URL(path, this.baseURL);
+      
+      var queryParamLimit = paramToString(params.Limit, "query parameter: limit", "integer", false);
+      if (queryParamLimit != "") {
+        url.searchParams.append("limit", queryParamLimit);
+      }
+      
+      var queryParamOffset = paramToString(params.Offset, "query parameter: offset", "integer", false);
+      if (queryParamOffset != "") {
+        url.searchParams.append("offset", queryParamOffset);
+      }
+      
+
+      var requestInit: RequestInit = {
+        method: "GET",
+      };
+      
+      
+      
+      
+      
+      this.addHeaders(requestInit, options);
+      const response = await this.fetchWithRetry(ListEventsRoute, url, requestInit, {
+        maxAttempts: 3,
+        statusCodes: [503],
+        initialBackoff: 10,
+        maxBackoff: 100,
+      });
+      switch (response.status) {
+      
+        
+        case 200:
+          return { StatusCode: 200, Response200: await Models.ParseListEvents200(response) };
+        
+      
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
+  }
   
   
-  UnknownResponse: Response;
-};
+  /**
+   * ListEventFeed calls GET /events/feed.
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async ListEventFeed(params: Models.ListEventFeedReq, options?: Models.RequestOptions): Promise<ListEventFeedResult> {
+    return this.call(ListEventFeedRoute, options, async (): Promise<ListEventFeedResult> => {
+      var path = "/events/feed";
+      
 
-export type WhoAmIResult = {
-  StatusCode: number;
-  
+      const url = new URL(path, this.baseURL);
+      
+      var queryParamCursor = paramToString(params.Cursor, "query parameter: cursor", "string", false);
+      if (queryParamCursor != "") {
+        url.searchParams.append("cursor", queryParamCursor);
+      }
+      
+      var queryParamLimit = paramToString(params.Limit, "query parameter: limit", "integer", false);
+      if (queryParamLimit != "") {
+        url.searchParams.append("limit", queryParamLimit);
+      }
+      
+
+      var requestInit: RequestInit = {
+        method: "GET",
+      };
+      
+      
+      
+      
+      
+      this.addHeaders(requestInit, options);
+      const response = await this.fetchWithRetry(ListEventFeedRoute, url, requestInit, {
+        maxAttempts: 3,
+        statusCodes: [503],
+        initialBackoff: 10,
+        maxBackoff: 100,
+      });
+      switch (response.status) {
+      
+        
+        case 200:
+          return { StatusCode: 200, Response200: await Models.ParseListEventFeed200(response) };
+        
+      
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
+  }
   
-  Response200: Models.WhoAmI200;
   
+  /**
+   * SendNotification calls POST /notifications.
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async SendNotification(params: Models.SendNotificationReq, options?: Models.RequestOptions): Promise<SendNotificationResult> {
+    return this.call(SendNotificationRoute, options, async (): Promise<SendNotificationResult> => {
+      var path = "/notifications";
+      
+
+      const url = new URL(path, this.baseURL);
+      
+
+      var requestInit: RequestInit = {
+        method: "POST",
+      };
+      
+      
+      requestInit.body = JSON.stringify(params.Body);
+      requestInit.headers = { ...requestInit.headers, "Content-Type": "application/json"};
+      
+      
+      
+      
+      this.addHeaders(requestInit, options);
+      const response = await this.send(SendNotificationRoute, url, requestInit);
+      switch (response.status) {
+      
+        
+        case 200:
+          return { StatusCode: 200, Response200: await Models.ParseSendNotification200(response) };
+        
+      
+        
+        case 400:
+          return { StatusCode: 400, Response400: await Models.ParseSendNotification400(response) };
+        
+      
+        
+        // SendNotification413 is a status-code only response
+        // Payload Too Large - the request body exceeds the maximum allowed size
+        case 413:
+          await response.body?.cancel();
+          return { StatusCode: 413 };
+        
+      
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
+  }
   
   
+  /**
+   * HealthCheck calls GET /health.
+   *
+   * Throws TestingAPIError, with ReasonTransport if the request fails, is aborted or times out.
+   */
+  async HealthCheck(params: Models.HealthCheckReq, options?: Models.RequestOptions): Promise<HealthCheckResult> {
+    return this.call(HealthCheckRoute, options, async (): Promise<HealthCheckResult> => {
+      var path = "/health";
+      
+
+      const url = new URL(path, this.baseURL);
+      
+
+      var requestInit: RequestInit = {
+        method: "GET",
+      };
+      
+      
+      
+      
+      
+      this.addHeaders(requestInit, options);
+      const response = await this.fetchWithRetry(HealthCheckRoute, url, requestInit, {
+        maxAttempts: 3,
+        statusCodes: [503],
+        initialBackoff: 10,
+        maxBackoff: 100,
+      });
+      switch (response.status) {
+      
+        
+        case 200:
+          return { StatusCode: 200, Response200: await Models.ParseHealthCheck200(response) };
+        
+      
+        default:
+          return { StatusCode: response.status, UnknownResponse: response };
+      }
+    });
+  }
   
-  UnknownResponse: Response;
+
+  /**
+   * Iterates over the Users of every page of ListUsers, requested by their PageNumber, from the one of params, or 0.
+   *
+   * Each page is requested once the items of the previous one are consumed.
+   *
+   * Throws the errors of ListUsers, and a TestingAPIError with ReasonUnexpected for a page whose response is not a 200.
+   */
+  async *listUsersAll(params: Models.ListUsersReq, options?: Models.RequestOptions): AsyncGenerator<Models.User, void, undefined> {
+    params = { ...params };
+    let position = params.PageNumber ?? 0;
+    // count is the number of items up to the current page, including those of the pages before startPage
+    const startPage = position;
+    let count = 0;
+    while (true) {
+      params.PageNumber = position;
+      const result = await this.ListUsers(params, options);
+      if (result.UnknownResponse || result.StatusCode !== 200) {
+        await result.UnknownResponse?.body?.cancel();
+        throw new TestingAPIError(ReasonUnexpected, `unexpected response status ${result.StatusCode} for a page of ListUsers`);
+      }
+      const body = result.Response200.Body;
+      const items = body.Users;
+      yield* items;
+      if (position === startPage) {
+        // The pages before the first one requested are assumed to be as large as it
+        count = startPage * items.length;
+      }
+      count += items.length;
+      if (items.length === 0 || count >= body.TotalCount) {
+        return;
+      }
+      position++;
+    }
+  }
+
+  /**
+   * Iterates over the Events of every page of ListEvents, requested by the Offset of their first item, from the one of params, or 0.
+   *
+   * Each page is requested once the items of the previous one are consumed.
+   *
+   * Throws the errors of ListEvents, and a TestingAPIError with ReasonUnexpected for a page whose response is not a 200.
+   */
+  async *listEventsAll(params: Models.ListEventsReq, options?: Models.RequestOptions): AsyncGenerator<string, void, undefined> {
+    params = { ...params };
+    let position = params.Offset ?? 0;
+    while (true) {
+      params.Offset = position;
+      const result = await this.ListEvents(params, options);
+      if (result.UnknownResponse || result.StatusCode !== 200) {
+        await result.UnknownResponse?.body?.cancel();
+        throw new TestingAPIError(ReasonUnexpected, `unexpected response status ${result.StatusCode} for a page of ListEvents`);
+      }
+      const body = result.Response200.Body;
+      const items = body.Events;
+      yield* items;
+      position += items.length;
+      if (items.length === 0 || position >= body.TotalCount) {
+        return;
+      }
+    }
+  }
+
+  /**
+   * Iterates over the Events of every page of ListEventFeed, requested with the Cursor returned by the previous page in NextCursor, from the one of params.
+   *
+   * Each page is requested once the items of the previous one are consumed.
+   *
+   * Throws the errors of ListEventFeed, and a TestingAPIError with ReasonUnexpected for a page whose response is not a 200.
+   */
+  async *listEventFeedAll(params: Models.ListEventFeedReq, options?: Models.RequestOptions): AsyncGenerator<string, void, undefined> {
+    params = { ...params };
+    let cursor = params.Cursor;
+    while (true) {
+      params.Cursor = cursor;
+      const result = await this.ListEventFeed(params, options);
+      if (result.UnknownResponse || result.StatusCode !== 200) {
+        await result.UnknownResponse?.body?.cancel();
+        throw new TestingAPIError(ReasonUnexpected, `unexpected response status ${result.StatusCode} for a page of ListEventFeed`);
+      }
+      const body = result.Response200.Body;
+      const items = body.Events;
+      yield* items;
+      if (!body.NextCursor) {
+        return;
+      }
+      cursor = body.NextCursor;
+    }
+  }
+}
+
+
+/**
+ * The result of CreateUser, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type CreateUserResult =
+  | { StatusCode: 201; Response201: Models.CreateUser201; UnknownResponse?: undefined }
+  | { StatusCode: 400; Response400: Models.CreateUser400; UnknownResponse?: undefined }
+  | { StatusCode: 413; UnknownResponse?: undefined }
+  | { StatusCode: 500; Response500: Models.CreateUser500; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+/**
+ * The result of GetUser, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type GetUserResult =
+  | { StatusCode: 200; Response200: Models.GetUser200; UnknownResponse?: undefined }
+  | { StatusCode: 400; Response400: Models.GetUser400; UnknownResponse?: undefined }
+  | { StatusCode: 404; Response404: Models.GetUser404; UnknownResponse?: undefined }
+  | { StatusCode: 500; Response500: Models.GetUser500; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+/**
+ * The result of ListUsers, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type ListUsersResult =
+  | { StatusCode: 200; Response200: Models.ListUsers200; UnknownResponse?: undefined }
+  | { StatusCode: 400; Response400: Models.ListUsers400; UnknownResponse?: undefined }
+  | { StatusCode: 500; Response500: Models.ListUsers500; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+/**
+ * The result of LogoutUser, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type LogoutUserResult =
+  | { StatusCode: 200; Response200: Models.LogoutUser200; UnknownResponse?: undefined }
+  | { StatusCode: 400; Response400: Models.LogoutUser400; UnknownResponse?: undefined }
+  | { StatusCode: 500; Response500: Models.LogoutUser500; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+/**
+ * The result of WhoAmI, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type WhoAmIResult =
+  | { StatusCode: 200; Response200: Models.WhoAmI200; UnknownResponse?: undefined }
+  | { StatusCode: 400; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+/**
+ * The result of GetSession, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type GetSessionResult =
+  | { StatusCode: 200; Response200: Models.GetSession200; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+/**
+ * The result of ReceiveWebhook, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type ReceiveWebhookResult =
+  | { StatusCode: 200; Response200: Models.ReceiveWebhook200; UnknownResponse?: undefined }
+  | { StatusCode: 413; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+/**
+ * The result of DeleteUser, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type DeleteUserResult =
+  | { StatusCode: 200; Response200: Models.DeleteUser200; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+/**
+ * The result of GetFlaky, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type GetFlakyResult =
+  | { StatusCode: 200; Response200: Models.GetFlaky200; UnknownResponse?: undefined }
+  | { StatusCode: 503; Response503: Models.GetFlaky503; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+/**
+ * The result of CreateFlaky, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type CreateFlakyResult =
+  | { StatusCode: 200; Response200: Models.CreateFlaky200; UnknownResponse?: undefined }
+  | { StatusCode: 503; Response503: Models.CreateFlaky503; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+/**
+ * The result of SubmitFlaky, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type SubmitFlakyResult =
+  | { StatusCode: 200; Response200: Models.SubmitFlaky200; UnknownResponse?: undefined }
+  | { StatusCode: 503; Response503: Models.SubmitFlaky503; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+/**
+ * The result of ListEvents, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type ListEventsResult =
+  | { StatusCode: 200; Response200: Models.ListEvents200; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+/**
+ * The result of ListEventFeed, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type ListEventFeedResult =
+  | { StatusCode: 200; Response200: Models.ListEventFeed200; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+/**
+ * The result of SendNotification, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type SendNotificationResult =
+  | { StatusCode: 200; Response200: Models.SendNotification200; UnknownResponse?: undefined }
+  | { StatusCode: 400; Response400: Models.SendNotification400; UnknownResponse?: undefined }
+  | { StatusCode: 413; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+/**
+ * The result of HealthCheck, a union discriminated by StatusCode.
+ *
+ * Responses that are not in the specification have an UnknownResponse, whose body must be consumed or cancelled.
+ * As their StatusCode is any number, check UnknownResponse before StatusCode to narrow the result to a response of the specification.
+ */
+export type HealthCheckResult =
+  | { StatusCode: 200; Response200: Models.HealthCheck200; UnknownResponse?: undefined }
+  | { StatusCode: number; UnknownResponse: Response };
+
+
+
+/**
+ * A TokenProvider that obtains access tokens from a token endpoint with the OAuth2 client credentials grant (RFC 6749, section 4.4).
+ *
+ * Tokens are cached, and obtained again refreshBeforeMs before their expiry.
+ * Concurrent calls to token() share the same token request.
+ */
+export class ClientCredentialsTokenProvider implements Models.TokenProvider {
+  /** How long before its expiry a cached token is replaced, in milliseconds. */
+  refreshBeforeMs = 30_000;
+
+  private fetch: typeof fetch;
+  private cached?: { token: string; expiresAt?: number };
+  private pending?: Promise<string>;
+
+  constructor(
+    public tokenURL: string,
+    public clientID: string,
+    public clientSecret: string,
+    public scopes: string[] = [],
+    customFetch?: typeof fetch,
+  ) {
+    this.fetch = customFetch || fetch;
+  }
+
+  /** Returns the cached access token, or obtains a new one if there is none or it is about to expire. */
+  async token(): Promise<string> {
+    if (this.cached && (this.cached.expiresAt === undefined || Date.now() + this.refreshBeforeMs < this.cached.expiresAt)) {
+      return this.cached.token;
+    }
+    if (!this.pending) {
+      this.pending = this.requestToken().finally(() => {
+        this.pending = undefined;
+      });
+    }
+    return this.pending;
+  }
+
+  /** Discards the cached access token if it is token. */
+  invalidate(token: string): void {
+    if (this.cached?.token === token) {
+      this.cached = undefined;
+    }
+  }
+
+  private async requestToken(): Promise<string> {
+    const form = new URLSearchParams({ grant_type: "client_credentials" });
+    if (this.scopes.length > 0) {
+      form.set("scope", this.scopes.join(" "));
+    }
+    // The client credentials are form-encoded before being used as basic auth credentials (RFC 6749, section 2.3.1).
+    const credentials = `${encodeURIComponent(this.clientID)}:${encodeURIComponent(this.clientSecret)}`;
+    const requestedAt = Date.now();
+    const response = await this.fetch(this.tokenURL, {
+      method: "POST",
+      headers: {
+        "Content-Type": "application/x-www-form-urlencoded",
+        "Accept": "application/json",
+        "Authorization": `Basic ${btoa(credentials)}`,
+      },
+      body: form.toString(),
+    });
+    if (response.status !== 200) {
+      throw new Error(`token endpoint responded with status ${response.status}: ${(await response.text()).trim()}`);
+    }
+    const body = await response.json();
+    if (typeof body.access_token !== "string" || body.access_token === "") {
+      throw new Error("token response has no access_token");
+    }
+    if (body.token_type && String(body.token_type).toLowerCase() !== "bearer") {
+      throw new Error(`unsupported token type ${body.token_type}`);
+    }
+    this.cached = {
+      token: body.access_token,
+      expiresAt: typeof body.expires_in === "number" && body.expires_in > 0 ? requestedAt + body.expires_in * 1000 : undefined,
+    };
+    return body.access_token;
+  }
+}
+
+/**
+ * Returns a ClientCredentialsTokenProvider for the Service auth method,
+ * with the token URL and scopes of the specification.
+ */
+export function newServiceTokenProvider(clientID: string, clientSecret: string, customFetch?: typeof fetch): ClientCredentialsTokenProvider {
+  return new ClientCredentialsTokenProvider("http://localhost:5000/oauth/token", clientID, clientSecret, ["session:read"], customFetch);
+}
+
+/** A token set on a request by provideToken. */
+type ProvidedToken = {
+  provider: Models.TokenProvider;
+  header: string;
+  token: string;
 };
 
-export type HealthCheckResult = {
-  StatusCode: number;
-  
-  
-  Response200: Models.HealthCheck200;
-  
-  
-  UnknownResponse: Response;
+
+/**
+ * Encodes the credentials of a "basic" auth method, without the "Basic " prefix.
+ */
+function basicAuthValue(credentials: Models.BasicAuthCredentials | undefined, paramDescription: string): string {
+  if (!credentials || !credentials.Username) {
+    throw new TestingAPIError(ReasonEncoding, `${paramDescription} username is required but was not provided`);
+  }
+  // btoa only accepts Latin-1, so encode the UTF-8 bytes
+  const bytes = new TextEncoder().encode(`${credentials.Username}:${credentials.Password}`);
+  return btoa(String.fromCharCode(...bytes));
+}
+
+/**
+ * Adds interceptor to interceptors, and returns a function removing it.
+ */
+function addInterceptor<T>(interceptors: T[], interceptor: T): () => void {
+  interceptors.push(interceptor);
+  return () => {
+    const index = interceptors.indexOf(interceptor);
+    if (index >= 0) {
+      interceptors.splice(index, 1);
+    }
+  };
+}
+
+/** The retry policy of an endpoint in the specification, with durations in milliseconds. */
+type RetryPolicy = {
+  /** Maximum number of attempts, including the first one. */
+  maxAttempts: number;
+  /** Response status codes that are retried. */
+  statusCodes: number[];
+  initialBackoff: number;
+  maxBackoff: number;
 };
 
+/**
+ * Waits for ms milliseconds, or rejects with the reason of signal once it is aborted.
+ */
+function sleep(ms: number, signal?: AbortSignal | null): Promise<void> {
+  return new Promise((resolve, reject) => {
+    signal?.throwIfAborted();
+    const onAbort = () => {
+      clearTimeout(timer);
+      reject(signal!.reason);
+    };
+    const timer = setTimeout(() => {
+      signal?.removeEventListener("abort", onAbort);
+      resolve();
+    }, ms);
+    signal?.addEventListener("abort", onAbort, { once: true });
+  });
+}
+
+/**
+ * Parses a Retry-After header, either a number of seconds or an HTTP date, into milliseconds.
+ */
+function parseRetryAfter(value: string | null): number | undefined {
+  if (!value) {
+    return undefined;
+  }
+  if (/^\d+$/.test(value)) {
+    return parseInt(value, 10) * 1000;
+  }
+  const date = Date.parse(value);
+  return isNaN(date) ? undefined : Math.max(date - Date.now(), 0);
+}
+
+
+/**
+ * Checks the signing key of an "hmac" auth method.
+ */
+function hmacKey(key: Models.HMACKey | undefined, paramDescription: string): Models.HMACKey {
+  if (!key || !key.KeyID || !key.Secret) {
+    throw new TestingAPIError(ReasonEncoding, `${paramDescription} key ID and secret are required but were not provided`);
+  }
+  return key;
+}
+
+/**
+ * Signs the request with key: the signature header holds the hex-encoded HMAC of the method, escaped path,
+ * raw query (without the "?"), signing time in Unix seconds and hex-encoded body hash of the request, separated by newlines,
+ * with the ID of the key and the signing time in their own headers.
+ *
+ * The body is read to be hashed, so it is replaced with its bytes.
+ */
+async function signHMAC(requestInit: RequestInit, url: URL, key: Models.HMACKey, algorithm: string, signatureHeader: string, keyIdHeader: string, timestampHeader: string) {
+  const encoder = new TextEncoder();
+  let body = new Uint8Array();
+  if (requestInit.body != null) {
+    body = new Uint8Array(await new Response(requestInit.body).arrayBuffer());
+    requestInit.body = body;
+  }
+  const timestamp = Math.floor(Date.now() / 1000).toString();
+  const bodyHash = toHex(await crypto.subtle.digest(algorithm, body));
+  const payload = `${requestInit.method}\n${url.pathname}\n${url.search.slice(1)}\n${timestamp}\n${bodyHash}`;
+
+  const cryptoKey = await crypto.subtle.importKey("raw", encoder.encode(key.Secret), { name: "HMAC", hash: algorithm }, false, ["sign"]);
+  const signature = toHex(await crypto.subtle.sign("HMAC", cryptoKey, encoder.encode(payload)));
+  requestInit.headers = {...requestInit.headers, [signatureHeader]: signature, [keyIdHeader]: key.KeyID, [timestampHeader]: timestamp};
+}
+
+function toHex(buffer: ArrayBuffer): string {
+  return Array.from(new Uint8Array(buffer), (b) => b.toString(16).padStart(2, "0")).join("");
+}
+
+
+/**
+ * Applies the "{token}" template format of an auth method, given by the text around the placeholder,
+ * to a credential, unless it is already formatted.
+ */
+function formatAuthValue(credential: string, prefix: string, suffix: string): string {
+  if (credential.length > prefix.length + suffix.length && credential.startsWith(prefix) && credential.endsWith(suffix)) {
+    return credential;
+  }
+  return prefix + credential + suffix;
+}
+
+/**
+ * Adds a cookie to the Cookie header of requestInit. Browsers ignore this header, and send their own cookies instead.
+ */
+function addCookie(requestInit: RequestInit, name: string, value: string) {
+  const headers = (requestInit.headers || {}) as Record<string, string>;
+  const cookie = `${name}=${value}`;
+  requestInit.headers = {...headers, "Cookie": headers["Cookie"] ? `${headers["Cookie"]}; ${cookie}` : cookie};
+}
 
 function paramToString(param: any, paramDescription: string, expectedType: string, required: boolean): string {
   if (param === undefined || param === null) {
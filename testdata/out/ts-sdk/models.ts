@@ -21,7 +21,8 @@ export class TestingAPIError extends Error {
 export type TestingAPIErrorReason =
   | "transport"
   | "encoding"
-  | "unexpected";
+  | "unexpected"
+  | "auth";
 
 /** Network/Timeout */
 export const ReasonTransport = "transport";
@@ -32,8 +33,173 @@ export const ReasonEncoding = "encoding";
 /** Non-Spec Response */
 export const ReasonUnexpected = "unexpected";
 
+/** Failed to obtain a default credential of the client config, or a token from a TokenProvider */
+export const ReasonAuth = "auth";
+
+/**
+ * Credentials of a "basic" auth method, sent as "Basic <base64(Username:Password)>".
+ */
+export type BasicAuthCredentials = {
+  Username: string;
+  Password: string;
+};
+
+/**
+ * Signing key of an "hmac" auth method, with which the client signs requests.
+ */
+export type HMACKey = {
+  /** ID of the key, sent with the signature so the server can look up the secret. */
+  KeyID: string;
+  Secret: string;
+};
+
+/**
+ * Configuration of a TestingAPI client.
+ */
+export type TestingAPIConfig = {
+  /** The fetch implementation to send requests with. Defaults to the global fetch. */
+  fetch?: typeof fetch;
+
+  /** Default credentials by auth method, used by the requests that do not set them. */
+  auth?: TestingAPIAuthConfig;
+
+  /**
+   * TokenProviders by oauth2ClientCredentials auth method name. Requests that need the credential and are not given one,
+   * and have no default for it, get a token from the provider, and are retried once with a new token
+   * if the server answers them with 401 Unauthorized.
+   */
+  tokenProviders?: {
+    Service?: TokenProvider;
+  };
+
+  /** Headers sent with every request. Headers set by a request take precedence. */
+  headers?: Record<string, string>;
+
+  /** Timeout of each request, including its retries, in milliseconds. Requests do not time out by default. */
+  timeout?: number;
+};
+
+/**
+ * Options of a single call of a TestingAPI method.
+ */
+export type RequestOptions = {
+  /** Aborts the call, which then throws a TestingAPIError with ReasonTransport. */
+  signal?: AbortSignal;
+
+  /** Timeout of the call, including its retries, in milliseconds. Overrides the timeout of the client config. */
+  timeout?: number;
+};
+
+/**
+ * Describes an endpoint of the specification, as passed to the interceptors of a TestingAPI.
+ */
+export type Route = {
+  /** Name of the endpoint, e.g. "CreateUser". */
+  name: string;
+  method: string;
+  /** Path pattern of the endpoint, e.g. "/users/{userId}". */
+  path: string;
+  /** Authorization scopes required by the endpoint, if any. */
+  scopes: string[];
+  /** IDs of the auth methods of the endpoint in the specification, if any. */
+  authMethods: string[];
+  /** Whether the endpoint is idempotent: its method is GET, PUT or DELETE, or it is marked idempotent in the specification. */
+  idempotent: boolean;
+};
+
+/**
+ * Called with every request of a TestingAPI, including retries, before it is sent.
+ * It can return a new Request to send instead, e.g. with more headers.
+ */
+export type RequestInterceptor = (request: Request, route: Route) => Request | void | Promise<Request | void>;
+
+/**
+ * Called with every response received by a TestingAPI, including those of the retried requests, before it is handled.
+ * It can return a new Response to handle instead.
+ */
+export type ResponseInterceptor = (response: Response, request: Request, route: Route) => Response | void | Promise<Response | void>;
+
+/**
+ * Called with the error thrown by a call of a TestingAPI method, before it is thrown.
+ */
+export type ErrorInterceptor = (error: TestingAPIError, route: Route) => void | Promise<void>;
+
+/**
+ * Supplies the access tokens of an oauth2ClientCredentials auth method to the TestingAPI,
+ * see TestingAPIConfig.tokenProviders.
+ */
+export interface TokenProvider {
+  /** Returns a valid access token, obtaining a new one if needed. */
+  token(): Promise<string>;
+
+  /**
+   * Discards token, if it is still the current one, so that the next call to token() obtains a new one.
+   *
+   * Called when the server answers a request made with token with 401 Unauthorized.
+   */
+  invalidate(token: string): void;
+}
+
+
+/**
+ * A default credential: either the value, or a function returning it, called for each request that needs it.
+ */
+export type Credential<T> = T | (() => T | Promise<T>);
+
+/**
+ * Default credentials of a TestingAPI client, by auth method name.
+ */
+export type TestingAPIAuthConfig = {
+  /** header "X-App-Admin-Token" */
+  AdminToken?: Credential<string>;
+  /** header "X-App-API-Key" */
+  APIKey?: Credential<string>;
+  /** basic "Authorization" */
+  Basic?: Credential<BasicAuthCredentials>;
+  /** bearer "Authorization" */
+  BearerToken?: Credential<string>;
+  /** query "api_key" */
+  QueryKey?: Credential<string>;
+  /** header "X-App-Refresh-Token" */
+  RefreshToken?: Credential<string>;
+  /** oauth2ClientCredentials "X-App-Service-Token" */
+  Service?: Credential<string>;
+  /** cookie "app_session" */
+  SessionCookie?: Credential<string>;
+  /** header "X-App-Session-Token" */
+  SessionToken?: Credential<string>;
+  /** hmac "X-Signature" */
+  WebhookSignature?: Credential<HMACKey>;
+};
+
+
+
+
+
+/**
+ * An entity recording who last updated it.
+ */
+
+export interface AuditedEntity extends Entity {
+  
+  
+  /**
+  * The user who last updated the entity, if any.
+  * Optional
+  * 
+  */
+  UpdatedBy?: string;
+
+  
+}
 
 
+/**
+ * createAuditedEntity creates a new instance of AuditedEntity with required fields as parameters
+ */
+export function createAuditedEntity(props: AuditedEntity): AuditedEntity {
+  return props;
+}
 
 
 /**
@@ -61,6 +227,24 @@ export interface CreateUserRequestBody {
 
   
   
+  /**
+  * The email contacts of the created user, by name, returned by the server in the response body.
+  * Optional
+  * 
+  */
+  Contacts?: Record<string, EmailNotification>;
+
+  
+  
+  /**
+  * Pairs of latitude and longitude, returned by the server in the response body. Just for testing nested array support in the generator.
+  * Optional
+  * 
+  */
+  Coordinates?: number[][];
+
+  
+  
   /**
   * The email address of the user to be created.
   * Required
@@ -70,6 +254,33 @@ export interface CreateUserRequestBody {
 
   
   
+  /**
+  * Labels of the created user, by name, returned by the server in the response body. Just for testing map support in the generator.
+  * Optional
+  * 
+  */
+  Labels?: Record<string, string>;
+
+  
+  
+  /**
+  * Rounds of notifications of the created user, returned by the server in the response body. Just for testing nested arrays of oneOf schemas in the generator.
+  * Optional
+  * 
+  */
+  NotificationRounds?: Notification[][];
+
+  
+  
+  /**
+  * Further notifications of the created user, returned by the server in the response body.
+  * Optional
+  * 
+  */
+  Notifications?: Notification[];
+
+  
+  
   /**
   * An optional status of the user to be created. Just for testing optional enum support in the generator.
   * Optional
@@ -96,6 +307,15 @@ export interface CreateUserRequestBody {
   UserName: string;
 
   
+  
+  /**
+  * The notification sent to the created user, returned by the server in the response body. Just for testing oneOf support in the generator.
+  * Optional
+  * 
+  */
+  WelcomeNotification?: Notification;
+
+  
 }
 
 
@@ -123,6 +343,51 @@ export interface CreateUserResponseBody {
 
   
   
+  /**
+  * The email contacts of the request, if any.
+  * Optional
+  * 
+  */
+  Contacts?: Record<string, EmailNotification>;
+
+  
+  
+  /**
+  * The pairs of latitude and longitude of the request, if any.
+  * Optional
+  * 
+  */
+  Coordinates?: number[][];
+
+  
+  
+  /**
+  * The labels of the request, if any.
+  * Optional
+  * 
+  */
+  Labels?: Record<string, string>;
+
+  
+  
+  /**
+  * The rounds of notifications of the request, if any.
+  * Optional
+  * 
+  */
+  NotificationRounds?: Notification[][];
+
+  
+  
+  /**
+  * The notifications of the request, if any.
+  * Optional
+  * 
+  */
+  Notifications?: Notification[];
+
+  
+  
   /**
   * An optional status of the user. Just for testing optional enum support in the generator.
   * Optional
@@ -149,6 +414,15 @@ export interface CreateUserResponseBody {
   User: User;
 
   
+  
+  /**
+  * The welcome notification of the request, if any.
+  * Optional
+  * 
+  */
+  WelcomeNotification?: Notification;
+
+  
 }
 
 
@@ -161,299 +435,1446 @@ export function createCreateUserResponseBody(props: CreateUserResponseBody): Cre
 
 
 /**
- * Standard error response schema.
+ * Response body for the DeleteUser endpoint.
  */
 
-export interface ErrorResponse {
+export interface DeleteUserResponseBody {
   
   
   /**
-  * A detailed debug message for developers. Only passed if in debug mode.
-  * Optional
-  * 
+  * The auth alternative the request was authorized with, e.g. "adminTokenAuth".
+  * Required
+  *  Must be non-empty
   */
-  DebugMessage?: string;
+  AuthAlternative: string;
 
   
   
   /**
-  * An error message which is user-friendly.
+  * The unique identifier of the deleted user.
   * Required
   *  Must be non-empty
   */
-  ErrorMessage: string;
+  UserId: string;
 
   
 }
 
 
 /**
- * createErrorResponse creates a new instance of ErrorResponse with required fields as parameters
+ * createDeleteUserResponseBody creates a new instance of DeleteUserResponseBody with required fields as parameters
  */
-export function createErrorResponse(props: ErrorResponse): ErrorResponse {
+export function createDeleteUserResponseBody(props: DeleteUserResponseBody): DeleteUserResponseBody {
   return props;
 }
 
 
 /**
- * Response body for the HealthCheck endpoint.
+ * A notification sent by email.
  */
 
-export interface HealthCheckResponseBody {
+export interface EmailNotification {
   
   
   /**
-  * The health status of the API, typically "OK".
+  * The email address the notification is sent to.
   * Required
   *  Must be non-empty
   */
-  Status: string;
+  Address: string;
+
+  
+  
+  /**
+  * The subject of the email.
+  * Optional
+  * 
+  */
+  Subject?: string;
 
   
 }
 
 
 /**
- * createHealthCheckResponseBody creates a new instance of HealthCheckResponseBody with required fields as parameters
+ * createEmailNotification creates a new instance of EmailNotification with required fields as parameters
  */
-export function createHealthCheckResponseBody(props: HealthCheckResponseBody): HealthCheckResponseBody {
+export function createEmailNotification(props: EmailNotification): EmailNotification {
   return props;
 }
 
 
 /**
- * Successful response containing a list of users.
+ * Properties shared by the stored entities. Just for testing extends support in the generator.
  */
 
-export interface ListUsersResponseBody {
+export interface Entity {
   
   
   /**
-  * The current page number.
+  * When the entity was created, in RFC 3339 format.
   * Required
-  * 
+  *  Must be non-empty
   */
-  PageNumber: number;
+  CreatedAt: string;
 
   
-  
-  /**
-  * The number of items per page.
-  * Required
-  * 
-  */
-  PageSize: number;
+}
 
+
+/**
+ * createEntity creates a new instance of Entity with required fields as parameters
+ */
+export function createEntity(props: Entity): Entity {
+  return props;
+}
+
+
+/**
+ * Standard error response schema.
+ */
+
+export interface ErrorResponse {
   
   
   /**
-  * The total number of users available.
-  * Required
+  * A detailed debug message for developers. Only passed if in debug mode.
+  * Optional
   * 
   */
-  TotalCount: number;
+  DebugMessage?: string;
 
   
   
   /**
-  * No description provided
+  * An error message which is user-friendly.
   * Required
-  * 
+  *  Must be non-empty
   */
-  Users: User[];
+  ErrorMessage: string;
 
   
 }
 
 
 /**
- * createListUsersResponseBody creates a new instance of ListUsersResponseBody with required fields as parameters
+ * createErrorResponse creates a new instance of ErrorResponse with required fields as parameters
  */
-export function createListUsersResponseBody(props: ListUsersResponseBody): ListUsersResponseBody {
+export function createErrorResponse(props: ErrorResponse): ErrorResponse {
   return props;
 }
 
 
 /**
- * Response body for the LogoutUser endpoint.
+ * A page of events, requested by a cursor.
  */
 
-export interface LogoutUserResponseBody {
+export interface EventFeed {
   
   
   /**
-  * A message confirming successful logout.
+  * The IDs of the events of the page.
   * Required
-  *  Must be non-empty
+  * 
   */
-  Message: string;
+  Events: string[];
+
+  
+  
+  /**
+  * The cursor of the next page, absent on the last one.
+  * Optional
+  * 
+  */
+  NextCursor?: string;
 
   
 }
 
 
 /**
- * createLogoutUserResponseBody creates a new instance of LogoutUserResponseBody with required fields as parameters
+ * createEventFeed creates a new instance of EventFeed with required fields as parameters
  */
-export function createLogoutUserResponseBody(props: LogoutUserResponseBody): LogoutUserResponseBody {
+export function createEventFeed(props: EventFeed): EventFeed {
   return props;
 }
 
 
 /**
- * Response Schema for GetUser endpoint.
+ * A page of events, requested by the offset of its first event.
  */
 
-export interface User {
-  
-  
-  /**
-  * The age of the user.
-  * Optional
-  * 
-  */
-  Age?: number;
-
+export interface EventPage {
   
   
   /**
-  * The email address of the user.
+  * The IDs of the events of the page.
   * Required
-  *  Must be non-empty
+  * 
   */
-  Email: string;
+  Events: string[];
 
   
   
   /**
-  * Indicates whether the user is active.
+  * The total number of events.
   * Required
   * 
   */
-  IsActive: boolean;
+  TotalCount: number;
 
   
-  
-  /**
-  * The unique identifier of the user.
-  * Required
+}
+
+
+/**
+ * createEventPage creates a new instance of EventPage with required fields as parameters
+ */
+export function createEventPage(props: EventPage): EventPage {
+  return props;
+}
+
+
+/**
+ * Response body for the GetFlaky and CreateFlaky endpoints.
+ */
+
+export interface FlakyResponseBody {
+  
+  
+  /**
+  * The number of requests received for the key, including this one.
+  * Required
+  * 
+  */
+  Attempts: number;
+
+  
+}
+
+
+/**
+ * createFlakyResponseBody creates a new instance of FlakyResponseBody with required fields as parameters
+ */
+export function createFlakyResponseBody(props: FlakyResponseBody): FlakyResponseBody {
+  return props;
+}
+
+
+/**
+ * Response body for the GetSession endpoint.
+ */
+
+export interface GetSessionResponseBody {
+  
+  
+  /**
+  * The name of the authentication method the request was authenticated with.
+  * Required
+  *  Must be non-empty
+  */
+  AuthMethod: string;
+
+  
+  
+  /**
+  * The credential the request was authenticated with. For basic authentication, "username:password".
+  * Required
+  *  Must be non-empty
+  */
+  Credential: string;
+
+  
+}
+
+
+/**
+ * createGetSessionResponseBody creates a new instance of GetSessionResponseBody with required fields as parameters
+ */
+export function createGetSessionResponseBody(props: GetSessionResponseBody): GetSessionResponseBody {
+  return props;
+}
+
+
+/**
+ * Response body for the HealthCheck endpoint.
+ */
+
+export interface HealthCheckResponseBody {
+  
+  
+  /**
+  * The health status of the API, typically "OK".
+  * Required
+  *  Must be non-empty
+  */
+  Status: string;
+
+  
+}
+
+
+/**
+ * createHealthCheckResponseBody creates a new instance of HealthCheckResponseBody with required fields as parameters
+ */
+export function createHealthCheckResponseBody(props: HealthCheckResponseBody): HealthCheckResponseBody {
+  return props;
+}
+
+
+/**
+ * Successful response containing a list of users.
+ */
+
+export interface ListUsersResponseBody {
+  
+  
+  /**
+  * The current page number.
+  * Required
+  * 
+  */
+  PageNumber: number;
+
+  
+  
+  /**
+  * The number of items per page.
+  * Required
+  * 
+  */
+  PageSize: number;
+
+  
+  
+  /**
+  * The total number of users available.
+  * Required
+  * 
+  */
+  TotalCount: number;
+
+  
+  
+  /**
+  * No description provided
+  * Required
+  * 
+  */
+  Users: User[];
+
+  
+}
+
+
+/**
+ * createListUsersResponseBody creates a new instance of ListUsersResponseBody with required fields as parameters
+ */
+export function createListUsersResponseBody(props: ListUsersResponseBody): ListUsersResponseBody {
+  return props;
+}
+
+
+/**
+ * Response body for the LogoutUser endpoint.
+ */
+
+export interface LogoutUserResponseBody {
+  
+  
+  /**
+  * A message confirming successful logout.
+  * Required
+  *  Must be non-empty
+  */
+  Message: string;
+
+  
+}
+
+
+/**
+ * createLogoutUserResponseBody creates a new instance of LogoutUserResponseBody with required fields as parameters
+ */
+export function createLogoutUserResponseBody(props: LogoutUserResponseBody): LogoutUserResponseBody {
+  return props;
+}
+
+
+/**
+ * A notification sent to a user, by email or SMS.
+ */
+
+
+export type Notification =
+  | ({ Channel: "email" } & EmailNotification)
+  | ({ Channel: "sms" } & SmsNotification);
+
+/**
+ * createNotification creates a new instance of Notification with required fields as parameters
+ */
+export function createNotification(props: Notification): Notification {
+  return props;
+}
+
+
+/**
+ * Request body for the ReceiveWebhook endpoint.
+ */
+
+export interface ReceiveWebhookRequestBody {
+  
+  
+  /**
+  * The name of the delivered event.
+  * Required
+  *  Must be non-empty
+  */
+  Event: string;
+
+  
+}
+
+
+/**
+ * createReceiveWebhookRequestBody creates a new instance of ReceiveWebhookRequestBody with required fields as parameters
+ */
+export function createReceiveWebhookRequestBody(props: ReceiveWebhookRequestBody): ReceiveWebhookRequestBody {
+  return props;
+}
+
+
+/**
+ * Response body for the ReceiveWebhook endpoint.
+ */
+
+export interface ReceiveWebhookResponseBody {
+  
+  
+  /**
+  * The name of the received event.
+  * Required
+  *  Must be non-empty
+  */
+  Event: string;
+
+  
+  
+  /**
+  * The ID of the key the delivery was signed with.
+  * Required
+  *  Must be non-empty
+  */
+  KeyId: string;
+
+  
+}
+
+
+/**
+ * createReceiveWebhookResponseBody creates a new instance of ReceiveWebhookResponseBody with required fields as parameters
+ */
+export function createReceiveWebhookResponseBody(props: ReceiveWebhookResponseBody): ReceiveWebhookResponseBody {
+  return props;
+}
+
+
+/**
+ * A notification sent by SMS.
+ */
+
+export interface SmsNotification {
+  
+  
+  /**
+  * The phone number the notification is sent to.
+  * Required
   *  Must be non-empty
   */
-  UserId: string;
-
-  
+  PhoneNumber: string;
+
+  
+}
+
+
+/**
+ * createSmsNotification creates a new instance of SmsNotification with required fields as parameters
+ */
+export function createSmsNotification(props: SmsNotification): SmsNotification {
+  return props;
+}
+
+
+/**
+ * Response Schema for GetUser endpoint.
+ */
+
+export interface User extends AuditedEntity {
+  
+  
+  /**
+  * The postal address of the user, if known. Just for testing inline object support in the generator.
+  * Optional
+  * 
+  */
+  Address?: UserAddress;
+
+  
+  
+  /**
+  * The age of the user.
+  * Optional
+  * 
+  */
+  Age?: number;
+
+  
+  
+  /**
+  * The email address of the user.
+  * Required
+  *  Must be non-empty
+  */
+  Email: string;
+
+  
+  
+  /**
+  * Indicates whether the user is active.
+  * Required
+  * 
+  */
+  IsActive: boolean;
+
+  
+  
+  /**
+  * The unique identifier of the user.
+  * Required
+  *  Must be non-empty
+  */
+  UserId: string;
+
+  
+  
+  /**
+  * The name of the user.
+  * Required
+  *  Must be non-empty
+  */
+  UserName: string;
+
+  
+}
+
+
+/**
+ * createUser creates a new instance of User with required fields as parameters
+ */
+export function createUser(props: User): User {
+  return props;
+}
+
+
+/**
+ * The postal address of the user, if known. Just for testing inline object support in the generator.
+ */
+
+export interface UserAddress {
+  
+  
+  /**
+  * The city.
+  * Required
+  *  Must be non-empty
+  */
+  City: string;
+
+  
+  
+  /**
+  * The coordinates of the address, if known.
+  * Optional
+  * 
+  */
+  Geo?: UserAddressGeo;
+
+  
+  
+  /**
+  * The street and number.
+  * Required
+  *  Must be non-empty
+  */
+  Street: string;
+
+  
+}
+
+
+/**
+ * createUserAddress creates a new instance of UserAddress with required fields as parameters
+ */
+export function createUserAddress(props: UserAddress): UserAddress {
+  return props;
+}
+
+
+/**
+ * The coordinates of the address, if known.
+ */
+
+export interface UserAddressGeo {
+  
+  
+  /**
+  * The latitude, in degrees.
+  * Required
+  * 
+  */
+  Latitude: number;
+
+  
+  
+  /**
+  * The longitude, in degrees.
+  * Required
+  * 
+  */
+  Longitude: number;
+
+  
+}
+
+
+/**
+ * createUserAddressGeo creates a new instance of UserAddressGeo with required fields as parameters
+ */
+export function createUserAddressGeo(props: UserAddressGeo): UserAddressGeo {
+  return props;
+}
+
+
+/**
+ * Enum representing the status of a user.
+ */
+
+
+export type UserStatus =
+  "ACTIVE"
+   | "INACTIVE_USER";
+
+
+export const UserStatusACTIVE = "ACTIVE";
+
+export const UserStatusINACTIVE_USER = "INACTIVE_USER";
+
+
+/**
+ * createUserStatus creates a new instance of UserStatus with required fields as parameters
+ */
+export function createUserStatus(props: UserStatus): UserStatus {
+  return props;
+}
+
+
+
+
+
+const CreateUserReqHTTPMethod = "POST";
+const CreateUserReqRoutePath = "/users/new";
+
+
+/**
+ * Create a new user in the system.
+ */
+
+export type CreateUserReq = {
+
+
+
+
+  // Authentication parameters (all required)
+  
+  /**
+  * Required Authentication Method
+  * Source: header "X-App-Admin-Token"
+  *  Description: Authentication method that denotes an admin token passed in the request header. 
+  *  Format (enforced by the server, applied by the SDK to a bare token): Admin {token} 
+  * If unset, the client uses the default of its config, if any.
+  */
+  AdminTokenAuth?: string;
+  
+  /**
+  * Required Authentication Method
+  * Source: header "X-App-API-Key"
+  *  Description: Authentication method that denotes an API key passed in the request header. 
+  *  Format (documentation only): api_key 
+  * If unset, the client uses the default of its config, if any.
+  */
+  APIKeyAuth?: string;
+  
+
+
+
+
+  /**
+  * Request body
+  */
+  Body: CreateUserRequestBody;
+
+};
+
+
+
+export type CreateUser201 = {
+  
+
+  
+  /**
+  * Response body
+  */
+  Body: CreateUserResponseBody;
+  
+};
+
+export async function ParseCreateUser201(resp: Response): Promise<CreateUser201> {
+  var result = {} as CreateUser201;
+  
+  
+  // Attempt to parse response body as JSON
+  return resp.json().then(
+    body => {
+      result.Body = body as CreateUserResponseBody;
+      return result;
+    },
+    err => {
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for CreateUser201");
+    }
+  );
+  
+  return result;
+}
+
+
+
+export type CreateUser400 = {
+  
+
+  
+  /**
+  * Response body
+  */
+  Body: ErrorResponse;
+  
+};
+
+export async function ParseCreateUser400(resp: Response): Promise<CreateUser400> {
+  var result = {} as CreateUser400;
+  
+  
+  // Attempt to parse response body as JSON
+  return resp.json().then(
+    body => {
+      result.Body = body as ErrorResponse;
+      return result;
+    },
+    err => {
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for CreateUser400");
+    }
+  );
+  
+  return result;
+}
+
+
+
+// CreateUser413 response has no headers or body
+// Payload Too Large - the request body exceeds the maximum allowed size
+
+
+
+export type CreateUser500 = {
+  
+
+  
+  /**
+  * Response body
+  */
+  Body: ErrorResponse;
+  
+};
+
+export async function ParseCreateUser500(resp: Response): Promise<CreateUser500> {
+  var result = {} as CreateUser500;
+  
+  
+  // Attempt to parse response body as JSON
+  return resp.json().then(
+    body => {
+      result.Body = body as ErrorResponse;
+      return result;
+    },
+    err => {
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for CreateUser500");
+    }
+  );
+  
+  return result;
+}
+
+
+
+const GetUserReqHTTPMethod = "GET";
+const GetUserReqRoutePath = "/users/{userId}";
+
+
+/**
+ * Retrieve user information by user ID.
+ */
+
+export type GetUserReq = {
+
+  /**
+  * Source: path parameter "{userId}"
+  
+  * The unique identifier of the user.
+  * 
+  * Required
+  */
+  UserId: string;
+
+
+
+
+
+  // Authentication parameters (all required)
+  
+  /**
+  * Required Authentication Method
+  * Source: header "X-App-API-Key"
+  *  Description: Authentication method that denotes an API key passed in the request header. 
+  *  Format (documentation only): api_key 
+  * If unset, the client uses the default of its config, if any.
+  */
+  APIKeyAuth?: string;
+  
+  /**
+  * Required Authentication Method
+  * Source: header "X-App-Session-Token"
+  *  Description: Authentication method that denotes a session token passed in the request header. 
+  *  Format (documentation only): session_token 
+  * If unset, the client uses the default of its config, if any.
+  */
+  SessionTokenAuth?: string;
+  
+
+
+
+
+};
+
+
+
+export type GetUser200 = {
+  
+
+  
+  /**
+  * Response body
+  */
+  Body: User;
+  
+};
+
+export async function ParseGetUser200(resp: Response): Promise<GetUser200> {
+  var result = {} as GetUser200;
+  
+  
+  // Attempt to parse response body as JSON
+  return resp.json().then(
+    body => {
+      result.Body = body as User;
+      return result;
+    },
+    err => {
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for GetUser200");
+    }
+  );
+  
+  return result;
+}
+
+
+
+export type GetUser400 = {
+  
+
+  
+  /**
+  * Response body
+  */
+  Body: ErrorResponse;
+  
+};
+
+export async function ParseGetUser400(resp: Response): Promise<GetUser400> {
+  var result = {} as GetUser400;
+  
+  
+  // Attempt to parse response body as JSON
+  return resp.json().then(
+    body => {
+      result.Body = body as ErrorResponse;
+      return result;
+    },
+    err => {
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for GetUser400");
+    }
+  );
+  
+  return result;
+}
+
+
+
+export type GetUser404 = {
+  
+
+  
+  /**
+  * Response body
+  */
+  Body: ErrorResponse;
+  
+};
+
+export async function ParseGetUser404(resp: Response): Promise<GetUser404> {
+  var result = {} as GetUser404;
+  
+  
+  // Attempt to parse response body as JSON
+  return resp.json().then(
+    body => {
+      result.Body = body as ErrorResponse;
+      return result;
+    },
+    err => {
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for GetUser404");
+    }
+  );
+  
+  return result;
+}
+
+
+
+export type GetUser500 = {
+  
+
+  
+  /**
+  * Response body
+  */
+  Body: ErrorResponse;
+  
+};
+
+export async function ParseGetUser500(resp: Response): Promise<GetUser500> {
+  var result = {} as GetUser500;
+  
+  
+  // Attempt to parse response body as JSON
+  return resp.json().then(
+    body => {
+      result.Body = body as ErrorResponse;
+      return result;
+    },
+    err => {
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for GetUser500");
+    }
+  );
+  
+  return result;
+}
+
+
+
+const ListUsersReqHTTPMethod = "GET";
+const ListUsersReqRoutePath = "/users";
+
+
+/**
+ * List users with optional pagination.
+ */
+
+export type ListUsersReq = {
+
+
+  /**
+  * Source: query parameter "page"
+  
+  * The page number for pagination. Default = 0.
+  * 
+  * Optional
+  */
+  PageNumber?: number;
+
+
+  /**
+  * Source: query parameter "pageSize"
+  
+  * The number of items per page for pagination. Default = 10.
+  * 
+  * Optional
+  */
+  PageSize?: number;
+
+
+
+
+  // Authentication parameters (all required)
+  
+  /**
+  * Required Authentication Method
+  * Source: header "X-App-Admin-Token"
+  *  Description: Authentication method that denotes an admin token passed in the request header. 
+  *  Format (enforced by the server, applied by the SDK to a bare token): Admin {token} 
+  * If unset, the client uses the default of its config, if any.
+  */
+  AdminTokenAuth?: string;
+  
+  /**
+  * Required Authentication Method
+  * Source: header "X-App-API-Key"
+  *  Description: Authentication method that denotes an API key passed in the request header. 
+  *  Format (documentation only): api_key 
+  * If unset, the client uses the default of its config, if any.
+  */
+  APIKeyAuth?: string;
+  
+
+
+
+
+};
+
+
+
+export type ListUsers200 = {
+  
+  /**
+  * Source: header parameter "X-RateLimit-Remaining"
+  
+  * The number of remaining requests allowed in the current rate limit window.
+  * 
+  * Required
+  */
+  RateLimitRemaining: number;
+
+  
+
+  
+  /**
+  * Response body
+  */
+  Body: ListUsersResponseBody;
+  
+};
+
+export async function ParseListUsers200(resp: Response): Promise<ListUsers200> {
+  var result = {} as ListUsers200;
+  
+  result.RateLimitRemaining = parseintegerParam(resp.headers.get("X-RateLimit-Remaining"), "header: X-RateLimit-Remaining", true)!;
+  
+  
+  // Attempt to parse response body as JSON
+  return resp.json().then(
+    body => {
+      result.Body = body as ListUsersResponseBody;
+      return result;
+    },
+    err => {
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for ListUsers200");
+    }
+  );
+  
+  return result;
+}
+
+
+
+export type ListUsers400 = {
+  
+
+  
+  /**
+  * Response body
+  */
+  Body: ErrorResponse;
+  
+};
+
+export async function ParseListUsers400(resp: Response): Promise<ListUsers400> {
+  var result = {} as ListUsers400;
+  
+  
+  // Attempt to parse response body as JSON
+  return resp.json().then(
+    body => {
+      result.Body = body as ErrorResponse;
+      return result;
+    },
+    err => {
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for ListUsers400");
+    }
+  );
+  
+  return result;
+}
+
+
+
+export type ListUsers500 = {
+  
+
+  
+  /**
+  * Response body
+  */
+  Body: ErrorResponse;
+  
+};
+
+export async function ParseListUsers500(resp: Response): Promise<ListUsers500> {
+  var result = {} as ListUsers500;
+  
+  
+  // Attempt to parse response body as JSON
+  return resp.json().then(
+    body => {
+      result.Body = body as ErrorResponse;
+      return result;
+    },
+    err => {
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for ListUsers500");
+    }
+  );
+  
+  return result;
+}
+
+
+
+const LogoutUserReqHTTPMethod = "GET";
+const LogoutUserReqRoutePath = "/users/logout";
+
+
+/**
+ * Logout the current user.
+ */
+
+export type LogoutUserReq = {
+
+
+
+
+  // Authentication parameters (all required)
+  
+  /**
+  * Required Authentication Method
+  * Source: header "X-App-API-Key"
+  *  Description: Authentication method that denotes an API key passed in the request header. 
+  *  Format (documentation only): api_key 
+  * If unset, the client uses the default of its config, if any.
+  */
+  APIKeyAuth?: string;
+  
+
+
+  // Authentication parameters (at least one required)
+  
+  /**
+  * Source: header "X-App-Refresh-Token"
+  *  Description: Authentication method that denotes a refresh token passed in the request header. 
+  *  Format (documentation only): refresh_token 
+  * If unset, the client uses the default of its config, if any.
+  */
+  RefreshTokenAuth?: string;
+  
+  /**
+  * Source: header "X-App-Session-Token"
+  *  Description: Authentication method that denotes a session token passed in the request header. 
+  *  Format (documentation only): session_token 
+  * If unset, the client uses the default of its config, if any.
+  */
+  SessionTokenAuth?: string;
+  
+
+
+
+};
+
+
+
+export type LogoutUser200 = {
+  
+
+  
+  /**
+  * Response body
+  */
+  Body: LogoutUserResponseBody;
+  
+};
+
+export async function ParseLogoutUser200(resp: Response): Promise<LogoutUser200> {
+  var result = {} as LogoutUser200;
+  
+  
+  // Attempt to parse response body as JSON
+  return resp.json().then(
+    body => {
+      result.Body = body as LogoutUserResponseBody;
+      return result;
+    },
+    err => {
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for LogoutUser200");
+    }
+  );
+  
+  return result;
+}
+
+
+
+export type LogoutUser400 = {
+  
+
+  
+  /**
+  * Response body
+  */
+  Body: ErrorResponse;
+  
+};
+
+export async function ParseLogoutUser400(resp: Response): Promise<LogoutUser400> {
+  var result = {} as LogoutUser400;
+  
+  
+  // Attempt to parse response body as JSON
+  return resp.json().then(
+    body => {
+      result.Body = body as ErrorResponse;
+      return result;
+    },
+    err => {
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for LogoutUser400");
+    }
+  );
+  
+  return result;
+}
+
+
+
+export type LogoutUser500 = {
+  
+
+  
+  /**
+  * Response body
+  */
+  Body: ErrorResponse;
+  
+};
+
+export async function ParseLogoutUser500(resp: Response): Promise<LogoutUser500> {
+  var result = {} as LogoutUser500;
+  
+  
+  // Attempt to parse response body as JSON
+  return resp.json().then(
+    body => {
+      result.Body = body as ErrorResponse;
+      return result;
+    },
+    err => {
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for LogoutUser500");
+    }
+  );
+  
+  return result;
+}
+
+
+
+const WhoAmIReqHTTPMethod = "POST";
+const WhoAmIReqRoutePath = "/users/whoami";
+
+
+/**
+ * Get information about the currently authenticated user. Provide the request body as just a string which is set to the user id.
+ */
+
+export type WhoAmIReq = {
+
+
+
+
+  // Authentication parameters (all required)
+  
+  /**
+  * Required Authentication Method
+  * Source: header "X-App-API-Key"
+  *  Description: Authentication method that denotes an API key passed in the request header. 
+  *  Format (documentation only): api_key 
+  * If unset, the client uses the default of its config, if any.
+  */
+  APIKeyAuth?: string;
   
   /**
-  * The name of the user.
-  * Required
-  *  Must be non-empty
+  * Required Authentication Method
+  * Source: header "X-App-Session-Token"
+  *  Description: Authentication method that denotes a session token passed in the request header. 
+  *  Format (documentation only): session_token 
+  * If unset, the client uses the default of its config, if any.
   */
-  UserName: string;
-
+  SessionTokenAuth?: string;
   
-}
-
 
-/**
- * createUser creates a new instance of User with required fields as parameters
- */
-export function createUser(props: User): User {
-  return props;
-}
 
 
-/**
- * Enum representing the status of a user.
- */
 
+};
 
-export type UserStatus =
-  "ACTIVE"
-   | "INACTIVE_USER";
 
 
-export const UserStatusACTIVE = "ACTIVE";
+export type WhoAmI200 = {
+  
+  /**
+  * Source: header parameter "X-RateLimit-Remaining"
+  
+  * The number of remaining requests allowed in the current rate limit window.
+  * 
+  * Required
+  */
+  RateLimitRemaining: number;
 
-export const UserStatusINACTIVE_USER = "INACTIVE_USER";
+  
 
+  
+  /**
+  * Raw response */
+  RawBody: Response;
+  
+};
 
-/**
- * createUserStatus creates a new instance of UserStatus with required fields as parameters
- */
-export function createUserStatus(props: UserStatus): UserStatus {
-  return props;
+export async function ParseWhoAmI200(resp: Response): Promise<WhoAmI200> {
+  var result = {} as WhoAmI200;
+  
+  result.RateLimitRemaining = parseintegerParam(resp.headers.get("X-RateLimit-Remaining"), "header: X-RateLimit-Remaining", true)!;
+  
+  
+  result.RawBody = resp;
+  
+  return result;
 }
 
 
 
+// WhoAmI400 response has no headers or body
+// Invalid Request
 
 
-const CreateUserReqHTTPMethod = "POST";
-const CreateUserReqRoutePath = "/users/new";
+
+const GetSessionReqHTTPMethod = "GET";
+const GetSessionReqRoutePath = "/session";
 
 
 /**
- * Create a new user in the system.
+ * Get the credential the request was authenticated with.
  */
 
-export type CreateUserReq = {
+export type GetSessionReq = {
 
 
 
 
-  // Authentication parameters (all required)
+
+  // Authentication parameters (at least one required)
   
   /**
-  * Required Authentication Method
-  * Source: header "X-App-Admin-Token"
-  *  Description: Authentication method that denotes an admin token passed in the request header. 
-  *  Format (NOT ENFORCED): admin_token 
+  * Source: basic "Authorization"
+  *  Description: Authentication method that denotes a username and password passed in the Authorization header. 
+  * 
+  * If unset, the client uses the default of its config, if any.
   */
-  AdminTokenAuth: string;
+  BasicAuth?: BasicAuthCredentials;
   
   /**
-  * Required Authentication Method
-  * Source: header "X-App-API-Key"
-  *  Description: Authentication method that denotes an API key passed in the request header. 
-  *  Format (NOT ENFORCED): api_key 
+  * Source: bearer "Authorization"
+  *  Description: Authentication method that denotes a bearer token passed in the Authorization header. 
+  *  Format (enforced by the server): ^[A-Za-z0-9._~+/-]+=*$ 
+  * If unset, the client uses the default of its config, if any.
   */
-  APIKeyAuth: string;
+  BearerTokenAuth?: string;
   
-
-
-
   /**
-  * Request body
+  * Source: query "api_key"
+  *  Description: Authentication method that denotes an API key passed in the query string. 
+  *  Format (enforced by the server, applied by the SDK to a bare token): qk_{token} 
+  * If unset, the client uses the default of its config, if any.
   */
-  Body: CreateUserRequestBody;
+  QueryKeyAuth?: string;
+  
+  /**
+  * Source: oauth2ClientCredentials "X-App-Service-Token"
+  *  Description: Authentication method that denotes an OAuth2 access token of a backend service, obtained with the client credentials grant. 
+  * 
+  * If unset, the client uses the default of its config, or obtains it from its TokenProvider in the config, if any.
+  */
+  ServiceAuth?: string;
+  
+  /**
+  * Source: cookie "app_session"
+  *  Description: Authentication method that denotes a session passed in a cookie. 
+  *  Format (enforced by the server): ^v1\.(?P<token>[A-Za-z0-9-]+)$ 
+  * If unset, the client uses the default of its config, if any.
+  */
+  SessionCookieAuth?: string;
+  
+
+
 
 };
 
 
 
-export type CreateUser201 = {
+export type GetSession200 = {
   
 
   
   /**
   * Response body
   */
-  Body: CreateUserResponseBody;
+  Body: GetSessionResponseBody;
   
 };
 
-export async function ParseCreateUser201(resp: Response): Promise<CreateUser201> {
-  var result = {} as CreateUser201;
+export async function ParseGetSession200(resp: Response): Promise<GetSession200> {
+  var result = {} as GetSession200;
   
   
   // Attempt to parse response body as JSON
   return resp.json().then(
     body => {
-      result.Body = body as CreateUserResponseBody;
+      result.Body = body as GetSessionResponseBody;
       return result;
     },
     err => {
-      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for CreateUser201");
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for GetSession200");
     }
   );
   
@@ -462,29 +1883,66 @@ export async function ParseCreateUser201(resp: Response): Promise<CreateUser201>
 
 
 
-export type CreateUser400 = {
+const ReceiveWebhookReqHTTPMethod = "POST";
+const ReceiveWebhookReqRoutePath = "/webhooks";
+
+
+/**
+ * Receive a webhook delivery signed with the webhook secret.
+ */
+
+export type ReceiveWebhookReq = {
+
+
+
+
+  // Authentication parameters (all required)
+  
+  /**
+  * Required Authentication Method
+  * Source: hmac "X-Signature"
+  *  Description: Authentication method that denotes a webhook delivery signed with a shared secret. 
+  * 
+  * If unset, the client uses the default of its config, if any.
+  */
+  WebhookSignatureAuth?: HMACKey;
+  
+
+
+
+
+  /**
+  * Request body
+  */
+  Body: ReceiveWebhookRequestBody;
+
+};
+
+
+
+export type ReceiveWebhook200 = {
   
 
   
   /**
   * Response body
   */
-  Body: ErrorResponse;
+  Body: ReceiveWebhookResponseBody;
   
 };
 
-export async function ParseCreateUser400(resp: Response): Promise<CreateUser400> {
-  var result = {} as CreateUser400;
+export async function ParseReceiveWebhook200(resp: Response): Promise<ReceiveWebhook200> {
+  var result = {} as ReceiveWebhook200;
   
   
   // Attempt to parse response body as JSON
   return resp.json().then(
     body => {
-      result.Body = body as ErrorResponse;
+      result.Body = body as ReceiveWebhookResponseBody;
       return result;
     },
     err => {
-      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for CreateUser400");
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for ReceiveWebhook200");
     }
   );
   
@@ -493,34 +1951,91 @@ export async function ParseCreateUser400(resp: Response): Promise<CreateUser400>
 
 
 
-// CreateUser413 response has no headers or body
+// ReceiveWebhook413 response has no headers or body
 // Payload Too Large - the request body exceeds the maximum allowed size
 
 
 
-export type CreateUser500 = {
+const DeleteUserReqHTTPMethod = "DELETE";
+const DeleteUserReqRoutePath = "/users/{userId}";
+
+
+/**
+ * Delete a user, either as the user (API key and session token) or as an admin (admin token alone).
+ */
+
+export type DeleteUserReq = {
+
+  /**
+  * Source: path parameter "{userId}"
+  
+  * The unique identifier of the user.
+  * 
+  * Required
+  */
+  UserId: string;
+
+
+
+
+
+
+
+  // Authentication parameters (exactly those of one of: [X-App-API-Key, X-App-Session-Token] or [X-App-Admin-Token])
+  
+  /**
+  * Source: header "X-App-Admin-Token"
+  *  Description: Authentication method that denotes an admin token passed in the request header. 
+  *  Format (enforced by the server, applied by the SDK to a bare token): Admin {token} 
+  * If unset, the client uses the default of its config, if any.
+  */
+  AdminTokenAuth?: string;
+  
+  /**
+  * Source: header "X-App-API-Key"
+  *  Description: Authentication method that denotes an API key passed in the request header. 
+  *  Format (documentation only): api_key 
+  * If unset, the client uses the default of its config, if any.
+  */
+  APIKeyAuth?: string;
+  
+  /**
+  * Source: header "X-App-Session-Token"
+  *  Description: Authentication method that denotes a session token passed in the request header. 
+  *  Format (documentation only): session_token 
+  * If unset, the client uses the default of its config, if any.
+  */
+  SessionTokenAuth?: string;
+  
+
+
+};
+
+
+
+export type DeleteUser200 = {
   
 
   
   /**
   * Response body
   */
-  Body: ErrorResponse;
+  Body: DeleteUserResponseBody;
   
 };
 
-export async function ParseCreateUser500(resp: Response): Promise<CreateUser500> {
-  var result = {} as CreateUser500;
+export async function ParseDeleteUser200(resp: Response): Promise<DeleteUser200> {
+  var result = {} as DeleteUser200;
   
   
   // Attempt to parse response body as JSON
   return resp.json().then(
     body => {
-      result.Body = body as ErrorResponse;
+      result.Body = body as DeleteUserResponseBody;
       return result;
     },
     err => {
-      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for CreateUser500");
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for DeleteUser200");
     }
   );
   
@@ -529,47 +2044,39 @@ export async function ParseCreateUser500(resp: Response): Promise<CreateUser500>
 
 
 
-const GetUserReqHTTPMethod = "GET";
-const GetUserReqRoutePath = "/users/{userId}";
+const GetFlakyReqHTTPMethod = "GET";
+const GetFlakyReqRoutePath = "/flaky";
 
 
 /**
- * Retrieve user information by user ID.
+ * Fails with 503 Service Unavailable for the first Failures requests with the same key.
  */
 
-export type GetUserReq = {
+export type GetFlakyReq = {
+
 
   /**
-  * Source: path parameter "{userId}"
+  * Source: query parameter "failures"
   
-  * The unique identifier of the user.
+  * The number of requests with the key that fail.
   * 
   * Required
   */
-  UserId: string;
-
+  Failures: number;
 
 
-
-
-  // Authentication parameters (all required)
-  
   /**
-  * Required Authentication Method
-  * Source: header "X-App-API-Key"
-  *  Description: Authentication method that denotes an API key passed in the request header. 
-  *  Format (NOT ENFORCED): api_key 
-  */
-  APIKeyAuth: string;
+  * Source: query parameter "key"
   
-  /**
-  * Required Authentication Method
-  * Source: header "X-App-Session-Token"
-  *  Description: Authentication method that denotes a session token passed in the request header. 
-  *  Format (NOT ENFORCED): session_token 
+  * The key the requests are counted by.
+  * 
+  * Required
   */
-  SessionTokenAuth: string;
-  
+  Key: string;
+
+
+
+
 
 
 
@@ -577,29 +2084,29 @@ export type GetUserReq = {
 
 
 
-export type GetUser200 = {
+export type GetFlaky200 = {
   
 
   
   /**
   * Response body
   */
-  Body: User;
+  Body: FlakyResponseBody;
   
 };
 
-export async function ParseGetUser200(resp: Response): Promise<GetUser200> {
-  var result = {} as GetUser200;
+export async function ParseGetFlaky200(resp: Response): Promise<GetFlaky200> {
+  var result = {} as GetFlaky200;
   
   
   // Attempt to parse response body as JSON
   return resp.json().then(
     body => {
-      result.Body = body as User;
+      result.Body = body as FlakyResponseBody;
       return result;
     },
     err => {
-      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for GetUser200");
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for GetFlaky200");
     }
   );
   
@@ -608,7 +2115,17 @@ export async function ParseGetUser200(resp: Response): Promise<GetUser200> {
 
 
 
-export type GetUser400 = {
+export type GetFlaky503 = {
+  
+  /**
+  * Source: header parameter "Retry-After"
+  
+  * The number of seconds to wait before retrying.
+  * 
+  * Required
+  */
+  RetryAfter: number;
+
   
 
   
@@ -619,8 +2136,10 @@ export type GetUser400 = {
   
 };
 
-export async function ParseGetUser400(resp: Response): Promise<GetUser400> {
-  var result = {} as GetUser400;
+export async function ParseGetFlaky503(resp: Response): Promise<GetFlaky503> {
+  var result = {} as GetFlaky503;
+  
+  result.RetryAfter = parseintegerParam(resp.headers.get("Retry-After"), "header: Retry-After", true)!;
   
   
   // Attempt to parse response body as JSON
@@ -630,7 +2149,7 @@ export async function ParseGetUser400(resp: Response): Promise<GetUser400> {
       return result;
     },
     err => {
-      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for GetUser400");
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for GetFlaky503");
     }
   );
   
@@ -639,29 +2158,69 @@ export async function ParseGetUser400(resp: Response): Promise<GetUser400> {
 
 
 
-export type GetUser404 = {
+const CreateFlakyReqHTTPMethod = "POST";
+const CreateFlakyReqRoutePath = "/flaky";
+
+
+/**
+ * Same as GetFlaky, but not idempotent, so the SDKs do not retry it.
+ */
+
+export type CreateFlakyReq = {
+
+
+  /**
+  * Source: query parameter "failures"
+  
+  * The number of requests with the key that fail.
+  * 
+  * Required
+  */
+  Failures: number;
+
+
+  /**
+  * Source: query parameter "key"
+  
+  * The key the requests are counted by.
+  * 
+  * Required
+  */
+  Key: string;
+
+
+
+
+
+
+
+};
+
+
+
+export type CreateFlaky200 = {
   
 
   
   /**
   * Response body
   */
-  Body: ErrorResponse;
+  Body: FlakyResponseBody;
   
 };
 
-export async function ParseGetUser404(resp: Response): Promise<GetUser404> {
-  var result = {} as GetUser404;
+export async function ParseCreateFlaky200(resp: Response): Promise<CreateFlaky200> {
+  var result = {} as CreateFlaky200;
   
   
   // Attempt to parse response body as JSON
   return resp.json().then(
     body => {
-      result.Body = body as ErrorResponse;
+      result.Body = body as FlakyResponseBody;
       return result;
     },
     err => {
-      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for GetUser404");
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for CreateFlaky200");
     }
   );
   
@@ -670,7 +2229,17 @@ export async function ParseGetUser404(resp: Response): Promise<GetUser404> {
 
 
 
-export type GetUser500 = {
+export type CreateFlaky503 = {
+  
+  /**
+  * Source: header parameter "Retry-After"
+  
+  * The number of seconds to wait before retrying.
+  * 
+  * Required
+  */
+  RetryAfter: number;
+
   
 
   
@@ -681,8 +2250,10 @@ export type GetUser500 = {
   
 };
 
-export async function ParseGetUser500(resp: Response): Promise<GetUser500> {
-  var result = {} as GetUser500;
+export async function ParseCreateFlaky503(resp: Response): Promise<CreateFlaky503> {
+  var result = {} as CreateFlaky503;
+  
+  result.RetryAfter = parseintegerParam(resp.headers.get("Retry-After"), "header: Retry-After", true)!;
   
   
   // Attempt to parse response body as JSON
@@ -692,7 +2263,7 @@ export async function ParseGetUser500(resp: Response): Promise<GetUser500> {
       return result;
     },
     err => {
-      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for GetUser500");
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for CreateFlaky503");
     }
   );
   
@@ -701,99 +2272,77 @@ export async function ParseGetUser500(resp: Response): Promise<GetUser500> {
 
 
 
-const ListUsersReqHTTPMethod = "GET";
-const ListUsersReqRoutePath = "/users";
+const SubmitFlakyReqHTTPMethod = "POST";
+const SubmitFlakyReqRoutePath = "/flaky/submit";
 
 
 /**
- * List users with optional pagination.
+ * Same as CreateFlaky, but made idempotent with an Idempotency-Key, so the SDKs retry it.
  */
 
-export type ListUsersReq = {
+export type SubmitFlakyReq = {
 
 
   /**
-  * Source: query parameter "page"
+  * Source: query parameter "failures"
   
-  * The page number for pagination. Default = 0.
+  * The number of requests with the key that fail.
   * 
-  * Optional
+  * Required
   */
-  PageNumber?: number;
+  Failures: number;
 
 
   /**
-  * Source: query parameter "pageSize"
+  * Source: query parameter "key"
   
-  * The number of items per page for pagination. Default = 10.
+  * The key the requests are counted by.
   * 
-  * Optional
+  * Required
   */
-  PageSize?: number;
-
+  Key: string;
 
 
 
-  // Authentication parameters (all required)
-  
-  /**
-  * Required Authentication Method
-  * Source: header "X-App-Admin-Token"
-  *  Description: Authentication method that denotes an admin token passed in the request header. 
-  *  Format (NOT ENFORCED): admin_token 
-  */
-  AdminTokenAuth: string;
-  
   /**
-  * Required Authentication Method
-  * Source: header "X-App-API-Key"
-  *  Description: Authentication method that denotes an API key passed in the request header. 
-  *  Format (NOT ENFORCED): api_key 
+  * Source: header "Idempotency-Key"
+  *
+  * Identifies the call, so the server answers its retries with the response of the first attempt.
+  * If unset, the client generates a random key, sent with every attempt of the call.
   */
-  APIKeyAuth: string;
-  
+  IdempotencyKey?: string;
 
 
 
-};
 
 
+};
 
-export type ListUsers200 = {
-  
-  /**
-  * Source: header parameter "X-RateLimit-Remaining"
-  
-  * The number of remaining requests allowed in the current rate limit window.
-  * 
-  * Required
-  */
-  RateLimitRemaining: number;
 
+
+export type SubmitFlaky200 = {
   
 
   
   /**
   * Response body
   */
-  Body: ListUsersResponseBody;
+  Body: FlakyResponseBody;
   
 };
 
-export async function ParseListUsers200(resp: Response): Promise<ListUsers200> {
-  var result = {} as ListUsers200;
-  
-  result.RateLimitRemaining = parseintegerParam(resp.headers.get("X-RateLimit-Remaining"), "header: X-RateLimit-Remaining", true)!;
+export async function ParseSubmitFlaky200(resp: Response): Promise<SubmitFlaky200> {
+  var result = {} as SubmitFlaky200;
   
   
   // Attempt to parse response body as JSON
   return resp.json().then(
     body => {
-      result.Body = body as ListUsersResponseBody;
+      result.Body = body as FlakyResponseBody;
       return result;
     },
     err => {
-      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for ListUsers200");
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for SubmitFlaky200");
     }
   );
   
@@ -802,7 +2351,17 @@ export async function ParseListUsers200(resp: Response): Promise<ListUsers200> {
 
 
 
-export type ListUsers400 = {
+export type SubmitFlaky503 = {
+  
+  /**
+  * Source: header parameter "Retry-After"
+  
+  * The number of seconds to wait before retrying.
+  * 
+  * Required
+  */
+  RetryAfter: number;
+
   
 
   
@@ -813,8 +2372,10 @@ export type ListUsers400 = {
   
 };
 
-export async function ParseListUsers400(resp: Response): Promise<ListUsers400> {
-  var result = {} as ListUsers400;
+export async function ParseSubmitFlaky503(resp: Response): Promise<SubmitFlaky503> {
+  var result = {} as SubmitFlaky503;
+  
+  result.RetryAfter = parseintegerParam(resp.headers.get("Retry-After"), "header: Retry-After", true)!;
   
   
   // Attempt to parse response body as JSON
@@ -824,7 +2385,7 @@ export async function ParseListUsers400(resp: Response): Promise<ListUsers400> {
       return result;
     },
     err => {
-      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for ListUsers400");
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for SubmitFlaky503");
     }
   );
   
@@ -833,29 +2394,69 @@ export async function ParseListUsers400(resp: Response): Promise<ListUsers400> {
 
 
 
-export type ListUsers500 = {
+const ListEventsReqHTTPMethod = "GET";
+const ListEventsReqRoutePath = "/events";
+
+
+/**
+ * List events, by the offset of the first one.
+ */
+
+export type ListEventsReq = {
+
+
+  /**
+  * Source: query parameter "limit"
+  
+  * The maximum number of events of the page. Default = 10.
+  * 
+  * Optional
+  */
+  Limit?: number;
+
+
+  /**
+  * Source: query parameter "offset"
+  
+  * The offset of the first event of the page. Default = 0.
+  * 
+  * Optional
+  */
+  Offset?: number;
+
+
+
+
+
+
+
+};
+
+
+
+export type ListEvents200 = {
   
 
   
   /**
   * Response body
   */
-  Body: ErrorResponse;
+  Body: EventPage;
   
 };
 
-export async function ParseListUsers500(resp: Response): Promise<ListUsers500> {
-  var result = {} as ListUsers500;
+export async function ParseListEvents200(resp: Response): Promise<ListEvents200> {
+  var result = {} as ListEvents200;
   
   
   // Attempt to parse response body as JSON
   return resp.json().then(
     body => {
-      result.Body = body as ErrorResponse;
+      result.Body = body as EventPage;
       return result;
     },
     err => {
-      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for ListUsers500");
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for ListEvents200");
     }
   );
   
@@ -864,76 +2465,69 @@ export async function ParseListUsers500(resp: Response): Promise<ListUsers500> {
 
 
 
-const LogoutUserReqHTTPMethod = "GET";
-const LogoutUserReqRoutePath = "/users/logout";
+const ListEventFeedReqHTTPMethod = "GET";
+const ListEventFeedReqRoutePath = "/events/feed";
 
 
 /**
- * Logout the current user.
+ * List events, with the cursor returned by the previous page.
  */
 
-export type LogoutUserReq = {
-
-
+export type ListEventFeedReq = {
 
 
-  // Authentication parameters (all required)
-  
   /**
-  * Required Authentication Method
-  * Source: header "X-App-API-Key"
-  *  Description: Authentication method that denotes an API key passed in the request header. 
-  *  Format (NOT ENFORCED): api_key 
-  */
-  APIKeyAuth: string;
+  * Source: query parameter "cursor"
   
+  * The cursor of the page, from the NextCursor of the previous one. Default = the first page.
+  * 
+  * Optional
+  */
+  Cursor?: string;
 
 
-  // Authentication parameters (at least one required)
-  
   /**
-  * Source: header "X-App-Refresh-Token"
-  *  Description: Authentication method that denotes a refresh token passed in the request header. 
-  *  Format (NOT ENFORCED): refresh_token 
-  */
-  RefreshTokenAuth?: string;
+  * Source: query parameter "limit"
   
-  /**
-  * Source: header "X-App-Session-Token"
-  *  Description: Authentication method that denotes a session token passed in the request header. 
-  *  Format (NOT ENFORCED): session_token 
+  * The maximum number of events of the page. Default = 10.
+  * 
+  * Optional
   */
-  SessionTokenAuth?: string;
-  
+  Limit?: number;
+
+
+
+
+
 
 
 };
 
 
 
-export type LogoutUser200 = {
+export type ListEventFeed200 = {
   
 
   
   /**
   * Response body
   */
-  Body: LogoutUserResponseBody;
+  Body: EventFeed;
   
 };
 
-export async function ParseLogoutUser200(resp: Response): Promise<LogoutUser200> {
-  var result = {} as LogoutUser200;
+export async function ParseListEventFeed200(resp: Response): Promise<ListEventFeed200> {
+  var result = {} as ListEventFeed200;
   
   
   // Attempt to parse response body as JSON
   return resp.json().then(
     body => {
-      result.Body = body as LogoutUserResponseBody;
+      result.Body = body as EventFeed;
       return result;
     },
     err => {
-      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for LogoutUser200");
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for ListEventFeed200");
     }
   );
   
@@ -942,29 +2536,54 @@ export async function ParseLogoutUser200(resp: Response): Promise<LogoutUser200>
 
 
 
-export type LogoutUser400 = {
+const SendNotificationReqHTTPMethod = "POST";
+const SendNotificationReqRoutePath = "/notifications";
+
+
+/**
+ * Send a notification, by email or SMS. Just for testing oneOf request and response bodies in the generator.
+ */
+
+export type SendNotificationReq = {
+
+
+
+
+
+
+
+  /**
+  * Request body
+  */
+  Body: Notification;
+
+};
+
+
+
+export type SendNotification200 = {
   
 
   
   /**
   * Response body
   */
-  Body: ErrorResponse;
+  Body: Notification;
   
 };
 
-export async function ParseLogoutUser400(resp: Response): Promise<LogoutUser400> {
-  var result = {} as LogoutUser400;
+export async function ParseSendNotification200(resp: Response): Promise<SendNotification200> {
+  var result = {} as SendNotification200;
   
   
   // Attempt to parse response body as JSON
   return resp.json().then(
     body => {
-      result.Body = body as ErrorResponse;
+      result.Body = body as Notification;
       return result;
     },
     err => {
-      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for LogoutUser400");
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for SendNotification200");
     }
   );
   
@@ -973,7 +2592,7 @@ export async function ParseLogoutUser400(resp: Response): Promise<LogoutUser400>
 
 
 
-export type LogoutUser500 = {
+export type SendNotification400 = {
   
 
   
@@ -984,8 +2603,8 @@ export type LogoutUser500 = {
   
 };
 
-export async function ParseLogoutUser500(resp: Response): Promise<LogoutUser500> {
-  var result = {} as LogoutUser500;
+export async function ParseSendNotification400(resp: Response): Promise<SendNotification400> {
+  var result = {} as SendNotification400;
   
   
   // Attempt to parse response body as JSON
@@ -995,7 +2614,7 @@ export async function ParseLogoutUser500(resp: Response): Promise<LogoutUser500>
       return result;
     },
     err => {
-      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for LogoutUser500");
+      throw new TestingAPIError(ReasonUnexpected, "Error parsing response body for SendNotification400");
     }
   );
   
@@ -1004,79 +2623,8 @@ export async function ParseLogoutUser500(resp: Response): Promise<LogoutUser500>
 
 
 
-const WhoAmIReqHTTPMethod = "POST";
-const WhoAmIReqRoutePath = "/users/whoami";
-
-
-/**
- * Get information about the currently authenticated user. Provide the request body as just a string which is set to the user id.
- */
-
-export type WhoAmIReq = {
-
-
-
-
-  // Authentication parameters (all required)
-  
-  /**
-  * Required Authentication Method
-  * Source: header "X-App-API-Key"
-  *  Description: Authentication method that denotes an API key passed in the request header. 
-  *  Format (NOT ENFORCED): api_key 
-  */
-  APIKeyAuth: string;
-  
-  /**
-  * Required Authentication Method
-  * Source: header "X-App-Session-Token"
-  *  Description: Authentication method that denotes a session token passed in the request header. 
-  *  Format (NOT ENFORCED): session_token 
-  */
-  SessionTokenAuth: string;
-  
-
-
-
-};
-
-
-
-export type WhoAmI200 = {
-  
-  /**
-  * Source: header parameter "X-RateLimit-Remaining"
-  
-  * The number of remaining requests allowed in the current rate limit window.
-  * 
-  * Required
-  */
-  RateLimitRemaining: number;
-
-  
-
-  
-  /**
-  * Raw response */
-  RawBody: Response;
-  
-};
-
-export async function ParseWhoAmI200(resp: Response): Promise<WhoAmI200> {
-  var result = {} as WhoAmI200;
-  
-  result.RateLimitRemaining = parseintegerParam(resp.headers.get("X-RateLimit-Remaining"), "header: X-RateLimit-Remaining", true)!;
-  
-  
-  result.RawBody = resp;
-  
-  return result;
-}
-
-
-
-// WhoAmI400 response has no headers or body
-// Invalid Request
+// SendNotification413 response has no headers or body
+// Payload Too Large - the request body exceeds the maximum allowed size
 
 
 
@@ -1091,6 +2639,7 @@ export type HealthCheckReq = {
 
 
 
+
 };
 
 
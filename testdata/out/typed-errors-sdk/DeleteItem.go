@@ -0,0 +1,81 @@
+package typed_errors_sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	DeleteItemReqHTTPMethod = "DELETE"
+	DeleteItemReqRoutePath  = "/items/{itemId}"
+)
+
+// Delete an item by its ID.
+type DeleteItemReq struct {
+
+	// Source: path parameter "{itemId}"
+	//
+
+	// The unique identifier of the item.
+	//
+	// Required
+	ItemId string
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// DeleteItem204 represents a response with no headers and no response body
+// The item was deleted.
+
+// Item Not Found
+type DeleteItem404 struct {
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// NewDeleteItemReq creates a new instance of DeleteItemReq with required fields as parameters
+func NewDeleteItemReq(
+
+	ItemId string,
+
+) *DeleteItemReq {
+	return &DeleteItemReq{
+
+		ItemId: ItemId,
+	}
+}
+
+// ParseDeleteItem404 creates a new instance of DeleteItem404 by parsing a map[string]any
+func ParseDeleteItem404(resp *http.Response) (*DeleteItem404, error) {
+	result := new(DeleteItem404)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(ErrorResponse)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for DeleteItem404: %w", err)
+	}
+
+	return result, nil
+}
+
+// DeleteItem404Error is the error returned by DeleteItem for a 404 response.
+// Item Not Found
+type DeleteItem404Error struct {
+	Response *DeleteItem404
+}
+
+// StatusCode returns the status code of the response, 404.
+func (e *DeleteItem404Error) StatusCode() int {
+	return 404
+}
+
+func (e *DeleteItem404Error) Error() string {
+	return "DeleteItem: 404 Not Found"
+}
@@ -0,0 +1,101 @@
+package typed_errors_sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	GetItemReqHTTPMethod = "GET"
+	GetItemReqRoutePath  = "/items/{itemId}"
+)
+
+// Retrieve an item by its ID.
+type GetItemReq struct {
+
+	// Source: path parameter "{itemId}"
+	//
+
+	// The unique identifier of the item.
+	//
+	// Required
+	ItemId string
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// The item.
+type GetItem200 struct {
+
+	// Response body
+	Body *Item
+}
+
+// Item Not Found
+type GetItem404 struct {
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// NewGetItemReq creates a new instance of GetItemReq with required fields as parameters
+func NewGetItemReq(
+
+	ItemId string,
+
+) *GetItemReq {
+	return &GetItemReq{
+
+		ItemId: ItemId,
+	}
+}
+
+// ParseGetItem200 creates a new instance of GetItem200 by parsing a map[string]any
+func ParseGetItem200(resp *http.Response) (*GetItem200, error) {
+	result := new(GetItem200)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(Item)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for GetItem200: %w", err)
+	}
+
+	return result, nil
+}
+
+// ParseGetItem404 creates a new instance of GetItem404 by parsing a map[string]any
+func ParseGetItem404(resp *http.Response) (*GetItem404, error) {
+	result := new(GetItem404)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(ErrorResponse)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for GetItem404: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetItem404Error is the error returned by GetItem for a 404 response.
+// Item Not Found
+type GetItem404Error struct {
+	Response *GetItem404
+}
+
+// StatusCode returns the status code of the response, 404.
+func (e *GetItem404Error) StatusCode() int {
+	return 404
+}
+
+func (e *GetItem404Error) Error() string {
+	return "GetItem: 404 Not Found"
+}
@@ -0,0 +1,114 @@
+package typed_errors_sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	ListItemsReqHTTPMethod = "GET"
+	ListItemsReqRoutePath  = "/items"
+)
+
+// List the IDs of the items, by the offset of the first one.
+type ListItemsReq struct {
+
+	// Source: query parameter "limit"
+	//
+
+	// The maximum number of items of the page. Default = 10.
+	//
+	// Optional
+	Limit *int64
+
+	// Source: query parameter "offset"
+	//
+
+	// The offset of the first item of the page. Default = 0.
+	//
+	// Optional
+	Offset *int64
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type ListItems200 struct {
+
+	// Response body
+	Body *ItemPage
+}
+
+// Bad Request
+type ListItems400 struct {
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// NewListItemsReq creates a new instance of ListItemsReq with required fields as parameters
+func NewListItemsReq() *ListItemsReq {
+	return &ListItemsReq{}
+}
+
+// WithLimit sets the optional query parameter Limit and returns the modified ListItemsReq instance
+func (o *ListItemsReq) WithLimit(value *int64) *ListItemsReq {
+	o.Limit = value
+	return o
+}
+
+// WithOffset sets the optional query parameter Offset and returns the modified ListItemsReq instance
+func (o *ListItemsReq) WithOffset(value *int64) *ListItemsReq {
+	o.Offset = value
+	return o
+}
+
+// ParseListItems200 creates a new instance of ListItems200 by parsing a map[string]any
+func ParseListItems200(resp *http.Response) (*ListItems200, error) {
+	result := new(ListItems200)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(ItemPage)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for ListItems200: %w", err)
+	}
+
+	return result, nil
+}
+
+// ParseListItems400 creates a new instance of ListItems400 by parsing a map[string]any
+func ParseListItems400(resp *http.Response) (*ListItems400, error) {
+	result := new(ListItems400)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(ErrorResponse)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for ListItems400: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListItems400Error is the error returned by ListItems for a 400 response.
+// Bad Request
+type ListItems400Error struct {
+	Response *ListItems400
+}
+
+// StatusCode returns the status code of the response, 400.
+func (e *ListItems400Error) StatusCode() int {
+	return 400
+}
+
+func (e *ListItems400Error) Error() string {
+	return "ListItems: 400 Bad Request"
+}
@@ -0,0 +1,163 @@
+package typed_errors_sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	PutItemReqHTTPMethod = "PUT"
+	PutItemReqRoutePath  = "/items/{itemId}"
+)
+
+// Create or replace an item.
+type PutItemReq struct {
+
+	// Source: path parameter "{itemId}"
+	//
+
+	// The unique identifier of the item.
+	//
+	// Required
+	ItemId string
+
+	// Request body
+	Body *PutItemRequestBody
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// The item was replaced.
+type PutItem200 struct {
+
+	// Response body
+	Body *Item
+}
+
+// The item was created.
+type PutItem201 struct {
+
+	// Response body
+	Body *Item
+}
+
+// Bad Request
+type PutItem400 struct {
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// Payload Too Large - the request body exceeds the maximum allowed size
+type PutItem413Response struct {
+
+	// Raw response body. The HTTP response will be returned directly for this response, and it will be the responsibility of the caller to read/close the response body.
+	RawBody *http.Response
+}
+
+// NewPutItemReq creates a new instance of PutItemReq with required fields as parameters
+func NewPutItemReq(
+
+	ItemId string,
+
+	Body *PutItemRequestBody,
+
+) *PutItemReq {
+	return &PutItemReq{
+
+		ItemId: ItemId,
+
+		Body: Body,
+	}
+}
+
+// ParsePutItem200 creates a new instance of PutItem200 by parsing a map[string]any
+func ParsePutItem200(resp *http.Response) (*PutItem200, error) {
+	result := new(PutItem200)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(Item)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for PutItem200: %w", err)
+	}
+
+	return result, nil
+}
+
+// ParsePutItem201 creates a new instance of PutItem201 by parsing a map[string]any
+func ParsePutItem201(resp *http.Response) (*PutItem201, error) {
+	result := new(PutItem201)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(Item)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for PutItem201: %w", err)
+	}
+
+	return result, nil
+}
+
+// ParsePutItem400 creates a new instance of PutItem400 by parsing a map[string]any
+func ParsePutItem400(resp *http.Response) (*PutItem400, error) {
+	result := new(PutItem400)
+
+	defer resp.Body.Close()
+	if result.Body == nil {
+		result.Body = new(ErrorResponse)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(result.Body); err != nil {
+		return nil, fmt.Errorf("error decoding response body for PutItem400: %w", err)
+	}
+
+	return result, nil
+}
+
+// PutItem400Error is the error returned by PutItem for a 400 response.
+// Bad Request
+type PutItem400Error struct {
+	Response *PutItem400
+}
+
+// StatusCode returns the status code of the response, 400.
+func (e *PutItem400Error) StatusCode() int {
+	return 400
+}
+
+func (e *PutItem400Error) Error() string {
+	return "PutItem: 400 Bad Request"
+}
+
+// ParsePutItem413Response creates a new instance of PutItem413Response by parsing a map[string]any
+func ParsePutItem413Response(resp *http.Response) (*PutItem413Response, error) {
+	result := new(PutItem413Response)
+
+	result.RawBody = resp
+
+	return result, nil
+}
+
+// PutItem413Error is the error returned by PutItem for a 413 response.
+// Payload Too Large - the request body exceeds the maximum allowed size
+type PutItem413Error struct {
+	// Response holds the parsed headers of the response. Callers MUST CLOSE THE BODY of its RawBody.
+	Response *PutItem413Response
+}
+
+// StatusCode returns the status code of the response, 413.
+func (e *PutItem413Error) StatusCode() int {
+	return 413
+}
+
+func (e *PutItem413Error) Error() string {
+	return "PutItem: 413 Request Entity Too Large"
+}
@@ -0,0 +1,481 @@
+package typed_errors_sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const ClientVersion = "1.0.0"
+
+type TypedErrorsAPIErrorReason string
+
+const (
+	// Network/Timeout
+	ReasonTransport TypedErrorsAPIErrorReason = "transport"
+
+	// Marshal/Unmarshal
+	ReasonEncoding TypedErrorsAPIErrorReason = "encoding"
+
+	// Non-Spec Response
+	ReasonUnexpected TypedErrorsAPIErrorReason = "unexpected"
+)
+
+type TypedErrorsAPIError struct {
+	// Reason is the "Actionable" category
+	Reason TypedErrorsAPIErrorReason
+	// Message is the human-readable "what happened"
+	Message string
+	// Err is the underlying cause (net.Conn, json.SyntaxError, etc.)
+	Err error
+
+	// UnknownResponse is the response of a ReasonUnexpected error whose status code is not in the specification,
+	// or whose headers or body failed to parse.
+	//
+	// Callers MUST CLOSE THE BODY of this response when done inspecting it to avoid resource leaks.
+	UnknownResponse *http.Response
+}
+
+func (e *TypedErrorsAPIError) Error() string {
+	return fmt.Sprintf("%s_error: %s: %v", e.Reason, e.Message, e.Err)
+}
+
+// ResponseError is implemented by the errors returned for the 4xx and 5xx responses of the specification,
+// e.g. *CreateUser400Error, which hold the parsed response. Use errors.As to get the typed error of a response.
+type ResponseError interface {
+	error
+	StatusCode() int
+}
+
+type TypedErrorsAPI struct {
+	httpClient *http.Client
+	baseURL    string
+
+	// The middleware added with WithMiddleware, and the Doer sending the requests through them.
+	middleware []Middleware
+	doer       Doer
+}
+
+// ClientOption configures a TypedErrorsAPI created by NewTypedErrorsAPI, see WithMiddleware.
+type ClientOption func(c *TypedErrorsAPI)
+
+// NewTypedErrorsAPI returns a client for the API at baseURL, with a 30 seconds timeout, configured with opts.
+func NewTypedErrorsAPI(baseURL string, opts ...ClientOption) *TypedErrorsAPI {
+	return NewTypedErrorsAPIWithHTTPClient(baseURL, &http.Client{Timeout: 30 * time.Second}, opts...)
+}
+
+// NewTypedErrorsAPIWithHTTPClient returns a client for the API at baseURL that sends requests with httpClient, configured with opts.
+func NewTypedErrorsAPIWithHTTPClient(baseURL string, httpClient *http.Client, opts ...ClientOption) *TypedErrorsAPI {
+	c := &TypedErrorsAPI{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.doer = DoerFunc(func(route Route, req *http.Request) (*http.Response, error) {
+		return c.httpClient.Do(req)
+	})
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		c.doer = c.middleware[i](c.doer)
+	}
+	return c
+}
+
+// do sends req, a request to route, through the middleware of the client.
+func (c *TypedErrorsAPI) do(ctx context.Context, route Route, req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", "application/json")
+	}
+	req.Header.Set("User-Agent", "TypedErrorsAPI-GoSDK/1.0.0")
+	return c.doer.Do(route, req.WithContext(ctx))
+}
+
+// basicAuthValue encodes credentials for a "basic" auth method, without the "Basic " prefix.
+func basicAuthValue(credentials BasicAuthCredentials) string {
+	return base64.StdEncoding.EncodeToString([]byte(credentials.Username + ":" + credentials.Password))
+}
+
+// formatAuthValue applies the "{token}" template format of an auth method, given by the text around the placeholder,
+// to a credential, unless it is already formatted.
+func formatAuthValue(credential, prefix, suffix string) string {
+	if len(credential) > len(prefix)+len(suffix) && strings.HasPrefix(credential, prefix) && strings.HasSuffix(credential, suffix) {
+		return credential
+	}
+	return prefix + credential + suffix
+}
+
+// GetItem calls GET /items/{itemId}.
+//
+// The 200 response of the specification is returned directly.
+// The 4xx and 5xx responses of the specification are returned as *GetItem<StatusCode>Error errors,
+// and the responses that are not in the specification as a *TypedErrorsAPIError with ReasonUnexpected,
+// with the response in UnknownResponse.
+func (c *TypedErrorsAPI) GetItem(ctx context.Context, params *GetItemReq) (*GetItem200, error) {
+	var body io.Reader
+
+	path := "/items/{itemId}"
+
+	pathParamItemId, err := paramToString(params.ItemId, "path parameter: ItemId", "string", true)
+	if err != nil {
+		return nil, &TypedErrorsAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid path parameter itemId",
+			Err:     err,
+		}
+	}
+	path = strings.ReplaceAll(path, "{itemId}", pathParamItemId)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		c.baseURL+path,
+		body,
+	)
+	if err != nil {
+		return nil, &TypedErrorsAPIError{
+			Reason:  ReasonTransport,
+			Message: "failed to create HTTP request",
+			Err:     err,
+		}
+	}
+
+	resp, err := c.do(ctx, GetItemRoute, req)
+	if err != nil {
+		return nil, &TypedErrorsAPIError{
+			Reason:  ReasonTransport,
+			Message: "HTTP request failed",
+			Err:     err,
+		}
+	}
+	switch resp.StatusCode {
+
+	case 200:
+		parsedResp, err := ParseGetItem200(resp)
+		if err != nil {
+			return nil, &TypedErrorsAPIError{
+				Reason:          ReasonUnexpected,
+				Message:         fmt.Sprintf("failed to parse response for status code %d", 200),
+				Err:             err,
+				UnknownResponse: resp,
+			}
+		}
+		return parsedResp, nil
+
+	case 404:
+		parsedResp, err := ParseGetItem404(resp)
+		if err != nil {
+			return nil, &TypedErrorsAPIError{
+				Reason:          ReasonUnexpected,
+				Message:         fmt.Sprintf("failed to parse response for status code %d", 404),
+				Err:             err,
+				UnknownResponse: resp,
+			}
+		}
+		return nil, &GetItem404Error{Response: parsedResp}
+
+	default:
+		return nil, &TypedErrorsAPIError{
+			Reason:          ReasonUnexpected,
+			Message:         fmt.Sprintf("unexpected response status %d", resp.StatusCode),
+			Err:             nil,
+			UnknownResponse: resp,
+		}
+	}
+}
+
+// DeleteItem calls DELETE /items/{itemId}.
+//
+// A nil error means the 204 response of the specification.
+// The 4xx and 5xx responses of the specification are returned as *DeleteItem<StatusCode>Error errors,
+// and the responses that are not in the specification as a *TypedErrorsAPIError with ReasonUnexpected,
+// with the response in UnknownResponse.
+func (c *TypedErrorsAPI) DeleteItem(ctx context.Context, params *DeleteItemReq) error {
+	var body io.Reader
+
+	path := "/items/{itemId}"
+
+	pathParamItemId, err := paramToString(params.ItemId, "path parameter: ItemId", "string", true)
+	if err != nil {
+		return &TypedErrorsAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid path parameter itemId",
+			Err:     err,
+		}
+	}
+	path = strings.ReplaceAll(path, "{itemId}", pathParamItemId)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		c.baseURL+path,
+		body,
+	)
+	if err != nil {
+		return &TypedErrorsAPIError{
+			Reason:  ReasonTransport,
+			Message: "failed to create HTTP request",
+			Err:     err,
+		}
+	}
+
+	resp, err := c.do(ctx, DeleteItemRoute, req)
+	if err != nil {
+		return &TypedErrorsAPIError{
+			Reason:  ReasonTransport,
+			Message: "HTTP request failed",
+			Err:     err,
+		}
+	}
+	switch resp.StatusCode {
+
+	case 204:
+		// No response body or headers to parse for this status code
+		return nil
+
+	case 404:
+		parsedResp, err := ParseDeleteItem404(resp)
+		if err != nil {
+			return &TypedErrorsAPIError{
+				Reason:          ReasonUnexpected,
+				Message:         fmt.Sprintf("failed to parse response for status code %d", 404),
+				Err:             err,
+				UnknownResponse: resp,
+			}
+		}
+		return &DeleteItem404Error{Response: parsedResp}
+
+	default:
+		return &TypedErrorsAPIError{
+			Reason:          ReasonUnexpected,
+			Message:         fmt.Sprintf("unexpected response status %d", resp.StatusCode),
+			Err:             nil,
+			UnknownResponse: resp,
+		}
+	}
+}
+
+type PutItemResult struct {
+
+	// The item was replaced.
+	Response200 *PutItem200
+
+	// The item was created.
+	Response201 *PutItem201
+
+	StatusCode int
+}
+
+// PutItem calls PUT /items/{itemId}.
+//
+// The success responses of the specification are returned in the PutItemResult.
+// The 4xx and 5xx responses of the specification are returned as *PutItem<StatusCode>Error errors,
+// and the responses that are not in the specification as a *TypedErrorsAPIError with ReasonUnexpected,
+// with the response in UnknownResponse.
+func (c *TypedErrorsAPI) PutItem(ctx context.Context, params *PutItemReq) (PutItemResult, error) {
+	var body io.Reader
+
+	bodyBytes, err := json.Marshal(params.Body)
+	if err != nil {
+		return PutItemResult{}, &TypedErrorsAPIError{
+			Reason:  ReasonEncoding,
+			Message: "failed to marshal request body",
+			Err:     err,
+		}
+	}
+	body = bytes.NewReader(bodyBytes)
+
+	path := "/items/{itemId}"
+
+	pathParamItemId, err := paramToString(params.ItemId, "path parameter: ItemId", "string", true)
+	if err != nil {
+		return PutItemResult{}, &TypedErrorsAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid path parameter itemId",
+			Err:     err,
+		}
+	}
+	path = strings.ReplaceAll(path, "{itemId}", pathParamItemId)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PUT",
+		c.baseURL+path,
+		body,
+	)
+	if err != nil {
+		return PutItemResult{}, &TypedErrorsAPIError{
+			Reason:  ReasonTransport,
+			Message: "failed to create HTTP request",
+			Err:     err,
+		}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(ctx, PutItemRoute, req)
+	if err != nil {
+		return PutItemResult{}, &TypedErrorsAPIError{
+			Reason:  ReasonTransport,
+			Message: "HTTP request failed",
+			Err:     err,
+		}
+	}
+	switch resp.StatusCode {
+
+	case 200:
+		parsedResp, err := ParsePutItem200(resp)
+		if err != nil {
+			return PutItemResult{}, &TypedErrorsAPIError{
+				Reason:          ReasonUnexpected,
+				Message:         fmt.Sprintf("failed to parse response for status code %d", 200),
+				Err:             err,
+				UnknownResponse: resp,
+			}
+		}
+		return PutItemResult{StatusCode: resp.StatusCode, Response200: parsedResp}, nil
+
+	case 201:
+		parsedResp, err := ParsePutItem201(resp)
+		if err != nil {
+			return PutItemResult{}, &TypedErrorsAPIError{
+				Reason:          ReasonUnexpected,
+				Message:         fmt.Sprintf("failed to parse response for status code %d", 201),
+				Err:             err,
+				UnknownResponse: resp,
+			}
+		}
+		return PutItemResult{StatusCode: resp.StatusCode, Response201: parsedResp}, nil
+
+	case 400:
+		parsedResp, err := ParsePutItem400(resp)
+		if err != nil {
+			return PutItemResult{}, &TypedErrorsAPIError{
+				Reason:          ReasonUnexpected,
+				Message:         fmt.Sprintf("failed to parse response for status code %d", 400),
+				Err:             err,
+				UnknownResponse: resp,
+			}
+		}
+		return PutItemResult{}, &PutItem400Error{Response: parsedResp}
+
+	case 413:
+		parsedResp, err := ParsePutItem413Response(resp)
+		if err != nil {
+			return PutItemResult{}, &TypedErrorsAPIError{
+				Reason:          ReasonUnexpected,
+				Message:         fmt.Sprintf("failed to parse response for status code %d", 413),
+				Err:             err,
+				UnknownResponse: resp,
+			}
+		}
+		return PutItemResult{}, &PutItem413Error{Response: parsedResp}
+
+	default:
+		return PutItemResult{}, &TypedErrorsAPIError{
+			Reason:          ReasonUnexpected,
+			Message:         fmt.Sprintf("unexpected response status %d", resp.StatusCode),
+			Err:             nil,
+			UnknownResponse: resp,
+		}
+	}
+}
+
+// ListItems calls GET /items.
+//
+// The 200 response of the specification is returned directly.
+// The 4xx and 5xx responses of the specification are returned as *ListItems<StatusCode>Error errors,
+// and the responses that are not in the specification as a *TypedErrorsAPIError with ReasonUnexpected,
+// with the response in UnknownResponse.
+func (c *TypedErrorsAPI) ListItems(ctx context.Context, params *ListItemsReq) (*ListItems200, error) {
+	var body io.Reader
+
+	path := "/items"
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		c.baseURL+path,
+		body,
+	)
+	if err != nil {
+		return nil, &TypedErrorsAPIError{
+			Reason:  ReasonTransport,
+			Message: "failed to create HTTP request",
+			Err:     err,
+		}
+	}
+
+	q := req.URL.Query()
+
+	queryLimit, err := paramToString(params.Limit, "query parameter: Limit", "*int64", false)
+	if err != nil {
+		return nil, &TypedErrorsAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid query parameter limit",
+			Err:     err,
+		}
+	}
+	q.Set("limit", queryLimit)
+
+	queryOffset, err := paramToString(params.Offset, "query parameter: Offset", "*int64", false)
+	if err != nil {
+		return nil, &TypedErrorsAPIError{
+			Reason:  ReasonEncoding,
+			Message: "invalid query parameter offset",
+			Err:     err,
+		}
+	}
+	q.Set("offset", queryOffset)
+
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.do(ctx, ListItemsRoute, req)
+	if err != nil {
+		return nil, &TypedErrorsAPIError{
+			Reason:  ReasonTransport,
+			Message: "HTTP request failed",
+			Err:     err,
+		}
+	}
+	switch resp.StatusCode {
+
+	case 200:
+		parsedResp, err := ParseListItems200(resp)
+		if err != nil {
+			return nil, &TypedErrorsAPIError{
+				Reason:          ReasonUnexpected,
+				Message:         fmt.Sprintf("failed to parse response for status code %d", 200),
+				Err:             err,
+				UnknownResponse: resp,
+			}
+		}
+		return parsedResp, nil
+
+	case 400:
+		parsedResp, err := ParseListItems400(resp)
+		if err != nil {
+			return nil, &TypedErrorsAPIError{
+				Reason:          ReasonUnexpected,
+				Message:         fmt.Sprintf("failed to parse response for status code %d", 400),
+				Err:             err,
+				UnknownResponse: resp,
+			}
+		}
+		return nil, &ListItems400Error{Response: parsedResp}
+
+	default:
+		return nil, &TypedErrorsAPIError{
+			Reason:          ReasonUnexpected,
+			Message:         fmt.Sprintf("unexpected response status %d", resp.StatusCode),
+			Err:             nil,
+			UnknownResponse: resp,
+		}
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/nbrglm/napiway/testdata/out/typed_errors_sdk
+
+go 1.26.3
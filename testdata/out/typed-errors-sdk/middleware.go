@@ -0,0 +1,96 @@
+package typed_errors_sdk
+
+import (
+	"net/http"
+)
+
+// Route describes an endpoint of the specification, as passed to the Middleware of the TypedErrorsAPI.
+type Route struct {
+	// Name of the endpoint, e.g. "CreateUser".
+	Name string
+
+	Method string
+
+	// Path pattern of the endpoint, e.g. "/users/{userId}".
+	Path string
+
+	// Authorization scopes required by the endpoint, if any.
+	Scopes []string
+
+	// IDs of the auth methods of the endpoint in the specification, if any.
+	AuthMethods []string
+
+	// Whether the endpoint is idempotent: its method is GET, PUT or DELETE, or it is marked idempotent in the specification.
+	Idempotent bool
+}
+
+var (
+	// GetItemRoute is the Route of the GetItem endpoint.
+	GetItemRoute = Route{
+		Name:       "GetItem",
+		Method:     GetItemReqHTTPMethod,
+		Path:       GetItemReqRoutePath,
+		Idempotent: true,
+	}
+
+	// DeleteItemRoute is the Route of the DeleteItem endpoint.
+	DeleteItemRoute = Route{
+		Name:       "DeleteItem",
+		Method:     DeleteItemReqHTTPMethod,
+		Path:       DeleteItemReqRoutePath,
+		Idempotent: true,
+	}
+
+	// PutItemRoute is the Route of the PutItem endpoint.
+	PutItemRoute = Route{
+		Name:       "PutItem",
+		Method:     PutItemReqHTTPMethod,
+		Path:       PutItemReqRoutePath,
+		Idempotent: true,
+	}
+
+	// ListItemsRoute is the Route of the ListItems endpoint.
+	ListItemsRoute = Route{
+		Name:       "ListItems",
+		Method:     ListItemsReqHTTPMethod,
+		Path:       ListItemsReqRoutePath,
+		Idempotent: true,
+	}
+)
+
+// Routes lists the Route of every endpoint in the specification.
+var Routes = []Route{
+	GetItemRoute,
+	DeleteItemRoute,
+	PutItemRoute,
+	ListItemsRoute,
+}
+
+// Doer sends the HTTP requests of the TypedErrorsAPI.
+type Doer interface {
+	// Do sends req, a request to route, and returns its response, like http.Client.Do.
+	Do(route Route, req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is a Doer implemented by a function.
+type DoerFunc func(route Route, req *http.Request) (*http.Response, error)
+
+// Do calls f.
+func (f DoerFunc) Do(route Route, req *http.Request) (*http.Response, error) {
+	return f(route, req)
+}
+
+// Middleware wraps the Doer of the TypedErrorsAPI, e.g. to log requests, add tracing headers, record metrics or
+// authenticate requests. It returns a Doer that calls next to send the request, or answers it without calling next.
+//
+// The Doer it returns is called for every HTTP request, including each retry, once the request is complete:
+// its parameters, credentials and the Accept and User-Agent headers are set. It must be safe for concurrent use.
+type Middleware func(next Doer) Doer
+
+// WithMiddleware adds middleware to the TypedErrorsAPI. The first middleware added is the outermost one,
+// which receives the requests first and their responses last.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(c *TypedErrorsAPI) {
+		c.middleware = append(c.middleware, middleware...)
+	}
+}
@@ -0,0 +1,56 @@
+package typed_errors_sdk
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// ListItemsAll returns an iterator over the ItemIds of every page of ListItems, requested by the Offset of their first item, from the one of params, or 0.
+//
+// Each page is requested once the items of the previous one are consumed. The iteration stops at the first error,
+// yielded with the zero string: the error of ListItems, or a *TypedErrorsAPIError with ReasonUnexpected
+// for a page whose response is not a 200.
+func (c *TypedErrorsAPI) ListItemsAll(ctx context.Context, params *ListItemsReq) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		var zero string
+		// Copy the params, so the pages requested are not visible to the caller
+		paramsCopy := *params
+		params := &paramsCopy
+		position := int64(0)
+		if params.Offset != nil {
+			position = *params.Offset
+		}
+		for {
+			params.Offset = &position
+			page, err := c.ListItems(ctx, params)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			body := page.Body
+			for _, item := range body.ItemIds {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			position += int64(len(body.ItemIds))
+			if len(body.ItemIds) == 0 || position >= body.TotalCount {
+				return
+			}
+		}
+	}
+}
+
+// unexpectedPageError returns the error of a page of endpoint answered with an unexpected statusCode,
+// closing the body of the unknown response, if any.
+func unexpectedPageError(endpoint string, statusCode int, unknown *http.Response) *TypedErrorsAPIError {
+	if unknown != nil {
+		unknown.Body.Close()
+	}
+	return &TypedErrorsAPIError{
+		Reason:  ReasonUnexpected,
+		Message: fmt.Sprintf("unexpected response status %d for a page of %s", statusCode, endpoint),
+	}
+}
@@ -0,0 +1,373 @@
+package typed_errors_sdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Auth method keys, if any
+
+type ErrorResponse struct {
+
+	// A detailed debug message for developers. Only passed if in debug mode.
+	//
+	// Optional
+	//
+	DebugMessage *string `json:"DebugMessage,omitempty"`
+
+	// An error message which is user-friendly.
+	//
+	// Required
+	//
+	// Must be non-empty
+	ErrorMessage string `json:"ErrorMessage"`
+}
+
+// NewErrorResponse creates a new instance of ErrorResponse with required fields as parameters
+func NewErrorResponse(
+
+	ErrorMessage string,
+
+) *ErrorResponse {
+	return &ErrorResponse{
+
+		ErrorMessage: ErrorMessage,
+	}
+}
+
+// WithDebugMessage sets the optional field DebugMessage and returns the modified ErrorResponse instance
+func (o *ErrorResponse) WithDebugMessage(value string) *ErrorResponse {
+	o.DebugMessage = &value
+	return o
+}
+
+// ParseErrorResponse creates a new instance of ErrorResponse from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseErrorResponse(data map[string]any) (*ErrorResponse, error) {
+	verr := &ValidationError{}
+	body := parseErrorResponse(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseErrorResponse parses data into a new ErrorResponse, recording issues in verr with paths relative to path.
+func parseErrorResponse(data map[string]any, path string, verr *ValidationError) *ErrorResponse {
+	body := new(ErrorResponse)
+
+	pathDebugMessage := joinValidationPath(path, "DebugMessage")
+
+	valDebugMessage, ok := data["DebugMessage"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valDebugMessageTyped, ok := valDebugMessage.(string); !ok {
+			verr.add(ValidationLocationBody, pathDebugMessage, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valDebugMessageTyped = strings.TrimSpace(valDebugMessageTyped)
+
+			body.DebugMessage = &valDebugMessageTyped
+		}
+
+	}
+
+	pathErrorMessage := joinValidationPath(path, "ErrorMessage")
+
+	valErrorMessage, ok := data["ErrorMessage"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valErrorMessageTyped, ok := valErrorMessage.(string); !ok {
+			verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valErrorMessageTyped = strings.TrimSpace(valErrorMessageTyped)
+
+			if len(valErrorMessageTyped) == 0 {
+				verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.ErrorMessage = valErrorMessageTyped
+		}
+
+	}
+
+	return body
+}
+
+type Item struct {
+
+	// The unique identifier of the item.
+	//
+	// Required
+	//
+	// Must be non-empty
+	ItemId string `json:"ItemId"`
+
+	// The name of the item.
+	//
+	// Required
+	//
+	// Must be non-empty
+	Name string `json:"Name"`
+}
+
+// NewItem creates a new instance of Item with required fields as parameters
+func NewItem(
+
+	ItemId string,
+
+	Name string,
+
+) *Item {
+	return &Item{
+
+		ItemId: ItemId,
+
+		Name: Name,
+	}
+}
+
+// ParseItem creates a new instance of Item from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseItem(data map[string]any) (*Item, error) {
+	verr := &ValidationError{}
+	body := parseItem(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseItem parses data into a new Item, recording issues in verr with paths relative to path.
+func parseItem(data map[string]any, path string, verr *ValidationError) *Item {
+	body := new(Item)
+
+	pathItemId := joinValidationPath(path, "ItemId")
+
+	valItemId, ok := data["ItemId"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathItemId, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valItemIdTyped, ok := valItemId.(string); !ok {
+			verr.add(ValidationLocationBody, pathItemId, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valItemIdTyped = strings.TrimSpace(valItemIdTyped)
+
+			if len(valItemIdTyped) == 0 {
+				verr.add(ValidationLocationBody, pathItemId, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.ItemId = valItemIdTyped
+		}
+
+	}
+
+	pathName := joinValidationPath(path, "Name")
+
+	valName, ok := data["Name"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathName, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valNameTyped, ok := valName.(string); !ok {
+			verr.add(ValidationLocationBody, pathName, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valNameTyped = strings.TrimSpace(valNameTyped)
+
+			if len(valNameTyped) == 0 {
+				verr.add(ValidationLocationBody, pathName, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Name = valNameTyped
+		}
+
+	}
+
+	return body
+}
+
+type ItemPage struct {
+
+	// The IDs of the items of the page.
+	//
+	// Required
+	//
+	ItemIds []string `json:"ItemIds"`
+
+	// The total number of items.
+	//
+	// Required
+	//
+	TotalCount int64 `json:"TotalCount"`
+}
+
+// NewItemPage creates a new instance of ItemPage with required fields as parameters
+func NewItemPage(
+
+	ItemIds []string,
+
+	TotalCount int64,
+
+) *ItemPage {
+	return &ItemPage{
+
+		ItemIds: ItemIds,
+
+		TotalCount: TotalCount,
+	}
+}
+
+// ParseItemPage creates a new instance of ItemPage from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseItemPage(data map[string]any) (*ItemPage, error) {
+	verr := &ValidationError{}
+	body := parseItemPage(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseItemPage parses data into a new ItemPage, recording issues in verr with paths relative to path.
+func parseItemPage(data map[string]any, path string, verr *ValidationError) *ItemPage {
+	body := new(ItemPage)
+
+	pathItemIds := joinValidationPath(path, "ItemIds")
+
+	valItemIds, ok := data["ItemIds"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathItemIds, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		valItemIdsSlice, ok := valItemIds.([]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathItemIds, ValidationCodeInvalidType, "must be an array")
+
+		} else if len(valItemIdsSlice) == 0 {
+			verr.add(ValidationLocationBody, pathItemIds, ValidationCodeNonEmpty, "must be non-empty")
+
+		} else {
+			valItemIdsTyped := make([]string, 0, len(valItemIdsSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valItemIdsSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathItemIds, idx)
+
+				itemTyped, ok := item.(string)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be a string")
+					continue
+				}
+				itemTyped = strings.TrimSpace(itemTyped)
+
+				if len(itemTyped) == 0 {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeNonEmpty, "must be non-empty")
+					continue
+				}
+
+				valItemIdsTyped = append(valItemIdsTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.ItemIds = valItemIdsTyped
+			}
+		}
+
+	}
+
+	pathTotalCount := joinValidationPath(path, "TotalCount")
+
+	valTotalCount, ok := data["TotalCount"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		// JSON numbers are float64 by default, so we need to handle that case
+		switch v := valTotalCount.(type) {
+		case float64:
+			valTotalCountTyped := int64(v)
+			body.TotalCount = valTotalCountTyped
+		case int64:
+			body.TotalCount = v
+		default:
+			verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeInvalidType, "must be an integer")
+		}
+
+	}
+
+	return body
+}
+
+type PutItemRequestBody struct {
+
+	// The name of the item.
+	//
+	// Required
+	//
+	// Must be non-empty
+	Name string `json:"Name"`
+}
+
+// NewPutItemRequestBody creates a new instance of PutItemRequestBody with required fields as parameters
+func NewPutItemRequestBody(
+
+	Name string,
+
+) *PutItemRequestBody {
+	return &PutItemRequestBody{
+
+		Name: Name,
+	}
+}
+
+// ParsePutItemRequestBody creates a new instance of PutItemRequestBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParsePutItemRequestBody(data map[string]any) (*PutItemRequestBody, error) {
+	verr := &ValidationError{}
+	body := parsePutItemRequestBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parsePutItemRequestBody parses data into a new PutItemRequestBody, recording issues in verr with paths relative to path.
+func parsePutItemRequestBody(data map[string]any, path string, verr *ValidationError) *PutItemRequestBody {
+	body := new(PutItemRequestBody)
+
+	pathName := joinValidationPath(path, "Name")
+
+	valName, ok := data["Name"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathName, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valNameTyped, ok := valName.(string); !ok {
+			verr.add(ValidationLocationBody, pathName, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valNameTyped = strings.TrimSpace(valNameTyped)
+
+			if len(valNameTyped) == 0 {
+				verr.add(ValidationLocationBody, pathName, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Name = valNameTyped
+		}
+
+	}
+
+	return body
+}
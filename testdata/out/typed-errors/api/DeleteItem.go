@@ -0,0 +1,144 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	DeleteItemReqHTTPMethod = "DELETE"
+	DeleteItemReqRoutePath  = "/items/{itemId}"
+)
+
+// Delete an item by its ID.
+type DeleteItemReq struct {
+
+	// Source: path parameter "{itemId}"
+	//
+
+	// The unique identifier of the item.
+	//
+	// Required
+	ItemId string
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// DeleteItem204 represents a response with no headers and no response body
+// The item was deleted.
+
+// Item Not Found
+type DeleteItem404 struct {
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// ParseDeleteItemReq creates a new instance of DeleteItemReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
+func ParseDeleteItemReq(w http.ResponseWriter, r *http.Request) (*DeleteItemReq, error) {
+	req := DeleteItemReq{}
+	verr := &ValidationError{}
+
+	// Parse path parameters, if any
+
+	valItemId, err := parsestringParam(r.PathValue("itemId"), "itemId", true)
+	if err != nil {
+		verr.addParamError(ValidationLocationPath, "itemId", err)
+	} else if valItemId != nil {
+		req.ItemId = *valItemId
+	}
+
+	// Parse query parameters, if any
+
+	// Parse header parameters, if any
+
+	// Required auth, if any
+
+	// Atleast one auth, if any
+
+	// All auth of one of the alternatives, if any
+
+	if len(verr.Issues) > 0 {
+		return &DeleteItemReq{}, newParseError(verr)
+	}
+	return &req, nil
+}
+
+// DeleteItemResponse is one of the responses defined for the DeleteItem endpoint:
+//   - 204: DeleteItem204
+//   - 404: DeleteItem404
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type DeleteItemResponse interface {
+	// writeDeleteItemResponse writes the headers, status code and body of the response to w.
+	writeDeleteItemResponse(w http.ResponseWriter) error
+}
+
+// WriteDeleteItemResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteDeleteItemResponse(w http.ResponseWriter, resp DeleteItemResponse) error {
+	return resp.writeDeleteItemResponse(w)
+}
+
+// DeleteItem204 is the status-code only 204 response of the DeleteItem endpoint.
+type DeleteItem204 struct{}
+
+// NewDeleteItem204 creates a new instance of DeleteItem204
+func NewDeleteItem204() *DeleteItem204 {
+	return &DeleteItem204{}
+}
+
+func (resp *DeleteItem204) writeDeleteItemResponse(w http.ResponseWriter) error {
+	// Set status code and write the header as there are no headers or body to write
+	w.WriteHeader(204)
+	return nil
+}
+
+// Write204 writes the DeleteItem204 response to the http.ResponseWriter
+//
+// The item was deleted.
+//
+// NOTE: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+//
+// Since there are no headers or body to write, this function will only set the status code in the response header.
+func (r *DeleteItemReq) Write204(w http.ResponseWriter) error {
+	return (&DeleteItem204{}).writeDeleteItemResponse(w)
+}
+
+func NewDeleteItem404(
+
+	body *ErrorResponse,
+
+) *DeleteItem404 {
+	return &DeleteItem404{
+
+		Body: body,
+	}
+}
+
+func (resp *DeleteItem404) writeDeleteItemResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(404)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write404 writes the DeleteItem404 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *DeleteItemReq) Write404(w http.ResponseWriter, resp *DeleteItem404) error {
+	return resp.writeDeleteItemResponse(w)
+}
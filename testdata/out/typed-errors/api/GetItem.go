@@ -0,0 +1,155 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	GetItemReqHTTPMethod = "GET"
+	GetItemReqRoutePath  = "/items/{itemId}"
+)
+
+// Retrieve an item by its ID.
+type GetItemReq struct {
+
+	// Source: path parameter "{itemId}"
+	//
+
+	// The unique identifier of the item.
+	//
+	// Required
+	ItemId string
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// The item.
+type GetItem200 struct {
+
+	// Response body
+	Body *Item
+}
+
+// Item Not Found
+type GetItem404 struct {
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// ParseGetItemReq creates a new instance of GetItemReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
+func ParseGetItemReq(w http.ResponseWriter, r *http.Request) (*GetItemReq, error) {
+	req := GetItemReq{}
+	verr := &ValidationError{}
+
+	// Parse path parameters, if any
+
+	valItemId, err := parsestringParam(r.PathValue("itemId"), "itemId", true)
+	if err != nil {
+		verr.addParamError(ValidationLocationPath, "itemId", err)
+	} else if valItemId != nil {
+		req.ItemId = *valItemId
+	}
+
+	// Parse query parameters, if any
+
+	// Parse header parameters, if any
+
+	// Required auth, if any
+
+	// Atleast one auth, if any
+
+	// All auth of one of the alternatives, if any
+
+	if len(verr.Issues) > 0 {
+		return &GetItemReq{}, newParseError(verr)
+	}
+	return &req, nil
+}
+
+// GetItemResponse is one of the responses defined for the GetItem endpoint:
+//   - 200: GetItem200
+//   - 404: GetItem404
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type GetItemResponse interface {
+	// writeGetItemResponse writes the headers, status code and body of the response to w.
+	writeGetItemResponse(w http.ResponseWriter) error
+}
+
+// WriteGetItemResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteGetItemResponse(w http.ResponseWriter, resp GetItemResponse) error {
+	return resp.writeGetItemResponse(w)
+}
+
+func NewGetItem200(
+
+	body *Item,
+
+) *GetItem200 {
+	return &GetItem200{
+
+		Body: body,
+	}
+}
+
+func (resp *GetItem200) writeGetItemResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(200)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write200 writes the GetItem200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *GetItemReq) Write200(w http.ResponseWriter, resp *GetItem200) error {
+	return resp.writeGetItemResponse(w)
+}
+
+func NewGetItem404(
+
+	body *ErrorResponse,
+
+) *GetItem404 {
+	return &GetItem404{
+
+		Body: body,
+	}
+}
+
+func (resp *GetItem404) writeGetItemResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(404)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write404 writes the GetItem404 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *GetItemReq) Write404(w http.ResponseWriter, resp *GetItem404) error {
+	return resp.writeGetItemResponse(w)
+}
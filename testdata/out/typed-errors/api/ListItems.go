@@ -0,0 +1,170 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	ListItemsReqHTTPMethod = "GET"
+	ListItemsReqRoutePath  = "/items"
+)
+
+// List the IDs of the items, by the offset of the first one.
+type ListItemsReq struct {
+
+	// Source: query parameter "limit"
+	//
+
+	// The maximum number of items of the page. Default = 10.
+	//
+	// Optional
+	Limit *int64
+
+	// Source: query parameter "offset"
+	//
+
+	// The offset of the first item of the page. Default = 0.
+	//
+	// Optional
+	Offset *int64
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// OK
+type ListItems200 struct {
+
+	// Response body
+	Body *ItemPage
+}
+
+// Bad Request
+type ListItems400 struct {
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// ParseListItemsReq creates a new instance of ListItemsReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
+func ParseListItemsReq(w http.ResponseWriter, r *http.Request) (*ListItemsReq, error) {
+	req := ListItemsReq{}
+	verr := &ValidationError{}
+
+	// Parse path parameters, if any
+
+	// Parse query parameters, if any
+
+	valLimit, err := parseint64Param(r.URL.Query().Get("limit"), "limit", false)
+	if err != nil {
+		verr.addParamError(ValidationLocationQuery, "limit", err)
+	} else if valLimit != nil {
+		req.Limit = valLimit
+	}
+
+	valOffset, err := parseint64Param(r.URL.Query().Get("offset"), "offset", false)
+	if err != nil {
+		verr.addParamError(ValidationLocationQuery, "offset", err)
+	} else if valOffset != nil {
+		req.Offset = valOffset
+	}
+
+	// Parse header parameters, if any
+
+	// Required auth, if any
+
+	// Atleast one auth, if any
+
+	// All auth of one of the alternatives, if any
+
+	if len(verr.Issues) > 0 {
+		return &ListItemsReq{}, newParseError(verr)
+	}
+	return &req, nil
+}
+
+// ListItemsResponse is one of the responses defined for the ListItems endpoint:
+//   - 200: ListItems200
+//   - 400: ListItems400
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type ListItemsResponse interface {
+	// writeListItemsResponse writes the headers, status code and body of the response to w.
+	writeListItemsResponse(w http.ResponseWriter) error
+}
+
+// WriteListItemsResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WriteListItemsResponse(w http.ResponseWriter, resp ListItemsResponse) error {
+	return resp.writeListItemsResponse(w)
+}
+
+func NewListItems200(
+
+	body *ItemPage,
+
+) *ListItems200 {
+	return &ListItems200{
+
+		Body: body,
+	}
+}
+
+func (resp *ListItems200) writeListItemsResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(200)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write200 writes the ListItems200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *ListItemsReq) Write200(w http.ResponseWriter, resp *ListItems200) error {
+	return resp.writeListItemsResponse(w)
+}
+
+func NewListItems400(
+
+	body *ErrorResponse,
+
+) *ListItems400 {
+	return &ListItems400{
+
+		Body: body,
+	}
+}
+
+func (resp *ListItems400) writeListItemsResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(400)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write400 writes the ListItems400 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *ListItemsReq) Write400(w http.ResponseWriter, resp *ListItems400) error {
+	return resp.writeListItemsResponse(w)
+}
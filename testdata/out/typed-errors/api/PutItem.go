@@ -0,0 +1,270 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+)
+
+const (
+	PutItemReqHTTPMethod = "PUT"
+	PutItemReqRoutePath  = "/items/{itemId}"
+)
+
+// Create or replace an item.
+type PutItemReq struct {
+
+	// Source: path parameter "{itemId}"
+	//
+
+	// The unique identifier of the item.
+	//
+	// Required
+	ItemId string
+
+	// Request body
+	Body *PutItemRequestBody
+
+	// NOTE: The RawBody field is not used here, as RequestBodyName and RawBody are mutually exclusive.
+	// RawBody is only used in the golang client sdk generation, since that will make NewRequest functions more ergonomic to use for endpoints without a request body schema.
+	// RawBody doesn't affect the structure of the request struct, and will not be unmarshalled/read by the server side NewRequest functions.
+}
+
+// The item was replaced.
+type PutItem200 struct {
+
+	// Response body
+	Body *Item
+}
+
+// The item was created.
+type PutItem201 struct {
+
+	// Response body
+	Body *Item
+}
+
+// Bad Request
+type PutItem400 struct {
+
+	// Response body
+	Body *ErrorResponse
+}
+
+// Payload Too Large - the request body exceeds the maximum allowed size
+type PutItem413Response struct {
+
+	// Raw response body. If set, it is copied to the http.ResponseWriter after the headers and status code are written.
+	// If nil, only the headers and status code are written, and writing the body is the responsibility of the caller.
+	RawBody io.Reader
+}
+
+// ParsePutItemReq creates a new instance of PutItemReq by parsing the http.Request
+//
+// Parameters, authentication and the request body are all validated in one pass,
+// and every issue found is returned together in a *ParseError, whose Kind tells how to answer the request.
+func ParsePutItemReq(w http.ResponseWriter, r *http.Request) (*PutItemReq, error) {
+	req := PutItemReq{}
+	verr := &ValidationError{}
+
+	// Parse path parameters, if any
+
+	valItemId, err := parsestringParam(r.PathValue("itemId"), "itemId", true)
+	if err != nil {
+		verr.addParamError(ValidationLocationPath, "itemId", err)
+	} else if valItemId != nil {
+		req.ItemId = *valItemId
+	}
+
+	// Parse query parameters, if any
+
+	// Parse header parameters, if any
+
+	// Limit the request body before anything reads it: the hmac auth, if any, hashes it before it is parsed
+
+	maxBodyBytes := int64(256 << 10) // Default max body bytes: 256KB
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+
+	// Required auth, if any
+
+	// Atleast one auth, if any
+
+	// All auth of one of the alternatives, if any
+
+	// Parse request body
+	defer r.Body.Close()
+	// A missing Content-Type is accepted, any other value must match the endpoint's content type.
+	supportedContentType := true
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		if mediaType, _, err := mime.ParseMediaType(contentType); err != nil || mediaType != "application/json" {
+			supportedContentType = false
+			verr.add(ValidationLocationHeader, "Content-Type", ValidationCodeUnsupportedContentType, fmt.Sprintf("unsupported content type '%s', expected 'application/json'", contentType))
+		}
+	}
+	if !supportedContentType {
+		// don't try to decode a body in a format we don't understand
+	} else if bodyData, err := io.ReadAll(r.Body); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			verr.add(ValidationLocationBody, "", ValidationCodeBodyTooLarge, fmt.Sprintf("request body exceeds the maximum allowed size of %d bytes", maxBytesErr.Limit))
+		} else {
+			verr.add(ValidationLocationBody, "", ValidationCodeMalformed, fmt.Sprintf("error reading request body: %v", err))
+		}
+	} else {
+		// Decoded straight into the struct, see PutItemRequestBody.UnmarshalJSON
+		req.Body = new(PutItemRequestBody)
+		req.Body.decodeJSON(bodyData, "", verr)
+	}
+
+	if len(verr.Issues) > 0 {
+		return &PutItemReq{}, newParseError(verr)
+	}
+	return &req, nil
+}
+
+// PutItemResponse is one of the responses defined for the PutItem endpoint:
+//   - 200: PutItem200
+//   - 201: PutItem201
+//   - 400: PutItem400
+//   - 413: PutItem413Response
+//
+// Only the generated response types implement it, so a handler cannot return a status that is not in the specification.
+type PutItemResponse interface {
+	// writePutItemResponse writes the headers, status code and body of the response to w.
+	writePutItemResponse(w http.ResponseWriter) error
+}
+
+// WritePutItemResponse writes resp to the http.ResponseWriter.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func WritePutItemResponse(w http.ResponseWriter, resp PutItemResponse) error {
+	return resp.writePutItemResponse(w)
+}
+
+func NewPutItem200(
+
+	body *Item,
+
+) *PutItem200 {
+	return &PutItem200{
+
+		Body: body,
+	}
+}
+
+func (resp *PutItem200) writePutItemResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(200)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write200 writes the PutItem200 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *PutItemReq) Write200(w http.ResponseWriter, resp *PutItem200) error {
+	return resp.writePutItemResponse(w)
+}
+
+func NewPutItem201(
+
+	body *Item,
+
+) *PutItem201 {
+	return &PutItem201{
+
+		Body: body,
+	}
+}
+
+func (resp *PutItem201) writePutItemResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(201)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write201 writes the PutItem201 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *PutItemReq) Write201(w http.ResponseWriter, resp *PutItem201) error {
+	return resp.writePutItemResponse(w)
+}
+
+func NewPutItem400(
+
+	body *ErrorResponse,
+
+) *PutItem400 {
+	return &PutItem400{
+
+		Body: body,
+	}
+}
+
+func (resp *PutItem400) writePutItemResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set Content-Type
+	w.Header().Set("Content-Type", "application/json")
+
+	// Set status code and write the header
+	w.WriteHeader(400)
+
+	// Write body
+	return json.NewEncoder(w).Encode(resp.Body)
+
+}
+
+// Write400 writes the PutItem400 response to the http.ResponseWriter
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *PutItemReq) Write400(w http.ResponseWriter, resp *PutItem400) error {
+	return resp.writePutItemResponse(w)
+}
+
+func NewPutItem413Response() *PutItem413Response {
+	return &PutItem413Response{}
+}
+
+func (resp *PutItem413Response) writePutItemResponse(w http.ResponseWriter) error {
+	// Set headers, if any
+
+	// Set status code and write the header
+	w.WriteHeader(413)
+
+	// Copy the raw body, if any, otherwise writing it is left to the caller
+	if resp.RawBody != nil {
+		_, err := io.Copy(w, resp.RawBody)
+		return err
+	}
+	return nil
+
+}
+
+// Write413 writes the PutItem413Response response to the http.ResponseWriter
+//
+// RawBody is true, hence unless resp.RawBody is set, this function will only set the headers and write the status code, rest is to be done by the caller.
+//
+// Note: THIS FUNCTION WILL CALL w.WriteHeader(), so ensure that no other calls to w.WriteHeader() are made before calling this function.
+func (r *PutItemReq) Write413(w http.ResponseWriter, resp *PutItem413Response) error {
+	return resp.writePutItemResponse(w)
+}
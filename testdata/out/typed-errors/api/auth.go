@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Principal is the identity an Authenticator resolves a credential to, e.g. a user or an API client.
+//
+// Its concrete type is chosen by the server implementation.
+type Principal any
+
+// principalKey is the context key of the Principal resolved for an auth method, by its ID in the specification.
+type principalKey string
+
+// principalValue wraps a Principal in the context, so that a nil Principal is still distinguishable from no Principal.
+type principalValue struct {
+	Principal Principal
+}
+
+func withPrincipal(ctx context.Context, authMethodID string, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey(authMethodID), principalValue{Principal: principal})
+}
+
+// The <type>Auth functions extract the credential of an auth method of that type from r.
+//
+// They return false if the credential is missing or empty, and an error if it is present but malformed.
+// A header with another scheme than the one expected by bearer, oauth2ClientCredentials and basic counts as missing,
+// since they can share the Authorization header.
+
+func headerAuth(r *http.Request, name string) (string, bool, error) {
+	value := strings.TrimSpace(r.Header.Get(name))
+	return value, value != "", nil
+}
+
+func queryAuth(r *http.Request, name string) (string, bool, error) {
+	value := strings.TrimSpace(r.URL.Query().Get(name))
+	return value, value != "", nil
+}
+
+func cookieAuth(r *http.Request, name string) (string, bool, error) {
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return "", false, nil
+	}
+	value := strings.TrimSpace(cookie.Value)
+	return value, value != "", nil
+}
+
+func bearerAuth(r *http.Request, name string) (string, bool, error) {
+	scheme, token, _ := strings.Cut(strings.TrimSpace(r.Header.Get(name)), " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", false, nil
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", true, errors.New("malformed authentication: " + name + " must be 'Bearer <token>'")
+	}
+	return token, true, nil
+}
+
+// oauth2ClientCredentialsAuth extracts an OAuth2 access token, which is sent as a bearer token.
+func oauth2ClientCredentialsAuth(r *http.Request, name string) (string, bool, error) {
+	return bearerAuth(r, name)
+}
+
+func basicAuth(r *http.Request, name string) (BasicAuthCredentials, bool, error) {
+	scheme, encoded, _ := strings.Cut(strings.TrimSpace(r.Header.Get(name)), " ")
+	if !strings.EqualFold(scheme, "Basic") {
+		return BasicAuthCredentials{}, false, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return BasicAuthCredentials{}, true, errors.New("malformed authentication: " + name + " must be 'Basic <base64(username:password)>'")
+	}
+	username, password, ok := strings.Cut(string(decoded), ":")
+	if !ok || username == "" {
+		return BasicAuthCredentials{}, true, errors.New("malformed authentication: " + name + " must contain a username and password")
+	}
+	return BasicAuthCredentials{Username: username, Password: password}, true, nil
+}
+
+// authFormat is the enforced Format of an auth method: either a "{token}" template, given by the text
+// around the placeholder, or a "^...$" pattern, whose "token" group (or whole match, without one) is the credential.
+type authFormat struct {
+	format         string
+	prefix, suffix string
+	pattern        *regexp.Regexp
+}
+
+// strip returns the credential held by value, or an error if value does not match the format.
+func (f authFormat) strip(name, value string) (string, error) {
+	var credential string
+	if f.pattern != nil {
+		if match := f.pattern.FindStringSubmatch(value); match != nil {
+			credential = match[0]
+			if idx := f.pattern.SubexpIndex("token"); idx > 0 {
+				credential = match[idx]
+			}
+		}
+	} else if len(value) > len(f.prefix)+len(f.suffix) && strings.HasPrefix(value, f.prefix) && strings.HasSuffix(value, f.suffix) {
+		credential = value[len(f.prefix) : len(value)-len(f.suffix)]
+	}
+	credential = strings.TrimSpace(credential)
+	if credential == "" {
+		return "", errors.New("malformed authentication: " + name + " must match the format '" + f.format + "'")
+	}
+	return credential, nil
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"net/http"
+)
+
+// ParseErrorKind is the category of a request rejected by a Parse<Endpoint>Req function.
+type ParseErrorKind string
+
+const (
+	// Required authentication is missing, malformed or rejected by an Authenticator. Maps to 401 Unauthorized.
+	ParseErrorKindUnauthenticated ParseErrorKind = "unauthenticated"
+
+	// The authenticated caller lacks a scope required by the endpoint, as decided by the ScopeChecker. Maps to 403 Forbidden.
+	ParseErrorKindForbidden ParseErrorKind = "forbidden"
+
+	// The request body exceeds the maximum allowed size. Maps to 413 Payload Too Large.
+	ParseErrorKindBodyTooLarge ParseErrorKind = "body_too_large"
+
+	// The request Content-Type is not the one defined for the endpoint. Maps to 415 Unsupported Media Type.
+	ParseErrorKindUnsupportedContentType ParseErrorKind = "unsupported_content_type"
+
+	// Parameters or the request body are invalid. Maps to 400 Bad Request.
+	ParseErrorKindInvalidInput ParseErrorKind = "invalid_input"
+
+	// The Idempotency-Key is used by a request in progress, see IdempotencyStore. Maps to 409 Conflict.
+	ParseErrorKindIdempotencyKeyInUse ParseErrorKind = "idempotency_key_in_use"
+
+	// The Idempotency-Key was used for a different request, see IdempotencyStore. Maps to 422 Unprocessable Entity.
+	ParseErrorKindIdempotencyKeyReused ParseErrorKind = "idempotency_key_reused"
+)
+
+// ParseError is the error returned by the Parse<Endpoint>Req functions.
+//
+// Kind is derived from the validation issues, in order of precedence:
+// unauthenticated, unsupported content type, body too large, invalid input.
+type ParseError struct {
+	Kind ParseErrorKind
+
+	// Every issue found while parsing the request, including the one(s) that determined Kind.
+	Validation *ValidationError
+
+	// The errors returned by the Authenticators that rejected the request's credentials, by the ScopeChecker,
+	// or by the IdempotencyStore, if any.
+	//
+	// These are not part of Validation, which may be written to the client.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	if e.Err != nil {
+		return string(e.Kind) + ": " + e.Validation.Error() + ": " + e.Err.Error()
+	}
+	return string(e.Kind) + ": " + e.Validation.Error()
+}
+
+func (e *ParseError) Unwrap() []error {
+	return []error{e.Validation, e.Err}
+}
+
+// StatusCode returns the HTTP status code the request should be answered with.
+func (e *ParseError) StatusCode() int {
+	switch e.Kind {
+	case ParseErrorKindUnauthenticated:
+		return http.StatusUnauthorized
+	case ParseErrorKindForbidden:
+		return http.StatusForbidden
+	case ParseErrorKindBodyTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case ParseErrorKindUnsupportedContentType:
+		return http.StatusUnsupportedMediaType
+	case ParseErrorKindIdempotencyKeyInUse:
+		return http.StatusConflict
+	case ParseErrorKindIdempotencyKeyReused:
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func newParseError(verr *ValidationError) *ParseError {
+	var auth, contentType, tooLarge bool
+	for _, issue := range verr.Issues {
+		switch {
+		case issue.Location == ValidationLocationAuth:
+			auth = true
+		case issue.Code == ValidationCodeUnsupportedContentType:
+			contentType = true
+		case issue.Code == ValidationCodeBodyTooLarge:
+			tooLarge = true
+		}
+	}
+
+	kind := ParseErrorKindInvalidInput
+	switch {
+	case auth:
+		kind = ParseErrorKindUnauthenticated
+	case contentType:
+		kind = ParseErrorKindUnsupportedContentType
+	case tooLarge:
+		kind = ParseErrorKindBodyTooLarge
+	}
+	return &ParseError{
+		Kind:       kind,
+		Validation: verr,
+	}
+}
@@ -0,0 +1,277 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var TypedErrorsAPIVersion = "1.0.0"
+
+// BasicAuthCredentials are the credentials of a "basic" auth method,
+// sent as "Basic <base64(Username:Password)>".
+type BasicAuthCredentials struct {
+	Username string
+	Password string
+}
+
+// ValidationLocation is the part of the request in which a validation issue was found.
+type ValidationLocation string
+
+const (
+	ValidationLocationBody   ValidationLocation = "body"
+	ValidationLocationQuery  ValidationLocation = "query"
+	ValidationLocationPath   ValidationLocation = "path"
+	ValidationLocationHeader ValidationLocation = "header"
+	ValidationLocationAuth   ValidationLocation = "auth"
+)
+
+// ValidationCode is the machine-readable category of a validation issue.
+type ValidationCode string
+
+const (
+	// A required field, parameter or authentication value is missing.
+	ValidationCodeRequired ValidationCode = "required"
+
+	// A string, array or object that must be non-empty is empty.
+	ValidationCodeNonEmpty ValidationCode = "non_empty"
+
+	// The value has the wrong type, e.g. a string where a number is expected.
+	ValidationCodeInvalidType ValidationCode = "invalid_type"
+
+	// The value has the right type but is not allowed, e.g. an unknown enum value.
+	ValidationCodeInvalidValue ValidationCode = "invalid_value"
+
+	// The value could not be decoded at all, e.g. a request body that is not valid JSON.
+	ValidationCodeMalformed ValidationCode = "malformed"
+
+	// The request body exceeds the maximum allowed size.
+	ValidationCodeBodyTooLarge ValidationCode = "body_too_large"
+
+	// The request Content-Type is not the one defined for the endpoint.
+	ValidationCodeUnsupportedContentType ValidationCode = "unsupported_content_type"
+
+	// The authenticated caller lacks a scope required by the endpoint.
+	ValidationCodeInsufficientScope ValidationCode = "insufficient_scope"
+
+	// The Idempotency-Key is used by a request in progress.
+	ValidationCodeIdempotencyKeyInUse ValidationCode = "idempotency_key_in_use"
+
+	// The Idempotency-Key was used for a different request.
+	ValidationCodeIdempotencyKeyReused ValidationCode = "idempotency_key_reused"
+)
+
+// ValidationIssue describes a single validation failure.
+type ValidationIssue struct {
+	// Path of the offending value: a dotted field path for the body (e.g. "Users[2].Email"),
+	// or the transport name for parameters and authentication (e.g. "pageSize").
+	// It is empty if the issue is not about a single value, e.g. when no set of authentication parameters is complete.
+	Path     string             `json:"Path"`
+	Location ValidationLocation `json:"Location"`
+	Code     ValidationCode     `json:"Code"`
+	Message  string             `json:"Message"`
+}
+
+// ValidationError is returned by the generated Parse functions, and holds every validation issue found in one pass.
+type ValidationError struct {
+	Issues []ValidationIssue `json:"Issues"`
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		if issue.Path == "" {
+			msgs[i] = fmt.Sprintf("%s: %s", issue.Location, issue.Message)
+		} else {
+			msgs[i] = fmt.Sprintf("%s '%s': %s", issue.Location, issue.Path, issue.Message)
+		}
+	}
+	return "validation failed: " + strings.Join(msgs, "; ")
+}
+
+func (e *ValidationError) add(location ValidationLocation, path string, code ValidationCode, message string) {
+	e.Issues = append(e.Issues, ValidationIssue{
+		Path:     path,
+		Location: location,
+		Code:     code,
+		Message:  message,
+	})
+}
+
+// addParamError records an error returned by one of the parse<Type>Param functions.
+func (e *ValidationError) addParamError(location ValidationLocation, path string, err error) {
+	code := ValidationCodeInvalidType
+	if pErr, ok := err.(*paramError); ok {
+		code = pErr.code
+	}
+	e.add(location, path, code, err.Error())
+}
+
+// errOrNil returns e as an error if it holds any issues, and nil otherwise.
+func (e *ValidationError) errOrNil() error {
+	if len(e.Issues) == 0 {
+		return nil
+	}
+	return e
+}
+
+func joinValidationPath(path string, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+type paramError struct {
+	code    ValidationCode
+	message string
+}
+
+func (e *paramError) Error() string {
+	return e.message
+}
+
+func missingParamError(paramName string) error {
+	return &paramError{
+		code:    ValidationCodeRequired,
+		message: fmt.Sprintf("missing required parameter '%s'", paramName),
+	}
+}
+
+func parseint64Param(param string, paramName string, required bool) (*int64, error) {
+	param = strings.TrimSpace(param)
+	if param == "" {
+		if required {
+			return nil, missingParamError(paramName)
+		}
+		return nil, nil
+	}
+
+	value, err := strconv.ParseInt(strings.TrimSpace(param), 10, 64)
+	if err != nil {
+		return nil, &paramError{
+			code:    ValidationCodeInvalidType,
+			message: fmt.Sprintf("invalid integer parameter '%s': %v", paramName, err),
+		}
+	}
+
+	return &value, nil
+}
+
+func parsefloat64Param(param string, paramName string, required bool) (*float64, error) {
+	param = strings.TrimSpace(param)
+	if param == "" {
+		if required {
+			return nil, missingParamError(paramName)
+		}
+		return nil, nil
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(param), 64)
+	if err != nil {
+		return nil, &paramError{
+			code:    ValidationCodeInvalidType,
+			message: fmt.Sprintf("invalid number parameter '%s': %v", paramName, err),
+		}
+	}
+
+	return &value, nil
+}
+
+func parseboolParam(param string, paramName string, required bool) (*bool, error) {
+	param = strings.TrimSpace(param)
+	if param == "" {
+		if required {
+			return nil, missingParamError(paramName)
+		}
+		return nil, nil
+	}
+
+	value, err := strconv.ParseBool(param)
+	if err != nil {
+		return nil, &paramError{
+			code:    ValidationCodeInvalidType,
+			message: fmt.Sprintf("invalid boolean parameter '%s': %v", paramName, err),
+		}
+	}
+
+	return &value, nil
+}
+
+func parsestringParam(param string, paramName string, required bool) (*string, error) {
+	param = strings.TrimSpace(param)
+	if param == "" {
+		if required {
+			return nil, missingParamError(paramName)
+		}
+		return nil, nil
+	}
+	return &param, nil
+}
+
+func paramToString(param interface{}, paramName string, goType string, required bool) (string, error) {
+	if param == nil {
+		if required {
+			return "", fmt.Errorf("missing required parameter '%s'", paramName)
+		}
+		return "", nil
+	}
+	strValue := ""
+	switch goType {
+	case "string":
+		strValue, _ = param.(string)
+	case "*string":
+		ptrValue, _ := param.(*string)
+		if ptrValue != nil {
+			strValue = *ptrValue
+		}
+	case "float64":
+		floatValue, ok := param.(float64)
+		if !ok {
+			return "", fmt.Errorf("invalid float64 parameter '%s'", paramName)
+		}
+		strValue = fmt.Sprintf("%f", floatValue)
+	case "*float64":
+		ptrValue, ok := param.(*float64)
+		if !ok {
+			return "", fmt.Errorf("invalid *float64 parameter '%s'", paramName)
+		}
+		if ptrValue != nil {
+			strValue = fmt.Sprintf("%f", *ptrValue)
+		}
+	case "int64":
+		intValue, ok := param.(int64)
+		if !ok {
+			return "", fmt.Errorf("invalid int64 parameter '%s'", paramName)
+		}
+		strValue = fmt.Sprintf("%d", intValue)
+	case "*int64":
+		ptrValue, ok := param.(*int64)
+		if !ok {
+			return "", fmt.Errorf("invalid *int64 parameter '%s'", paramName)
+		}
+		if ptrValue != nil {
+			strValue = fmt.Sprintf("%d", *ptrValue)
+		}
+	case "bool":
+		boolValue, ok := param.(bool)
+		if !ok {
+			return "", fmt.Errorf("invalid bool parameter '%s'", paramName)
+		}
+		strValue = fmt.Sprintf("%t", boolValue)
+	case "*bool":
+		ptrValue, ok := param.(*bool)
+		if !ok {
+			return "", fmt.Errorf("invalid *bool parameter '%s'", paramName)
+		}
+		if ptrValue != nil {
+			strValue = fmt.Sprintf("%t", *ptrValue)
+		}
+	default:
+		return "", fmt.Errorf("unsupported goType '%s' for parameter '%s'", goType, paramName)
+	}
+	if strValue == "" && required {
+		return "", fmt.Errorf("missing required parameter '%s'", paramName)
+	}
+	return strValue, nil
+}
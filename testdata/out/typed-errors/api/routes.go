@@ -0,0 +1,203 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// Handler is implemented by the API server, with one method per endpoint in the specification.
+//
+// RegisterRoutes parses and validates every request before calling the corresponding method,
+// so implementations only receive requests that passed the generated Parse<Endpoint>Req checks.
+//
+// Each method returns exactly one of the responses defined for its endpoint, which RegisterRoutes writes.
+// The http.Request is passed for its context and, for rawBody endpoints, its body.
+//
+// It also embeds the Authenticator of every auth method used by the endpoints, which RegisterRoutes calls
+// to verify the credentials of a request before the endpoint method.
+type Handler interface {
+
+	// GetItem handles GET /items/{itemId}
+	//
+	// Retrieve an item by its ID.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	GetItem(r *http.Request, req *GetItemReq) (GetItemResponse, error)
+
+	// DeleteItem handles DELETE /items/{itemId}
+	//
+	// Delete an item by its ID.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	DeleteItem(r *http.Request, req *DeleteItemReq) (DeleteItemResponse, error)
+
+	// PutItem handles PUT /items/{itemId}
+	//
+	// Create or replace an item.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	PutItem(r *http.Request, req *PutItemReq) (PutItemResponse, error)
+
+	// ListItems handles GET /items
+	//
+	// List the IDs of the items, by the offset of the first one.
+	//
+	// The returned response is written by RegisterRoutes. A non-nil error is answered with 500 Internal Server Error.
+	ListItems(r *http.Request, req *ListItemsReq) (ListItemsResponse, error)
+
+	// RenderParseError returns the body written for a request that failed parsing, with status err.StatusCode().
+	//
+	// A nil return writes the response with an empty body.
+	RenderParseError(r *http.Request, err *ParseError) *ErrorResponse
+}
+
+// Route describes an endpoint of the specification, as registered by RegisterRoutes.
+type Route struct {
+	// Name of the endpoint, e.g. "CreateUser".
+	Name string
+
+	Method string
+
+	// Path pattern of the endpoint, e.g. "/users/{userId}".
+	Path string
+
+	// Authorization scopes required by the endpoint, if any.
+	Scopes []string
+}
+
+var (
+	// GetItemRoute is the Route of the GetItem endpoint.
+	GetItemRoute = Route{Name: "GetItem", Method: GetItemReqHTTPMethod, Path: GetItemReqRoutePath}
+
+	// DeleteItemRoute is the Route of the DeleteItem endpoint.
+	DeleteItemRoute = Route{Name: "DeleteItem", Method: DeleteItemReqHTTPMethod, Path: DeleteItemReqRoutePath}
+
+	// PutItemRoute is the Route of the PutItem endpoint.
+	PutItemRoute = Route{Name: "PutItem", Method: PutItemReqHTTPMethod, Path: PutItemReqRoutePath}
+
+	// ListItemsRoute is the Route of the ListItems endpoint.
+	ListItemsRoute = Route{Name: "ListItems", Method: ListItemsReqHTTPMethod, Path: ListItemsReqRoutePath}
+)
+
+// Routes lists the Route of every endpoint in the specification.
+var Routes = []Route{
+	GetItemRoute,
+	DeleteItemRoute,
+	PutItemRoute,
+	ListItemsRoute,
+}
+
+// RegisterRoutes registers a route on mux for every endpoint in the specification.
+//
+// Routes use "METHOD /path/{param}" patterns, so http.ServeMux rejects requests with a wrong method
+// with 405 Method Not Allowed before they reach impl.
+//
+// Requests that fail parsing are rejected with the status code of the *ParseError (see ParseError.StatusCode),
+// and the ErrorResponse returned by impl.RenderParseError as the JSON body.
+func RegisterRoutes(mux *http.ServeMux, impl Handler) {
+
+	mux.HandleFunc(GetItemReqHTTPMethod+" "+GetItemReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseGetItemReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.GetItem(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteGetItemResponse(w, resp)
+	})
+
+	mux.HandleFunc(DeleteItemReqHTTPMethod+" "+DeleteItemReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseDeleteItemReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.DeleteItem(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteDeleteItemResponse(w, resp)
+	})
+
+	mux.HandleFunc(PutItemReqHTTPMethod+" "+PutItemReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParsePutItemReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.PutItem(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WritePutItemResponse(w, resp)
+	})
+
+	mux.HandleFunc(ListItemsReqHTTPMethod+" "+ListItemsReqRoutePath, func(w http.ResponseWriter, r *http.Request) {
+		req, err := ParseListItemsReq(w, r)
+		if err != nil {
+			writeParseError(w, r, impl, err)
+			return
+		}
+
+		resp, err := impl.ListItems(r, req)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if resp == nil {
+			http.Error(w, "handler returned no response", http.StatusInternalServerError)
+			return
+		}
+		// The error is deliberately ignored: it comes from writing the body, after the status code was sent,
+		// and is most often a client that went away.
+		_ = WriteListItemsResponse(w, resp)
+	})
+
+}
+
+// writeParseError writes the response for an error returned by a Parse<Endpoint>Req function.
+func writeParseError(w http.ResponseWriter, r *http.Request, impl Handler, err error) {
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	body := impl.RenderParseError(r, perr)
+	if body == nil {
+		w.WriteHeader(perr.StatusCode())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(perr.StatusCode())
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,825 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type ErrorResponse struct {
+
+	// A detailed debug message for developers. Only passed if in debug mode.
+	//
+	// Optional
+	//
+	DebugMessage *string `json:"DebugMessage,omitempty"`
+
+	// An error message which is user-friendly.
+	//
+	// Required
+	//
+	// Must be non-empty
+	ErrorMessage string `json:"ErrorMessage"`
+}
+
+// NewErrorResponse creates a new instance of ErrorResponse with required fields as parameters
+func NewErrorResponse(
+
+	ErrorMessage string,
+
+) *ErrorResponse {
+	return &ErrorResponse{
+
+		ErrorMessage: ErrorMessage,
+	}
+}
+
+// WithDebugMessage sets the optional field DebugMessage and returns the modified ErrorResponse instance
+func (o *ErrorResponse) WithDebugMessage(value string) *ErrorResponse {
+	o.DebugMessage = &value
+	return o
+}
+
+// ParseErrorResponse creates a new instance of ErrorResponse from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseErrorResponse(data map[string]any) (*ErrorResponse, error) {
+	verr := &ValidationError{}
+	body := parseErrorResponse(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseErrorResponse parses data into a new ErrorResponse, recording issues in verr with paths relative to path.
+func parseErrorResponse(data map[string]any, path string, verr *ValidationError) *ErrorResponse {
+	body := new(ErrorResponse)
+
+	pathDebugMessage := joinValidationPath(path, "DebugMessage")
+
+	valDebugMessage, ok := data["DebugMessage"]
+	if !ok {
+
+		// skip, leave as zero value
+
+	} else {
+
+		if valDebugMessageTyped, ok := valDebugMessage.(string); !ok {
+			verr.add(ValidationLocationBody, pathDebugMessage, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valDebugMessageTyped = strings.TrimSpace(valDebugMessageTyped)
+
+			body.DebugMessage = &valDebugMessageTyped
+		}
+
+	}
+
+	pathErrorMessage := joinValidationPath(path, "ErrorMessage")
+
+	valErrorMessage, ok := data["ErrorMessage"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valErrorMessageTyped, ok := valErrorMessage.(string); !ok {
+			verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valErrorMessageTyped = strings.TrimSpace(valErrorMessageTyped)
+
+			if len(valErrorMessageTyped) == 0 {
+				verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.ErrorMessage = valErrorMessageTyped
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into ErrorResponse, with the same checks as ParseErrorResponse.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *ErrorResponse) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonErrorResponse holds the raw JSON of the fields of a ErrorResponse, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonErrorResponse struct {
+	DebugMessage jsonValue `json:"DebugMessage"`
+	ErrorMessage jsonValue `json:"ErrorMessage"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *ErrorResponse) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonErrorResponse
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseErrorResponse instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseErrorResponse(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *ErrorResponse) decodeRaw(raw *jsonErrorResponse, path string, verr *ValidationError) {
+
+	pathDebugMessage := joinValidationPath(path, "DebugMessage")
+	if raw.DebugMessage.absent() {
+
+		// skip, leave as zero value
+
+	} else if valDebugMessage, ok := decodeJSONValue[string](raw.DebugMessage, pathDebugMessage, "must be of type string", verr); ok {
+		valDebugMessage = strings.TrimSpace(valDebugMessage)
+
+		t.DebugMessage = &valDebugMessage
+	}
+
+	pathErrorMessage := joinValidationPath(path, "ErrorMessage")
+	if raw.ErrorMessage.absent() {
+
+		verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeRequired, "missing required field")
+
+	} else if valErrorMessage, ok := decodeJSONValue[string](raw.ErrorMessage, pathErrorMessage, "must be of type string", verr); ok {
+		valErrorMessage = strings.TrimSpace(valErrorMessage)
+
+		if len(valErrorMessage) == 0 {
+			verr.add(ValidationLocationBody, pathErrorMessage, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.ErrorMessage = valErrorMessage
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated ErrorResponse,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *ErrorResponse) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *ErrorResponse) validate(path string, verr *ValidationError) {
+
+	if len(strings.TrimSpace(t.ErrorMessage)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "ErrorMessage"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+}
+
+type Item struct {
+
+	// The unique identifier of the item.
+	//
+	// Required
+	//
+	// Must be non-empty
+	ItemId string `json:"ItemId"`
+
+	// The name of the item.
+	//
+	// Required
+	//
+	// Must be non-empty
+	Name string `json:"Name"`
+}
+
+// NewItem creates a new instance of Item with required fields as parameters
+func NewItem(
+
+	ItemId string,
+
+	Name string,
+
+) *Item {
+	return &Item{
+
+		ItemId: ItemId,
+
+		Name: Name,
+	}
+}
+
+// ParseItem creates a new instance of Item from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseItem(data map[string]any) (*Item, error) {
+	verr := &ValidationError{}
+	body := parseItem(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseItem parses data into a new Item, recording issues in verr with paths relative to path.
+func parseItem(data map[string]any, path string, verr *ValidationError) *Item {
+	body := new(Item)
+
+	pathItemId := joinValidationPath(path, "ItemId")
+
+	valItemId, ok := data["ItemId"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathItemId, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valItemIdTyped, ok := valItemId.(string); !ok {
+			verr.add(ValidationLocationBody, pathItemId, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valItemIdTyped = strings.TrimSpace(valItemIdTyped)
+
+			if len(valItemIdTyped) == 0 {
+				verr.add(ValidationLocationBody, pathItemId, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.ItemId = valItemIdTyped
+		}
+
+	}
+
+	pathName := joinValidationPath(path, "Name")
+
+	valName, ok := data["Name"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathName, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valNameTyped, ok := valName.(string); !ok {
+			verr.add(ValidationLocationBody, pathName, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valNameTyped = strings.TrimSpace(valNameTyped)
+
+			if len(valNameTyped) == 0 {
+				verr.add(ValidationLocationBody, pathName, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Name = valNameTyped
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into Item, with the same checks as ParseItem.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *Item) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonItem holds the raw JSON of the fields of a Item, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonItem struct {
+	ItemId jsonValue `json:"ItemId"`
+	Name   jsonValue `json:"Name"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *Item) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonItem
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseItem instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseItem(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *Item) decodeRaw(raw *jsonItem, path string, verr *ValidationError) {
+
+	pathItemId := joinValidationPath(path, "ItemId")
+	if raw.ItemId.absent() {
+
+		verr.add(ValidationLocationBody, pathItemId, ValidationCodeRequired, "missing required field")
+
+	} else if valItemId, ok := decodeJSONValue[string](raw.ItemId, pathItemId, "must be of type string", verr); ok {
+		valItemId = strings.TrimSpace(valItemId)
+
+		if len(valItemId) == 0 {
+			verr.add(ValidationLocationBody, pathItemId, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.ItemId = valItemId
+	}
+
+	pathName := joinValidationPath(path, "Name")
+	if raw.Name.absent() {
+
+		verr.add(ValidationLocationBody, pathName, ValidationCodeRequired, "missing required field")
+
+	} else if valName, ok := decodeJSONValue[string](raw.Name, pathName, "must be of type string", verr); ok {
+		valName = strings.TrimSpace(valName)
+
+		if len(valName) == 0 {
+			verr.add(ValidationLocationBody, pathName, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.Name = valName
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated Item,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *Item) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *Item) validate(path string, verr *ValidationError) {
+
+	if len(strings.TrimSpace(t.ItemId)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "ItemId"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+	if len(strings.TrimSpace(t.Name)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Name"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+}
+
+type ItemPage struct {
+
+	// The IDs of the items of the page.
+	//
+	// Required
+	//
+	ItemIds []string `json:"ItemIds"`
+
+	// The total number of items.
+	//
+	// Required
+	//
+	TotalCount int64 `json:"TotalCount"`
+}
+
+// NewItemPage creates a new instance of ItemPage with required fields as parameters
+func NewItemPage(
+
+	ItemIds []string,
+
+	TotalCount int64,
+
+) *ItemPage {
+	return &ItemPage{
+
+		ItemIds: ItemIds,
+
+		TotalCount: TotalCount,
+	}
+}
+
+// ParseItemPage creates a new instance of ItemPage from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParseItemPage(data map[string]any) (*ItemPage, error) {
+	verr := &ValidationError{}
+	body := parseItemPage(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parseItemPage parses data into a new ItemPage, recording issues in verr with paths relative to path.
+func parseItemPage(data map[string]any, path string, verr *ValidationError) *ItemPage {
+	body := new(ItemPage)
+
+	pathItemIds := joinValidationPath(path, "ItemIds")
+
+	valItemIds, ok := data["ItemIds"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathItemIds, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		valItemIdsSlice, ok := valItemIds.([]any)
+		if !ok {
+			verr.add(ValidationLocationBody, pathItemIds, ValidationCodeInvalidType, "must be an array")
+
+		} else if len(valItemIdsSlice) == 0 {
+			verr.add(ValidationLocationBody, pathItemIds, ValidationCodeNonEmpty, "must be non-empty")
+
+		} else {
+			valItemIdsTyped := make([]string, 0, len(valItemIdsSlice))
+			numIssues := len(verr.Issues)
+			for idx, item := range valItemIdsSlice {
+				itemPath := fmt.Sprintf("%s[%d]", pathItemIds, idx)
+
+				itemTyped, ok := item.(string)
+				if !ok {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeInvalidType, "must be a string")
+					continue
+				}
+				itemTyped = strings.TrimSpace(itemTyped)
+
+				if len(itemTyped) == 0 {
+					verr.add(ValidationLocationBody, itemPath, ValidationCodeNonEmpty, "must be non-empty")
+					continue
+				}
+
+				valItemIdsTyped = append(valItemIdsTyped, itemTyped)
+			}
+			if len(verr.Issues) == numIssues {
+				body.ItemIds = valItemIdsTyped
+			}
+		}
+
+	}
+
+	pathTotalCount := joinValidationPath(path, "TotalCount")
+
+	valTotalCount, ok := data["TotalCount"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		// JSON numbers are float64 by default, so we need to handle that case
+		switch v := valTotalCount.(type) {
+		case float64:
+			valTotalCountTyped := int64(v)
+			body.TotalCount = valTotalCountTyped
+		case int64:
+			body.TotalCount = v
+		default:
+			verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeInvalidType, "must be an integer")
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into ItemPage, with the same checks as ParseItemPage.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *ItemPage) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonItemPage holds the raw JSON of the fields of a ItemPage, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonItemPage struct {
+	ItemIds    []jsonValue `json:"ItemIds"`
+	TotalCount jsonValue   `json:"TotalCount"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *ItemPage) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonItemPage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parseItemPage instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parseItemPage(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *ItemPage) decodeRaw(raw *jsonItemPage, path string, verr *ValidationError) {
+
+	pathItemIds := joinValidationPath(path, "ItemIds")
+	if raw.ItemIds == nil {
+
+		verr.add(ValidationLocationBody, pathItemIds, ValidationCodeRequired, "missing required field")
+
+	} else if len(raw.ItemIds) == 0 {
+		verr.add(ValidationLocationBody, pathItemIds, ValidationCodeNonEmpty, "must be non-empty")
+
+	} else {
+		valItemIds := make([]string, 0, len(raw.ItemIds))
+		numIssues := len(verr.Issues)
+		for idx, rawItem := range raw.ItemIds {
+			itemPath := fmt.Sprintf("%s[%d]", pathItemIds, idx)
+
+			item, ok := decodeJSONValue[string](rawItem, itemPath, "must be a string", verr)
+			if !ok {
+				continue
+			}
+			item = strings.TrimSpace(item)
+
+			if len(item) == 0 {
+				verr.add(ValidationLocationBody, itemPath, ValidationCodeNonEmpty, "must be non-empty")
+				continue
+			}
+
+			valItemIds = append(valItemIds, item)
+		}
+		if len(verr.Issues) == numIssues {
+			t.ItemIds = valItemIds
+		}
+	}
+
+	pathTotalCount := joinValidationPath(path, "TotalCount")
+	if raw.TotalCount.absent() {
+
+		verr.add(ValidationLocationBody, pathTotalCount, ValidationCodeRequired, "missing required field")
+
+	} else if valTotalCount, ok := decodeJSONValue[int64](raw.TotalCount, pathTotalCount, "must be an integer", verr); ok {
+		t.TotalCount = valTotalCount
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated ItemPage,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *ItemPage) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *ItemPage) validate(path string, verr *ValidationError) {
+
+	if len(t.ItemIds) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "ItemIds"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+	for idx, item := range t.ItemIds {
+		itemPath := fmt.Sprintf("%s[%d]", joinValidationPath(path, "ItemIds"), idx)
+
+		if len(strings.TrimSpace(item)) == 0 {
+			verr.add(ValidationLocationBody, itemPath, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+	}
+
+}
+
+type PutItemRequestBody struct {
+
+	// The name of the item.
+	//
+	// Required
+	//
+	// Must be non-empty
+	Name string `json:"Name"`
+}
+
+// NewPutItemRequestBody creates a new instance of PutItemRequestBody with required fields as parameters
+func NewPutItemRequestBody(
+
+	Name string,
+
+) *PutItemRequestBody {
+	return &PutItemRequestBody{
+
+		Name: Name,
+	}
+}
+
+// ParsePutItemRequestBody creates a new instance of PutItemRequestBody from decoded JSON data, validating every field.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func ParsePutItemRequestBody(data map[string]any) (*PutItemRequestBody, error) {
+	verr := &ValidationError{}
+	body := parsePutItemRequestBody(data, "", verr)
+	return body, verr.errOrNil()
+}
+
+// parsePutItemRequestBody parses data into a new PutItemRequestBody, recording issues in verr with paths relative to path.
+func parsePutItemRequestBody(data map[string]any, path string, verr *ValidationError) *PutItemRequestBody {
+	body := new(PutItemRequestBody)
+
+	pathName := joinValidationPath(path, "Name")
+
+	valName, ok := data["Name"]
+	if !ok {
+
+		verr.add(ValidationLocationBody, pathName, ValidationCodeRequired, "missing required field")
+
+	} else {
+
+		if valNameTyped, ok := valName.(string); !ok {
+			verr.add(ValidationLocationBody, pathName, ValidationCodeInvalidType, "must be of type string")
+		} else {
+
+			valNameTyped = strings.TrimSpace(valNameTyped)
+
+			if len(valNameTyped) == 0 {
+				verr.add(ValidationLocationBody, pathName, ValidationCodeNonEmpty, "must be non-empty")
+			}
+
+			body.Name = valNameTyped
+		}
+
+	}
+
+	return body
+}
+
+// UnmarshalJSON decodes a JSON object into PutItemRequestBody, with the same checks as ParsePutItemRequestBody.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *PutItemRequestBody) UnmarshalJSON(data []byte) error {
+	verr := &ValidationError{}
+	t.decodeJSON(data, "", verr)
+	return verr.errOrNil()
+}
+
+// jsonPutItemRequestBody holds the raw JSON of the fields of a PutItemRequestBody, the inherited ones included, so that a body
+// is decoded by a single json.Unmarshal: objects of a struct type are decoded into their own raw struct,
+// and the other values are kept as a jsonValue.
+type jsonPutItemRequestBody struct {
+	Name jsonValue `json:"Name"`
+}
+
+// decodeJSON decodes data into t, recording issues in verr with paths relative to path.
+//
+// A field set to null is treated as missing.
+func (t *PutItemRequestBody) decodeJSON(data []byte, path string, verr *ValidationError) {
+	var raw jsonPutItemRequestBody
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+		// An object or an array of the wrong type is left unset in raw, as if it were missing,
+		// so data is checked by parsePutItemRequestBody instead, which records the issue of each of them.
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			verr.add(ValidationLocationBody, path, ValidationCodeMalformed, fmt.Sprintf("invalid JSON: %v", err))
+		} else if fields, ok := value.(map[string]any); !ok {
+			verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, "must be an object")
+		} else {
+			*t = *parsePutItemRequestBody(fields, path, verr)
+		}
+		return
+	}
+	t.decodeRaw(&raw, path, verr)
+}
+
+// decodeRaw decodes the fields of raw into t, recording issues in verr with paths relative to path.
+func (t *PutItemRequestBody) decodeRaw(raw *jsonPutItemRequestBody, path string, verr *ValidationError) {
+
+	pathName := joinValidationPath(path, "Name")
+	if raw.Name.absent() {
+
+		verr.add(ValidationLocationBody, pathName, ValidationCodeRequired, "missing required field")
+
+	} else if valName, ok := decodeJSONValue[string](raw.Name, pathName, "must be of type string", verr); ok {
+		valName = strings.TrimSpace(valName)
+
+		if len(valName) == 0 {
+			verr.add(ValidationLocationBody, pathName, ValidationCodeNonEmpty, "must be non-empty")
+		}
+
+		t.Name = valName
+	}
+
+}
+
+// Validate checks the required and non-empty constraints of an already-populated PutItemRequestBody,
+// e.g. one built by a handler. Required fields that are not pointers cannot be checked for presence.
+//
+// All validation issues are collected, and returned together as a *ValidationError.
+func (t *PutItemRequestBody) Validate() error {
+	verr := &ValidationError{}
+	t.validate("", verr)
+	return verr.errOrNil()
+}
+
+// validate records the issues of t in verr, with paths relative to path.
+func (t *PutItemRequestBody) validate(path string, verr *ValidationError) {
+
+	if len(strings.TrimSpace(t.Name)) == 0 {
+		verr.add(ValidationLocationBody, joinValidationPath(path, "Name"), ValidationCodeNonEmpty, "must be non-empty")
+	}
+
+}
+
+// jsonValue holds the raw JSON of a single value while a decodeJSON method runs.
+type jsonValue []byte
+
+func (v *jsonValue) UnmarshalJSON(data []byte) error {
+	// data is a sub-slice of the buffer passed to json.Unmarshal by decodeJSON, which outlives v,
+	// so it is kept without copying.
+	*v = data
+	return nil
+}
+
+// absent reports whether the field was missing from the JSON object, or null.
+func (v jsonValue) absent() bool {
+	return len(v) == 0 || string(v) == "null"
+}
+
+// decodeJSONValue decodes raw into a T, recording an invalid_type issue with the given message if it has the wrong type.
+//
+// raw is already known to be valid JSON, so primitives are parsed directly instead of going through json.Unmarshal again.
+func decodeJSONValue[T any](raw jsonValue, path, message string, verr *ValidationError) (T, bool) {
+	var val T
+	ok := true
+	switch dst := any(&val).(type) {
+	case *string:
+		if len(raw) >= 2 && raw[0] == '"' && bytes.IndexByte(raw, '\\') == -1 {
+			*dst = string(raw[1 : len(raw)-1])
+		} else {
+			ok = json.Unmarshal(raw, dst) == nil
+		}
+	case *int64:
+		var err error
+		if *dst, err = strconv.ParseInt(string(raw), 10, 64); err != nil {
+			// Like Parse<Schema>, which gets JSON numbers as float64, numbers like 1.0 or 1e3 are accepted,
+			// and truncated to an integer
+			var number float64
+			number, ok = decodeJSONNumber(raw)
+			*dst = int64(number)
+		}
+	case *float64:
+		*dst, ok = decodeJSONNumber(raw)
+	case *bool:
+		*dst = string(raw) == "true"
+		ok = *dst || string(raw) == "false"
+	default:
+		ok = json.Unmarshal(raw, dst) == nil
+	}
+	if !ok {
+		verr.add(ValidationLocationBody, path, ValidationCodeInvalidType, message)
+	}
+	return val, ok
+}
+
+// decodeJSONNumber parses raw as a JSON number.
+func decodeJSONNumber(raw jsonValue) (float64, bool) {
+	number, err := strconv.ParseFloat(string(raw), 64)
+	// ParseFloat also accepts values like "Inf" or "0x1p-2", which are not JSON numbers
+	return number, err == nil && (raw[0] == '-' || (raw[0] >= '0' && raw[0] <= '9'))
+}
@@ -0,0 +1,7 @@
+module github.com/nbrglm/napiway/testdata/out/typed_errors
+
+go 1.27.1
+
+replace github.com/nbrglm/napiway/testdata/out/typed_errors_sdk => ../typed-errors-sdk
+
+require github.com/nbrglm/napiway/testdata/out/typed_errors_sdk v0.0.0-00010101000000-000000000000
@@ -0,0 +1,201 @@
+package typed_errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/nbrglm/napiway/testdata/out/typed_errors/api"
+	sdk "github.com/nbrglm/napiway/testdata/out/typed_errors_sdk"
+)
+
+// Tests the Go SDK generated with typedErrors against the server of the same specification,
+// since the main e2e client tests the SDK generated without it.
+
+type store struct {
+	mu    sync.Mutex
+	items map[string]string
+}
+
+func (s *store) GetItem(r *http.Request, req *api.GetItemReq) (api.GetItemResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name, ok := s.items[req.ItemId]
+	if !ok {
+		return api.NewGetItem404(api.NewErrorResponse("Item Not Found")), nil
+	}
+	return api.NewGetItem200(api.NewItem(req.ItemId, name)), nil
+}
+
+func (s *store) DeleteItem(r *http.Request, req *api.DeleteItemReq) (api.DeleteItemResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.items[req.ItemId]; !ok {
+		return api.NewDeleteItem404(api.NewErrorResponse("Item Not Found")), nil
+	}
+	delete(s.items, req.ItemId)
+	return api.NewDeleteItem204(), nil
+}
+
+func (s *store) PutItem(r *http.Request, req *api.PutItemReq) (api.PutItemResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, replaced := s.items[req.ItemId]
+	s.items[req.ItemId] = req.Body.Name
+	if replaced {
+		return api.NewPutItem200(api.NewItem(req.ItemId, req.Body.Name)), nil
+	}
+	return api.NewPutItem201(api.NewItem(req.ItemId, req.Body.Name)), nil
+}
+
+func (s *store) ListItems(r *http.Request, req *api.ListItemsReq) (api.ListItemsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	offset, limit := int64(0), int64(10)
+	if req.Offset != nil {
+		offset = *req.Offset
+	}
+	if req.Limit != nil {
+		limit = *req.Limit
+	}
+	if offset < 0 || limit <= 0 {
+		return api.NewListItems400(api.NewErrorResponse("Bad Request")), nil
+	}
+	ids := slices.Sorted(func(yield func(string) bool) {
+		for id := range s.items {
+			if !yield(id) {
+				return
+			}
+		}
+	})
+	page := ids[min(offset, int64(len(ids))):min(offset+limit, int64(len(ids)))]
+	return api.NewListItems200(api.NewItemPage(page, int64(len(ids)))), nil
+}
+
+func (s *store) RenderParseError(r *http.Request, err *api.ParseError) *api.ErrorResponse {
+	return api.NewErrorResponse(http.StatusText(err.StatusCode()))
+}
+
+func newClient(t *testing.T) *sdk.TypedErrorsAPI {
+	t.Helper()
+	mux := http.NewServeMux()
+	api.RegisterRoutes(mux, &store{items: map[string]string{"item-1": "First"}})
+	// A status code that is not in the specification
+	mux.HandleFunc("GET /items/teapot", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return sdk.NewTypedErrorsAPI(server.URL)
+}
+
+func TestSingleSuccessResponseIsReturnedDirectly(t *testing.T) {
+	client := newClient(t)
+	item, err := client.GetItem(context.Background(), sdk.NewGetItemReq("item-1"))
+	if err != nil {
+		t.Fatalf("GetItem: %v", err)
+	}
+	if item.Body.ItemId != "item-1" || item.Body.Name != "First" {
+		t.Fatalf("GetItem returned %+v, want item-1 named First", item.Body)
+	}
+
+	_, err = client.GetItem(context.Background(), sdk.NewGetItemReq("missing"))
+	var notFound *sdk.GetItem404Error
+	if !errors.As(err, &notFound) {
+		t.Fatalf("GetItem of a missing item returned %v, want a *GetItem404Error", err)
+	}
+	if notFound.StatusCode() != http.StatusNotFound || notFound.Response.Body.ErrorMessage != "Item Not Found" {
+		t.Fatalf("GetItem404Error has status %d and body %+v", notFound.StatusCode(), notFound.Response.Body)
+	}
+}
+
+func TestStatusOnlySuccessResponseReturnsOnlyAnError(t *testing.T) {
+	client := newClient(t)
+	if err := client.DeleteItem(context.Background(), sdk.NewDeleteItemReq("item-1")); err != nil {
+		t.Fatalf("DeleteItem: %v", err)
+	}
+
+	err := client.DeleteItem(context.Background(), sdk.NewDeleteItemReq("item-1"))
+	var notFound *sdk.DeleteItem404Error
+	if !errors.As(err, &notFound) {
+		t.Fatalf("DeleteItem of a deleted item returned %v, want a *DeleteItem404Error", err)
+	}
+}
+
+func TestSeveralSuccessResponsesAreReturnedInAResult(t *testing.T) {
+	client := newClient(t)
+	res, err := client.PutItem(context.Background(), sdk.NewPutItemReq("item-2", sdk.NewPutItemRequestBody("Second")))
+	if err != nil {
+		t.Fatalf("PutItem: %v", err)
+	}
+	if res.StatusCode != http.StatusCreated || res.Response201 == nil || res.Response200 != nil {
+		t.Fatalf("PutItem of a new item returned status %d, want only Response201", res.StatusCode)
+	}
+
+	res, err = client.PutItem(context.Background(), sdk.NewPutItemReq("item-2", sdk.NewPutItemRequestBody("Renamed")))
+	if err != nil {
+		t.Fatalf("PutItem: %v", err)
+	}
+	if res.StatusCode != http.StatusOK || res.Response200 == nil || res.Response200.Body.Name != "Renamed" {
+		t.Fatalf("PutItem of an existing item returned status %d, want Response200 named Renamed", res.StatusCode)
+	}
+
+	// An empty name is rejected by the server
+	_, err = client.PutItem(context.Background(), sdk.NewPutItemReq("item-2", sdk.NewPutItemRequestBody("")))
+	var badRequest *sdk.PutItem400Error
+	if !errors.As(err, &badRequest) {
+		t.Fatalf("PutItem with an empty name returned %v, want a *PutItem400Error", err)
+	}
+}
+
+func TestPaginationOverDirectResponses(t *testing.T) {
+	client := newClient(t)
+	want := []string{"item-1"}
+	for i := range 4 {
+		id := fmt.Sprintf("item-%d", i+2)
+		if _, err := client.PutItem(context.Background(), sdk.NewPutItemReq(id, sdk.NewPutItemRequestBody(id))); err != nil {
+			t.Fatalf("PutItem: %v", err)
+		}
+		want = append(want, id)
+	}
+
+	limit := int64(2)
+	var got []string
+	for id, err := range client.ListItemsAll(context.Background(), sdk.NewListItemsReq().WithLimit(&limit)) {
+		if err != nil {
+			t.Fatalf("ListItemsAll: %v", err)
+		}
+		got = append(got, id)
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("ListItemsAll yielded %v, want %v", got, want)
+	}
+
+	// An invalid limit stops the iteration at the error of its first page
+	limit = 0
+	var errs []error
+	for _, err := range client.ListItemsAll(context.Background(), sdk.NewListItemsReq().WithLimit(&limit)) {
+		errs = append(errs, err)
+	}
+	var badRequest *sdk.ListItems400Error
+	if len(errs) != 1 || !errors.As(errs[0], &badRequest) {
+		t.Fatalf("ListItemsAll with an invalid limit yielded %v, want a single *ListItems400Error", errs)
+	}
+}
+
+func TestUndeclaredStatusIsAnUnexpectedError(t *testing.T) {
+	client := newClient(t)
+	_, err := client.GetItem(context.Background(), sdk.NewGetItemReq("teapot"))
+	var apiErr *sdk.TypedErrorsAPIError
+	if !errors.As(err, &apiErr) || apiErr.Reason != sdk.ReasonUnexpected {
+		t.Fatalf("GetItem answered with 418 returned %v, want a *TypedErrorsAPIError with ReasonUnexpected", err)
+	}
+	if apiErr.UnknownResponse == nil || apiErr.UnknownResponse.StatusCode != http.StatusTeapot {
+		t.Fatalf("TypedErrorsAPIError has UnknownResponse %v, want the 418 response", apiErr.UnknownResponse)
+	}
+}